@@ -0,0 +1,23 @@
+package accesslogging
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/accesslogging/remove"
+	"github.com/bilalcaliskan/s3-manager/cmd/accesslogging/set"
+	"github.com/bilalcaliskan/s3-manager/cmd/accesslogging/show"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	AccessLoggingCmd.AddCommand(show.ShowCmd)
+	AccessLoggingCmd.AddCommand(set.SetCmd)
+	AccessLoggingCmd.AddCommand(remove.RemoveCmd)
+}
+
+var (
+	AccessLoggingCmd = &cobra.Command{
+		Use:           "accesslogging",
+		Short:         "shows/sets the server access logging configuration of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: false,
+	}
+)
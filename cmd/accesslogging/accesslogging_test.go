@@ -0,0 +1,13 @@
+//go:build unit
+
+package accesslogging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessLoggingCmd(t *testing.T) {
+	assert.NotNil(t, AccessLoggingCmd)
+}
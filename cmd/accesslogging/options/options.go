@@ -0,0 +1,36 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/spf13/cobra"
+)
+
+type AccessLoggingOptsKey struct{}
+
+var accessLoggingOpts = &AccessLoggingOptions{}
+
+// AccessLoggingOptions contains frequent command line and application options.
+type AccessLoggingOptions struct {
+	// TargetBucket is the name of the bucket that the server access logs are delivered to
+	TargetBucket string
+	// TargetPrefix is the key prefix of the delivered server access logs
+	TargetPrefix string
+	*options.RootOptions
+}
+
+func (opts *AccessLoggingOptions) InitFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.TargetBucket, "target-bucket", "", "",
+		"name of the bucket that the server access logs are delivered to, it must be in the same region and account")
+	cmd.Flags().StringVarP(&opts.TargetPrefix, "target-prefix", "", "",
+		"key prefix of the delivered server access logs, e.g. 'logs/'")
+}
+
+// GetAccessLoggingOptions returns the pointer of AccessLoggingOptions
+func GetAccessLoggingOptions() *AccessLoggingOptions {
+	return accessLoggingOpts
+}
+
+func (opts *AccessLoggingOptions) SetZeroValues() {
+	opts.TargetBucket = ""
+	opts.TargetPrefix = ""
+}
@@ -0,0 +1,36 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAccessLoggingOptions(t *testing.T) {
+	opts := GetAccessLoggingOptions()
+	assert.NotNil(t, opts)
+}
+
+func TestAccessLoggingOptions_InitFlags(t *testing.T) {
+	cmd := cobra.Command{}
+	opts := GetAccessLoggingOptions()
+	opts.InitFlags(&cmd)
+
+	assert.NotNil(t, cmd.Flags().Lookup("target-bucket"))
+	assert.NotNil(t, cmd.Flags().Lookup("target-prefix"))
+}
+
+func TestAccessLoggingOptions_SetZeroValues(t *testing.T) {
+	opts := GetAccessLoggingOptions()
+	assert.NotNil(t, opts)
+
+	opts.TargetBucket = "thevpnbeast-logs"
+	opts.TargetPrefix = "logs/"
+	opts.SetZeroValues()
+
+	assert.Empty(t, opts.TargetBucket)
+	assert.Empty(t, opts.TargetPrefix)
+}
@@ -0,0 +1,66 @@
+package remove
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/accesslogging/options"
+	accessloggingutils "github.com/bilalcaliskan/s3-manager/cmd/accesslogging/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	accessLoggingOpts = options.GetAccessLoggingOptions()
+}
+
+var (
+	svc               internalawstypes.S3ClientAPI
+	logger            zerolog.Logger
+	confirmRunner     prompt.PromptRunner
+	accessLoggingOpts *options.AccessLoggingOptions
+	RemoveCmd         = &cobra.Command{
+		Use:           "remove",
+		Short:         "disables the server access logging of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# disable the server access logging of the target bucket
+s3-manager accesslogging remove
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			accessLoggingOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			res, err := aws.GetBucketLogging(svc, accessLoggingOpts)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if res == nil {
+				logger.Warn().Msg(accessloggingutils.WarnNotEnabled)
+				return nil
+			}
+
+			logger.Info().Msgf(accessloggingutils.InfCurrentState, accessloggingutils.FormatLogging(res))
+			if _, err := aws.DisableBucketLogging(svc, accessLoggingOpts, confirmRunner, logger); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if !accessLoggingOpts.DryRun {
+				logger.Info().Msg(accessloggingutils.InfRemoved)
+			}
+
+			return nil
+		},
+	}
+)
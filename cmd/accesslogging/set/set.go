@@ -0,0 +1,85 @@
+package set
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/bilalcaliskan/s3-manager/cmd/accesslogging/options"
+	accessloggingutils "github.com/bilalcaliskan/s3-manager/cmd/accesslogging/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalaws "github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	accessLoggingOpts = options.GetAccessLoggingOptions()
+	accessLoggingOpts.InitFlags(SetCmd)
+}
+
+var (
+	svc               internalawstypes.S3ClientAPI
+	logger            zerolog.Logger
+	confirmRunner     prompt.PromptRunner
+	accessLoggingOpts *options.AccessLoggingOptions
+	SetCmd            = &cobra.Command{
+		Use:   "set",
+		Short: "enables the server access logging of the target bucket",
+		Long: `enables the server access logging of the target bucket, the target bucket must allow the logging service
+principal 'logging.s3.amazonaws.com' to put objects with its bucket policy`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# deliver the server access logs of the target bucket to another bucket under 'logs/' prefix
+s3-manager accesslogging set --target-bucket thevpnbeast-logs --target-prefix logs/
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			accessLoggingOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if accessLoggingOpts.TargetBucket == "" {
+				err := errors.New(accessloggingutils.ErrMissingTargetBucket)
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if accessLoggingOpts.TargetBucket == accessLoggingOpts.BucketName {
+				logger.Warn().Msg(accessloggingutils.WarnSameBucket)
+			}
+
+			res, err := internalaws.GetBucketLogging(svc, accessLoggingOpts)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			desired := accessloggingutils.ToLoggingEnabled(accessLoggingOpts)
+			logger.Info().Msgf(accessloggingutils.InfCurrentState, accessloggingutils.FormatLogging(res))
+			if res != nil && aws.ToString(res.TargetBucket) == accessLoggingOpts.TargetBucket &&
+				aws.ToString(res.TargetPrefix) == accessLoggingOpts.TargetPrefix {
+				logger.Warn().Msg(accessloggingutils.WarnDesiredSame)
+				return nil
+			}
+
+			logger.Info().Msgf(accessloggingutils.InfSetting, accessloggingutils.FormatLogging(desired))
+			if _, err := internalaws.SetBucketLogging(svc, accessLoggingOpts, confirmRunner, logger); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if !accessLoggingOpts.DryRun {
+				logger.Info().Msgf(accessloggingutils.InfSuccess, accessloggingutils.FormatLogging(desired))
+			}
+
+			return nil
+		},
+	}
+)
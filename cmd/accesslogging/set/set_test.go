@@ -0,0 +1,162 @@
+//go:build e2e
+
+package set
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func getBucketLoggingFunc(ctx context.Context, params *s3.GetBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+	return &s3.GetBucketLoggingOutput{LoggingEnabled: &types.LoggingEnabled{
+		TargetBucket: aws.String("thevpnbeast-logs"),
+		TargetPrefix: aws.String("logs/"),
+	}}, nil
+}
+
+func TestExecuteSetCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	SetCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName             string
+		args                 []string
+		shouldPass           bool
+		getBucketLoggingFunc func(ctx context.Context, params *s3.GetBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error)
+		putBucketLoggingFunc func(ctx context.Context, params *s3.PutBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			[]string{"--target-bucket", "thevpnbeast-logs", "--target-prefix", "access-logs/"},
+			true,
+			getBucketLoggingFunc,
+			func(ctx context.Context, params *s3.PutBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error) {
+				return &s3.PutBucketLoggingOutput{}, nil
+			},
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success when already at desired state",
+			[]string{"--target-bucket", "thevpnbeast-logs", "--target-prefix", "logs/"},
+			true,
+			getBucketLoggingFunc,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with same bucket and dry run",
+			[]string{"--target-bucket", "thisisbucketname"},
+			true,
+			func(ctx context.Context, params *s3.GetBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+				return &s3.GetBucketLoggingOutput{}, nil
+			},
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by missing target bucket",
+			[]string{},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by get error",
+			[]string{"--target-bucket", "thevpnbeast-logs"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by put error",
+			[]string{"--target-bucket", "thevpnbeast-logs"},
+			false,
+			getBucketLoggingFunc,
+			func(ctx context.Context, params *s3.PutBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated process",
+			[]string{"--target-bucket", "thevpnbeast-logs"},
+			false,
+			getBucketLoggingFunc,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketLoggingAPI = tc.getBucketLoggingFunc
+		mockS3.PutBucketLoggingAPI = tc.putBucketLoggingFunc
+
+		SetCmd.SetContext(context.WithValue(SetCmd.Context(), options.S3ClientKey{}, mockS3))
+		SetCmd.SetContext(context.WithValue(SetCmd.Context(), options.OptsKey{}, rootOpts))
+		SetCmd.SetContext(context.WithValue(SetCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		SetCmd.SetArgs(tc.args)
+
+		err := SetCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		accessLoggingOpts.SetZeroValues()
+	}
+}
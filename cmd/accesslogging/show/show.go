@@ -0,0 +1,51 @@
+package show
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/accesslogging/options"
+	accessloggingutils "github.com/bilalcaliskan/s3-manager/cmd/accesslogging/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	accessLoggingOpts = options.GetAccessLoggingOptions()
+}
+
+var (
+	svc               internalawstypes.S3ClientAPI
+	logger            zerolog.Logger
+	accessLoggingOpts *options.AccessLoggingOptions
+	ShowCmd           = &cobra.Command{
+		Use:           "show",
+		Short:         "shows the server access logging configuration of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# show the server access logging configuration of the target bucket
+s3-manager accesslogging show
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			accessLoggingOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			res, err := aws.GetBucketLogging(svc, accessLoggingOpts)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Msgf(accessloggingutils.InfCurrentState, accessloggingutils.FormatLogging(res))
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,84 @@
+//go:build e2e
+
+package show
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteShowCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	ShowCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName             string
+		args                 []string
+		shouldPass           bool
+		getBucketLoggingFunc func(ctx context.Context, params *s3.GetBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error)
+	}{
+		{
+			"Too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+		},
+		{
+			"Success",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.GetBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+				return &s3.GetBucketLoggingOutput{LoggingEnabled: &types.LoggingEnabled{
+					TargetBucket: aws.String("thevpnbeast-logs"),
+					TargetPrefix: aws.String("logs/"),
+				}}, nil
+			},
+		},
+		{
+			"Success when disabled",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.GetBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+				return &s3.GetBucketLoggingOutput{}, nil
+			},
+		},
+		{
+			"Failure",
+			[]string{},
+			false,
+			func(ctx context.Context, params *s3.GetBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketLoggingAPI = tc.getBucketLoggingFunc
+
+		ShowCmd.SetContext(context.WithValue(ShowCmd.Context(), options.S3ClientKey{}, mockS3))
+		ShowCmd.SetContext(context.WithValue(ShowCmd.Context(), options.OptsKey{}, rootOpts))
+		ShowCmd.SetArgs(tc.args)
+
+		err := ShowCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		accessLoggingOpts.SetZeroValues()
+	}
+}
@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/accesslogging/options"
+)
+
+const (
+	ErrMissingTargetBucket = "'--target-bucket' flag is required"
+
+	WarnSameBucket  = "target bucket is the same with the source bucket, log deliveries will also be logged and increase the storage costs"
+	WarnNotEnabled  = "server access logging is already disabled on target bucket, skipping operation"
+	WarnDesiredSame = "server access logging is already at the desired state, skipping operation"
+
+	InfCurrentState = "current server access logging configuration is %s"
+	InfSetting      = "setting server access logging as %s"
+	InfSuccess      = "successfully configured server access logging as %s"
+	InfRemoved      = "successfully disabled server access logging on target bucket"
+	InfDisabled     = "disabled"
+)
+
+// FormatLogging returns the human-readable representation of a server access logging configuration, nil means
+// the server access logging is disabled.
+func FormatLogging(enabled *types.LoggingEnabled) string {
+	if enabled == nil {
+		return InfDisabled
+	}
+
+	return fmt.Sprintf("s3://%s/%s", aws.ToString(enabled.TargetBucket), aws.ToString(enabled.TargetPrefix))
+}
+
+// ToLoggingEnabled converts the desired server access logging settings of AccessLoggingOptions into a LoggingEnabled.
+func ToLoggingEnabled(opts *options.AccessLoggingOptions) *types.LoggingEnabled {
+	return &types.LoggingEnabled{
+		TargetBucket: aws.String(opts.TargetBucket),
+		TargetPrefix: aws.String(opts.TargetPrefix),
+	}
+}
@@ -0,0 +1,26 @@
+//go:build unit
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/accesslogging/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatLogging(t *testing.T) {
+	assert.Equal(t, InfDisabled, FormatLogging(nil))
+	assert.Equal(t, "s3://thevpnbeast-logs/logs/", FormatLogging(&types.LoggingEnabled{
+		TargetBucket: aws.String("thevpnbeast-logs"),
+		TargetPrefix: aws.String("logs/"),
+	}))
+}
+
+func TestToLoggingEnabled(t *testing.T) {
+	enabled := ToLoggingEnabled(&options.AccessLoggingOptions{TargetBucket: "thevpnbeast-logs", TargetPrefix: "logs/"})
+	assert.Equal(t, "thevpnbeast-logs", aws.ToString(enabled.TargetBucket))
+	assert.Equal(t, "logs/", aws.ToString(enabled.TargetPrefix))
+}
@@ -0,0 +1,19 @@
+package acl
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/acl/show"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	AclCmd.AddCommand(show.ShowCmd)
+}
+
+var (
+	AclCmd = &cobra.Command{
+		Use:           "acl",
+		Short:         "shows the access control lists of the target bucket and its objects",
+		SilenceUsage:  false,
+		SilenceErrors: false,
+	}
+)
@@ -0,0 +1,13 @@
+//go:build unit
+
+package acl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAclCmd(t *testing.T) {
+	assert.NotNil(t, AclCmd)
+}
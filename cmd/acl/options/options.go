@@ -0,0 +1,31 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/spf13/cobra"
+)
+
+type AclOptsKey struct{}
+
+var aclOpts = &AclOptions{}
+
+// AclOptions contains frequent command line and application options.
+type AclOptions struct {
+	// Keys is the list of object keys to report the ACL grants of, in addition to the bucket ACL grants
+	Keys []string
+	*options.RootOptions
+}
+
+func (opts *AclOptions) InitFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVarP(&opts.Keys, "key", "", []string{},
+		"comma separated keys of the objects to report the ACL grants of, in addition to the bucket ACL grants")
+}
+
+// GetAclOptions returns the pointer of AclOptions
+func GetAclOptions() *AclOptions {
+	return aclOpts
+}
+
+func (opts *AclOptions) SetZeroValues() {
+	opts.Keys = []string{}
+}
@@ -0,0 +1,32 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAclOptions(t *testing.T) {
+	opts := GetAclOptions()
+	assert.NotNil(t, opts)
+}
+
+func TestAclOptions_InitFlags(t *testing.T) {
+	cmd := cobra.Command{}
+	opts := GetAclOptions()
+	opts.InitFlags(&cmd)
+
+	assert.NotNil(t, cmd.Flags().Lookup("key"))
+}
+
+func TestAclOptions_SetZeroValues(t *testing.T) {
+	opts := GetAclOptions()
+	assert.NotNil(t, opts)
+
+	opts.Keys = []string{"foo.txt"}
+	opts.SetZeroValues()
+	assert.Empty(t, opts.Keys)
+}
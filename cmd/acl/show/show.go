@@ -0,0 +1,99 @@
+package show
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/acl/options"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/publicaccess"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	aclOpts = options.GetAclOptions()
+	aclOpts.InitFlags(ShowCmd)
+}
+
+var (
+	svc     internalawstypes.S3ClientAPI
+	logger  zerolog.Logger
+	aclOpts *options.AclOptions
+	ShowCmd = &cobra.Command{
+		Use:           "show",
+		Short:         "shows the ACL grants of the target bucket and optionally of the specified objects",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# show the ACL grants of the target bucket
+s3-manager acl show
+
+# show the ACL grants of the target bucket and two of its objects
+s3-manager acl show --key foo/file1.txt,bar/file2.txt
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			aclOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			res, err := aws.GetBucketAcl(svc, aclOpts)
+			if err != nil {
+				logger.Error().
+					Str("error", err.Error()).
+					Msg("an error occurred while getting bucket acl")
+				return err
+			}
+
+			logger.Info().Msg("fetched bucket acl successfully")
+			printGrants(rootOpts.BucketName, res.Grants)
+
+			for _, key := range aclOpts.Keys {
+				res, err := aws.GetObjectAcl(svc, aclOpts, key)
+				if err != nil {
+					logger.Error().
+						Str("key", key).
+						Str("error", err.Error()).
+						Msg("an error occurred while getting object acl")
+					return err
+				}
+
+				logger.Info().Str("key", key).Msg("fetched object acl successfully")
+				printGrants(key, res.Grants)
+			}
+
+			return nil
+		},
+	}
+)
+
+func printGrants(resource string, grants []types.Grant) {
+	for _, grant := range grants {
+		if grant.Grantee == nil {
+			continue
+		}
+
+		grantee := grant.Grantee.ID
+		switch grant.Grantee.Type {
+		case types.TypeGroup:
+			grantee = grant.Grantee.URI
+		case types.TypeAmazonCustomerByEmail:
+			grantee = grant.Grantee.EmailAddress
+		}
+
+		var name string
+		if grantee != nil {
+			name = *grantee
+		}
+
+		fmt.Printf("resource=%s, granteeType=%s, grantee=%s, permission=%s, public=%t\n", resource,
+			grant.Grantee.Type, name, grant.Permission, publicaccess.IsPublicGrant(grant))
+	}
+}
@@ -0,0 +1,117 @@
+//go:build e2e
+
+package show
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+var grants = []types.Grant{
+	{
+		Grantee: &types.Grantee{
+			Type: types.TypeCanonicalUser,
+			ID:   aws.String("79a59df900b949e55d96a1e698fbacedfd6e09d98eacf8f8d5218e7cd47ef2be"),
+		},
+		Permission: types.PermissionFullControl,
+	},
+	{
+		Grantee: &types.Grantee{
+			Type: types.TypeGroup,
+			URI:  aws.String("http://acs.amazonaws.com/groups/global/AllUsers"),
+		},
+		Permission: types.PermissionRead,
+	},
+}
+
+func TestExecuteShowCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	ShowCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName         string
+		args             []string
+		shouldPass       bool
+		getBucketAclFunc func(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options)) (*s3.GetBucketAclOutput, error)
+		getObjectAclFunc func(ctx context.Context, params *s3.GetObjectAclInput, optFns ...func(*s3.Options)) (*s3.GetObjectAclOutput, error)
+	}{
+		{
+			"Too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"Success",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+				return &s3.GetBucketAclOutput{Grants: grants}, nil
+			},
+			nil,
+		},
+		{
+			"Success with keys",
+			[]string{"--key", "foo.txt,bar.txt"},
+			true,
+			func(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+				return &s3.GetBucketAclOutput{Grants: grants}, nil
+			},
+			func(ctx context.Context, params *s3.GetObjectAclInput, optFns ...func(*s3.Options)) (*s3.GetObjectAclOutput, error) {
+				return &s3.GetObjectAclOutput{Grants: grants}, nil
+			},
+		},
+		{
+			"Failure caused by get bucket acl error",
+			[]string{},
+			false,
+			func(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+		},
+		{
+			"Failure caused by get object acl error",
+			[]string{"--key", "foo.txt"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+				return &s3.GetBucketAclOutput{Grants: grants}, nil
+			},
+			func(ctx context.Context, params *s3.GetObjectAclInput, optFns ...func(*s3.Options)) (*s3.GetObjectAclOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketAclAPI = tc.getBucketAclFunc
+		mockS3.GetObjectAclAPI = tc.getObjectAclFunc
+
+		ShowCmd.SetContext(context.WithValue(ShowCmd.Context(), options.S3ClientKey{}, mockS3))
+		ShowCmd.SetContext(context.WithValue(ShowCmd.Context(), options.OptsKey{}, rootOpts))
+		ShowCmd.SetArgs(tc.args)
+
+		err := ShowCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		aclOpts.SetZeroValues()
+	}
+}
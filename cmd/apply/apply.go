@@ -0,0 +1,102 @@
+package apply
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/apply/options"
+	applyutils "github.com/bilalcaliskan/s3-manager/cmd/apply/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/diff"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/manifest"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	applyOpts = options.GetApplyOptions()
+	applyOpts.InitFlags(ApplyCmd)
+}
+
+var (
+	svc           internalawstypes.S3ClientAPI
+	logger        zerolog.Logger
+	confirmRunner prompt.PromptRunner
+	applyOpts     *options.ApplyOptions
+	ApplyCmd      = &cobra.Command{
+		Use:   "apply",
+		Short: "applies the settings declared in a JSON or YAML manifest on the target bucket",
+		Long: `applies the settings declared in a JSON or YAML manifest on the target bucket, the manifest can declare the
+tags, versioning, transfer acceleration, policy, lifecycle and default encryption of the bucket. The current
+settings are compared with the manifest, the differences are printed as a plan and only they are applied after
+the approval. The settings that are left out of the manifest are not touched`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# apply the settings declared in bucket.yaml on the target bucket
+s3-manager apply -f bucket.yaml
+
+# print the plan without applying it
+s3-manager apply -f bucket.yaml --dry-run
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			applyOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if applyOpts.ManifestFile == "" {
+				err := errors.New(applyutils.ErrNoManifest)
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger = logger.With().Str("manifestFilePath", applyOpts.ManifestFile).Logger()
+
+			if applyOpts.Manifest, err = manifest.ReadFile(applyOpts.ManifestFile); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			current, err := aws.GetManifest(svc, applyOpts.RootOptions)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			changes, err := manifest.Diff(current, applyOpts.Manifest)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if len(changes) == 0 {
+				logger.Info().Msg(applyutils.InfInSync)
+				return nil
+			}
+
+			logger.Info().Msg(applyutils.InfWillApply)
+			for _, change := range changes {
+				fmt.Println(diff.Colorize(change.String()))
+			}
+
+			if err := aws.ApplyManifest(svc, applyOpts, changes, confirmRunner, logger); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if !applyOpts.DryRun {
+				logger.Info().Msg(applyutils.InfSuccess)
+			}
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,196 @@
+//go:build e2e
+
+package apply
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMockS3 returns a client that reports a bucket with the given tag and versioning status, none of the other
+// settings of a manifest are configured
+func newMockS3(team string, versioning types.BucketVersioningStatus, putErr error) *internalawstypes.MockS3Client {
+	mockS3 := new(internalawstypes.MockS3Client)
+	mockS3.GetBucketTaggingAPI = func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+		return &s3.GetBucketTaggingOutput{TagSet: []types.Tag{{Key: aws.String("team"), Value: aws.String(team)}}}, nil
+	}
+	mockS3.GetBucketVersioningAPI = func(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
+		return &s3.GetBucketVersioningOutput{Status: versioning}, nil
+	}
+	mockS3.GetBucketAccelerateConfigurationAPI = func(ctx context.Context, params *s3.GetBucketAccelerateConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketAccelerateConfigurationOutput, error) {
+		return &s3.GetBucketAccelerateConfigurationOutput{}, nil
+	}
+	mockS3.GetBucketPolicyAPI = func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+		return nil, &smithy.GenericAPIError{Code: "NoSuchBucketPolicy"}
+	}
+	mockS3.GetBucketLifecycleConfigurationAPI = func(ctx context.Context, params *s3.GetBucketLifecycleConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+		return nil, &smithy.GenericAPIError{Code: "NoSuchLifecycleConfiguration"}
+	}
+	mockS3.GetBucketEncryptionAPI = func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+		return &s3.GetBucketEncryptionOutput{ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+			Rules: []types.ServerSideEncryptionRule{{
+				ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{SSEAlgorithm: types.ServerSideEncryptionAes256},
+			}},
+		}}, nil
+	}
+	mockS3.PutBucketTaggingAPI = func(ctx context.Context, params *s3.PutBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error) {
+		return &s3.PutBucketTaggingOutput{}, putErr
+	}
+	mockS3.PutBucketVersioningAPI = func(ctx context.Context, params *s3.PutBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.PutBucketVersioningOutput, error) {
+		return &s3.PutBucketVersioningOutput{}, putErr
+	}
+
+	return mockS3
+}
+
+func TestExecuteApplyCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	ApplyCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName   string
+		args       []string
+		shouldPass bool
+		mockS3     *internalawstypes.MockS3Client
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			[]string{"-f", "../../testdata/manifest.json"},
+			true,
+			newMockS3("data", types.BucketVersioningStatusSuspended, nil),
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success with yaml manifest and dry run",
+			[]string{"--file", "../../testdata/manifest.yaml"},
+			true,
+			newMockS3("data", types.BucketVersioningStatusSuspended, nil),
+			nil,
+			true,
+			false,
+		},
+		{
+			"Success when already in sync",
+			[]string{"-f", "../../testdata/manifest.json"},
+			true,
+			newMockS3("platform", types.BucketVersioningStatusEnabled, nil),
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by too many arguments",
+			[]string{"foo", "-f", "../../testdata/manifest.json"},
+			false,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by missing manifest file flag",
+			[]string{},
+			false,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by manifest file not found",
+			[]string{"-f", "../../testdata/manifest_notfound.yaml"},
+			false,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by invalid manifest",
+			[]string{"-f", "../../testdata/manifest_invalid.yaml"},
+			false,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by get error",
+			[]string{"-f", "../../testdata/manifest.json"},
+			false,
+			func() *internalawstypes.MockS3Client {
+				mockS3 := newMockS3("data", types.BucketVersioningStatusSuspended, nil)
+				mockS3.GetBucketVersioningAPI = func(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
+					return nil, constants.ErrInjected
+				}
+
+				return mockS3
+			}(),
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by put error",
+			[]string{"-f", "../../testdata/manifest.json"},
+			false,
+			newMockS3("data", types.BucketVersioningStatusSuspended, constants.ErrInjected),
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated process",
+			[]string{"-f", "../../testdata/manifest.json"},
+			false,
+			newMockS3("data", types.BucketVersioningStatusSuspended, nil),
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		ApplyCmd.SetContext(context.WithValue(ApplyCmd.Context(), options.S3ClientKey{}, tc.mockS3))
+		ApplyCmd.SetContext(context.WithValue(ApplyCmd.Context(), options.OptsKey{}, rootOpts))
+		ApplyCmd.SetContext(context.WithValue(ApplyCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		ApplyCmd.SetArgs(tc.args)
+
+		err := ApplyCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		applyOpts.SetZeroValues()
+	}
+}
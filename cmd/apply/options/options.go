@@ -0,0 +1,35 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/manifest"
+	"github.com/spf13/cobra"
+)
+
+type ApplyOptsKey struct{}
+
+var applyOpts = &ApplyOptions{}
+
+// ApplyOptions contains frequent command line and application options.
+type ApplyOptions struct {
+	// ManifestFile is the path of the JSON or YAML file that declares the desired settings of the target bucket
+	ManifestFile string
+	// Manifest is the desired configuration of the target bucket parsed from ManifestFile
+	Manifest *manifest.Manifest
+	*options.RootOptions
+}
+
+func (opts *ApplyOptions) InitFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.ManifestFile, "file", "f", "", "path of the JSON or YAML manifest that "+
+		"declares the desired settings of the target bucket")
+}
+
+// GetApplyOptions returns the pointer of ApplyOptions
+func GetApplyOptions() *ApplyOptions {
+	return applyOpts
+}
+
+func (opts *ApplyOptions) SetZeroValues() {
+	opts.ManifestFile = ""
+	opts.Manifest = nil
+}
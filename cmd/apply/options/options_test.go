@@ -0,0 +1,24 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetApplyOptions(t *testing.T) {
+	opts := GetApplyOptions()
+	assert.NotNil(t, opts)
+}
+
+func TestApplyOptions_SetZeroValues(t *testing.T) {
+	opts := GetApplyOptions()
+	assert.NotNil(t, opts)
+
+	opts.ManifestFile = "bucket.yaml"
+	opts.SetZeroValues()
+	assert.Empty(t, opts.ManifestFile)
+	assert.Nil(t, opts.Manifest)
+}
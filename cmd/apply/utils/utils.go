@@ -0,0 +1,9 @@
+package utils
+
+const (
+	ErrNoManifest = "'--file' flag must be specified"
+
+	InfInSync    = "target bucket already matches the manifest, nothing to apply"
+	InfWillApply = "will attempt to apply below changes, + add, ~ change, - remove"
+	InfSuccess   = "successfully applied the manifest on target bucket"
+)
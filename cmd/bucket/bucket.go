@@ -0,0 +1,25 @@
+package bucket
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/bucket/create"
+	"github.com/bilalcaliskan/s3-manager/cmd/bucket/delete"
+	"github.com/bilalcaliskan/s3-manager/cmd/bucket/describe"
+	"github.com/bilalcaliskan/s3-manager/cmd/bucket/empty"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	BucketCmd.AddCommand(create.CreateCmd)
+	BucketCmd.AddCommand(empty.EmptyCmd)
+	BucketCmd.AddCommand(delete.DeleteCmd)
+	BucketCmd.AddCommand(describe.DescribeCmd)
+}
+
+var (
+	BucketCmd = &cobra.Command{
+		Use:           "bucket",
+		Short:         "creates, empties, deletes or describes the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: false,
+	}
+)
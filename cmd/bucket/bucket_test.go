@@ -0,0 +1,13 @@
+//go:build unit
+
+package bucket
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketCmd(t *testing.T) {
+	assert.NotNil(t, BucketCmd)
+}
@@ -0,0 +1,69 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/bucket/options"
+	bucketutils "github.com/bilalcaliskan/s3-manager/cmd/bucket/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	bucketOpts = options.GetBucketOptions()
+	bucketOpts.InitCreateFlags(CreateCmd)
+}
+
+var (
+	svc           internalawstypes.S3ClientAPI
+	logger        zerolog.Logger
+	confirmRunner prompt.PromptRunner
+	bucketOpts    *options.BucketOptions
+	CreateCmd     = &cobra.Command{
+		Use:           "create",
+		Short:         "creates the target bucket and configures its versioning, default encryption and tags in one step",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# create the target bucket in the region passed with '--region' flag
+s3-manager bucket create
+
+# create the target bucket with versioning, KMS encryption and tags
+s3-manager bucket create --versioning --encryption aws:kms --kms-key-id alias/releases --tags team=platform,env=prod
+
+# create the target bucket with Object Lock enabled, which can not be enabled afterwards
+s3-manager bucket create --object-lock
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			bucketOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if err := bucketutils.ValidateCreate(bucketOpts); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Msg(bucketutils.InfWillCreate)
+			for _, line := range bucketutils.FormatCreatePlan(bucketOpts) {
+				fmt.Println(line)
+			}
+
+			if err := aws.CreateBucket(svc, bucketOpts, confirmRunner, logger); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			return nil
+		},
+	}
+)
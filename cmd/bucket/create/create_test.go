@@ -0,0 +1,191 @@
+//go:build e2e
+
+package create
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func createBucketFunc(ctx context.Context, params *s3.CreateBucketInput, optFns ...func(*s3.Options)) (*s3.CreateBucketOutput, error) {
+	return &s3.CreateBucketOutput{}, nil
+}
+
+func TestExecuteCreateCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	CreateCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName                string
+		args                    []string
+		shouldPass              bool
+		createBucketFunc        func(ctx context.Context, params *s3.CreateBucketInput, optFns ...func(*s3.Options)) (*s3.CreateBucketOutput, error)
+		putBucketVersioningFunc func(ctx context.Context, params *s3.PutBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.PutBucketVersioningOutput, error)
+		putBucketEncryptionFunc func(ctx context.Context, params *s3.PutBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error)
+		putBucketTaggingFunc    func(ctx context.Context, params *s3.PutBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			[]string{},
+			true,
+			createBucketFunc,
+			nil,
+			nil,
+			nil,
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success with all the configurations",
+			[]string{"--versioning", "--encryption", "aws:kms", "--kms-key-id", "alias/releases", "--tags", "team=platform,env=prod"},
+			true,
+			createBucketFunc,
+			func(ctx context.Context, params *s3.PutBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.PutBucketVersioningOutput, error) {
+				return &s3.PutBucketVersioningOutput{}, nil
+			},
+			func(ctx context.Context, params *s3.PutBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error) {
+				return &s3.PutBucketEncryptionOutput{}, nil
+			},
+			func(ctx context.Context, params *s3.PutBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error) {
+				return &s3.PutBucketTaggingOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with object lock",
+			[]string{"--object-lock", "--versioning"},
+			true,
+			createBucketFunc,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with dry run",
+			[]string{"--tags", "team=platform"},
+			true,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by invalid flags",
+			[]string{"--kms-key-id", "alias/releases"},
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by create error",
+			[]string{},
+			false,
+			func(ctx context.Context, params *s3.CreateBucketInput, optFns ...func(*s3.Options)) (*s3.CreateBucketOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by versioning error",
+			[]string{"--versioning"},
+			false,
+			createBucketFunc,
+			func(ctx context.Context, params *s3.PutBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.PutBucketVersioningOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated process",
+			[]string{},
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.CreateBucketAPI = tc.createBucketFunc
+		mockS3.PutBucketVersioningAPI = tc.putBucketVersioningFunc
+		mockS3.PutBucketEncryptionAPI = tc.putBucketEncryptionFunc
+		mockS3.PutBucketTaggingAPI = tc.putBucketTaggingFunc
+
+		CreateCmd.SetContext(context.WithValue(CreateCmd.Context(), options.S3ClientKey{}, mockS3))
+		CreateCmd.SetContext(context.WithValue(CreateCmd.Context(), options.OptsKey{}, rootOpts))
+		CreateCmd.SetContext(context.WithValue(CreateCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		CreateCmd.SetArgs(tc.args)
+
+		err := CreateCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		bucketOpts.SetZeroValues()
+	}
+}
@@ -0,0 +1,66 @@
+package delete
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/bucket/options"
+	bucketutils "github.com/bilalcaliskan/s3-manager/cmd/bucket/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	bucketOpts = options.GetBucketOptions()
+	bucketOpts.InitDeleteFlags(DeleteCmd)
+}
+
+var (
+	svc        internalawstypes.S3ClientAPI
+	logger     zerolog.Logger
+	nameRunner prompt.PromptRunner
+	bucketOpts *options.BucketOptions
+	DeleteCmd  = &cobra.Command{
+		Use:   "delete",
+		Short: "deletes the target bucket after confirming its name, the bucket must be empty",
+		Long: `deletes the target bucket after confirming its name, the bucket must be empty. The name of the bucket must
+be typed to the prompt, or given with '--confirm-bucket-name' flag for non-interactive use. '--auto-approve' flag
+does not skip this confirmation, '--confirm-bucket-name' flag is required with it`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# delete the target bucket, you will be asked to type the name of the bucket to confirm
+s3-manager bucket delete
+
+# empty and then delete the target bucket
+s3-manager bucket empty && s3-manager bucket delete
+
+# delete the target bucket without the prompt, e.g. in a CI pipeline
+s3-manager bucket delete --bucket-name foo --confirm-bucket-name foo --auto-approve
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			bucketOpts.RootOptions = rootOpts
+			nameRunner, _ = cmd.Context().Value(rootopts.BucketNameRunnerKey{}).(prompt.PromptRunner)
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Msg(bucketutils.InfWillDelete)
+			if _, err := aws.DeleteBucket(svc, bucketOpts, nameRunner, logger); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if !bucketOpts.DryRun {
+				logger.Info().Msg(bucketutils.InfDeleted)
+			}
+
+			return nil
+		},
+	}
+)
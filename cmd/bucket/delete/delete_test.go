@@ -0,0 +1,157 @@
+//go:build e2e
+
+package delete
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteDeleteCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	DeleteCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName         string
+		args             []string
+		shouldPass       bool
+		deleteBucketFunc func(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
+				return &s3.DeleteBucketOutput{}, nil
+			},
+			&prompt.PromptWrapper{
+				Prompt:    prompt.GetExactMatchRunner("Type the bucket name to confirm", rootOpts.BucketName),
+				UserInput: rootOpts.BucketName,
+			},
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			[]string{},
+			true,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Success with confirm bucket name and auto approve",
+			[]string{"--confirm-bucket-name", "thisisbucketname"},
+			true,
+			func(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
+				return &s3.DeleteBucketOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by auto approve without confirm bucket name",
+			[]string{},
+			false,
+			func(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
+				return &s3.DeleteBucketOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by confirm bucket name mismatch",
+			[]string{"--confirm-bucket-name", "foo"},
+			false,
+			func(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
+				return &s3.DeleteBucketOutput{}, nil
+			},
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by bucket name mismatch",
+			[]string{},
+			false,
+			nil,
+			&prompt.PromptWrapper{
+				Prompt:    prompt.GetExactMatchRunner("Type the bucket name to confirm", rootOpts.BucketName),
+				UserInput: "y",
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by non empty bucket",
+			[]string{"--confirm-bucket-name", "thisisbucketname"},
+			false,
+			func(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "BucketNotEmpty"}
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by delete error",
+			[]string{"--confirm-bucket-name", "thisisbucketname"},
+			false,
+			func(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.DeleteBucketAPI = tc.deleteBucketFunc
+
+		DeleteCmd.SetContext(context.WithValue(DeleteCmd.Context(), options.S3ClientKey{}, mockS3))
+		DeleteCmd.SetContext(context.WithValue(DeleteCmd.Context(), options.OptsKey{}, rootOpts))
+		DeleteCmd.SetContext(context.WithValue(DeleteCmd.Context(), options.BucketNameRunnerKey{}, tc.PromptRunner))
+		DeleteCmd.SetArgs(tc.args)
+
+		err := DeleteCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		bucketOpts.SetZeroValues()
+	}
+}
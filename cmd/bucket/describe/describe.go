@@ -0,0 +1,67 @@
+package describe
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/bucket/options"
+	bucketutils "github.com/bilalcaliskan/s3-manager/cmd/bucket/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	bucketOpts = options.GetBucketOptions()
+}
+
+var (
+	svc         internalawstypes.S3ClientAPI
+	logger      zerolog.Logger
+	bucketOpts  *options.BucketOptions
+	DescribeCmd = &cobra.Command{
+		Use:   "describe",
+		Short: "prints a consolidated report of every setting of the target bucket that s3-manager manages",
+		Long: `prints a consolidated report of every setting of the target bucket that s3-manager manages, the settings
+that could not be fetched are reported as unknown`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# print a consolidated report of the settings of the target bucket
+s3-manager bucket describe
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			bucketOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			report, errs := aws.DescribeBucket(svc, bucketOpts.RootOptions)
+			for _, err := range errs {
+				logger.Error().Msg(err.Error())
+			}
+
+			if len(errs) == len(report) {
+				err := errors.New(bucketutils.ErrDescribeFailed)
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			for _, line := range report.Lines() {
+				fmt.Println(line)
+			}
+
+			if len(errs) > 0 {
+				logger.Warn().Msg(bucketutils.WarnPartialReport)
+			}
+
+			return nil
+		},
+	}
+)
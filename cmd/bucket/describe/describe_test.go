@@ -0,0 +1,109 @@
+//go:build e2e
+
+package describe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMockS3 returns a client whose getters all return err, the region is always reported
+func newMockS3(err error) *internalawstypes.MockS3Client {
+	mockS3 := new(internalawstypes.MockS3Client)
+	mockS3.GetBucketLocationAPI = func(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+		return &s3.GetBucketLocationOutput{}, nil
+	}
+	mockS3.GetBucketVersioningAPI = func(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
+		return &s3.GetBucketVersioningOutput{}, err
+	}
+	mockS3.GetBucketEncryptionAPI = func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+		return &s3.GetBucketEncryptionOutput{}, err
+	}
+	mockS3.GetBucketAccelerateConfigurationAPI = func(ctx context.Context, params *s3.GetBucketAccelerateConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketAccelerateConfigurationOutput, error) {
+		return &s3.GetBucketAccelerateConfigurationOutput{}, err
+	}
+	mockS3.GetBucketPolicyAPI = func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+		return &s3.GetBucketPolicyOutput{Policy: aws.String(`{"Version":"2012-10-17","Statement":[]}`)}, err
+	}
+	mockS3.GetBucketAclAPI = func(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+		return &s3.GetBucketAclOutput{}, err
+	}
+	mockS3.GetPublicAccessBlockAPI = func(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+		return &s3.GetPublicAccessBlockOutput{}, err
+	}
+	mockS3.GetBucketCorsAPI = func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+		return &s3.GetBucketCorsOutput{}, err
+	}
+	mockS3.GetBucketWebsiteAPI = func(ctx context.Context, params *s3.GetBucketWebsiteInput, optFns ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error) {
+		return &s3.GetBucketWebsiteOutput{}, err
+	}
+	mockS3.GetBucketReplicationAPI = func(ctx context.Context, params *s3.GetBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
+		return &s3.GetBucketReplicationOutput{}, err
+	}
+	mockS3.GetObjectLockConfigurationAPI = func(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+		return &s3.GetObjectLockConfigurationOutput{}, err
+	}
+	mockS3.GetBucketLoggingAPI = func(ctx context.Context, params *s3.GetBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+		return &s3.GetBucketLoggingOutput{}, err
+	}
+	mockS3.ListBucketInventoryConfigurationsAPI = func(ctx context.Context, params *s3.ListBucketInventoryConfigurationsInput, optFns ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+		return &s3.ListBucketInventoryConfigurationsOutput{}, err
+	}
+	mockS3.GetBucketNotificationConfigurationAPI = func(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+		return &s3.GetBucketNotificationConfigurationOutput{}, err
+	}
+	mockS3.GetBucketTaggingAPI = func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+		return &s3.GetBucketTaggingOutput{TagSet: []types.Tag{}}, err
+	}
+
+	return mockS3
+}
+
+func TestExecuteDescribeCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	DescribeCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName   string
+		args       []string
+		shouldPass bool
+		mockS3     *internalawstypes.MockS3Client
+	}{
+		{"Too many arguments", []string{"foo"}, false, newMockS3(nil)},
+		{"Success", []string{}, true, newMockS3(nil)},
+		{"Success with unknown settings", []string{}, true, newMockS3(constants.ErrInjected)},
+		{"Failure caused by all settings unknown", []string{}, false, func() *internalawstypes.MockS3Client {
+			mockS3 := newMockS3(constants.ErrInjected)
+			mockS3.GetBucketLocationAPI = func(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+				return nil, constants.ErrInjected
+			}
+
+			return mockS3
+		}()},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		DescribeCmd.SetContext(context.WithValue(DescribeCmd.Context(), options.S3ClientKey{}, tc.mockS3))
+		DescribeCmd.SetContext(context.WithValue(DescribeCmd.Context(), options.OptsKey{}, rootOpts))
+		DescribeCmd.SetArgs(tc.args)
+
+		err := DescribeCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package empty
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/bucket/options"
+	bucketutils "github.com/bilalcaliskan/s3-manager/cmd/bucket/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	bucketOpts = options.GetBucketOptions()
+	bucketOpts.InitEmptyFlags(EmptyCmd)
+}
+
+var (
+	svc           internalawstypes.S3ClientAPI
+	logger        zerolog.Logger
+	confirmRunner prompt.PromptRunner
+	bucketOpts    *options.BucketOptions
+	EmptyCmd      = &cobra.Command{
+		Use:           "empty",
+		Short:         "deletes all the objects, versions and delete markers of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# delete all the objects, versions and delete markers of the target bucket
+s3-manager bucket empty
+
+# delete the objects with smaller batches to spread the load
+s3-manager bucket empty --batch-size 100
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			bucketOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if err := bucketutils.ValidateBatchSize(bucketOpts.BatchSize); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			objects, err := aws.ListAllObjectVersions(svc, bucketOpts.BucketName)
+			if err != nil {
+				logger.Error().Str("error", err.Error()).Msg("an error occurred while listing object versions")
+				return err
+			}
+
+			if len(objects) == 0 {
+				logger.Warn().Msg(bucketutils.WarnAlreadyEmpty)
+				return nil
+			}
+
+			logger.Info().Int("count", len(objects)).Msg(bucketutils.InfWillEmpty)
+			if err := aws.DeleteObjectsInBatches(svc, bucketOpts, objects, confirmRunner, logger); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if !bucketOpts.DryRun {
+				logger.Info().Msg(bucketutils.InfEmptied)
+			}
+
+			return nil
+		},
+	}
+)
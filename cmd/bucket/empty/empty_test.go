@@ -0,0 +1,178 @@
+//go:build e2e
+
+package empty
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func listObjectVersionsFunc(ctx context.Context, params *s3.ListObjectVersionsInput, optFns ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error) {
+	return &s3.ListObjectVersionsOutput{
+		Versions: []types.ObjectVersion{
+			{Key: aws.String("file1.txt"), VersionId: aws.String("v1")},
+			{Key: aws.String("file2.txt"), VersionId: aws.String("v2")},
+		},
+		DeleteMarkers: []types.DeleteMarkerEntry{{Key: aws.String("file3.txt"), VersionId: aws.String("v3")}},
+		IsTruncated:   aws.Bool(false),
+	}, nil
+}
+
+func deleteObjectsFunc(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
+	return &s3.DeleteObjectsOutput{}, nil
+}
+
+func TestExecuteEmptyCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	EmptyCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName               string
+		args                   []string
+		shouldPass             bool
+		listObjectVersionsFunc func(ctx context.Context, params *s3.ListObjectVersionsInput, optFns ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error)
+		deleteObjectsFunc      func(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			[]string{},
+			true,
+			listObjectVersionsFunc,
+			deleteObjectsFunc,
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success with small batches",
+			[]string{"--batch-size", "1"},
+			true,
+			listObjectVersionsFunc,
+			deleteObjectsFunc,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success when already empty",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.ListObjectVersionsInput, optFns ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error) {
+				return &s3.ListObjectVersionsOutput{IsTruncated: aws.Bool(false)}, nil
+			},
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			[]string{},
+			true,
+			listObjectVersionsFunc,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by invalid batch size",
+			[]string{"--batch-size", "1001"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by list error",
+			[]string{},
+			false,
+			func(ctx context.Context, params *s3.ListObjectVersionsInput, optFns ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by objects that could not be deleted",
+			[]string{},
+			false,
+			listObjectVersionsFunc,
+			func(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
+				return &s3.DeleteObjectsOutput{Errors: []types.Error{{Key: aws.String("file1.txt"), Code: aws.String("AccessDenied")}}}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated process",
+			[]string{},
+			false,
+			listObjectVersionsFunc,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListObjectVersionsAPI = tc.listObjectVersionsFunc
+		mockS3.DeleteObjectsAPI = tc.deleteObjectsFunc
+
+		EmptyCmd.SetContext(context.WithValue(EmptyCmd.Context(), options.S3ClientKey{}, mockS3))
+		EmptyCmd.SetContext(context.WithValue(EmptyCmd.Context(), options.OptsKey{}, rootOpts))
+		EmptyCmd.SetContext(context.WithValue(EmptyCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		EmptyCmd.SetArgs(tc.args)
+
+		err := EmptyCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		bucketOpts.SetZeroValues()
+	}
+}
@@ -0,0 +1,69 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/spf13/cobra"
+)
+
+// MaxBatchSize is the maximum number of objects that can be deleted with a single DeleteObjects call
+const MaxBatchSize = 1000
+
+type BucketOptsKey struct{}
+
+var bucketOpts = &BucketOptions{BatchSize: MaxBatchSize}
+
+// BucketOptions contains frequent command line and application options.
+type BucketOptions struct {
+	// ObjectLock enables Object Lock on the bucket while creating it, it can not be enabled afterwards
+	ObjectLock bool
+	// Versioning enables versioning on the bucket after creating it
+	Versioning bool
+	// Tags are the tags to attach to the bucket after creating it
+	Tags map[string]string
+	// Encryption is the default server-side encryption algorithm of the bucket, empty string keeps the AWS default
+	Encryption string
+	// KmsKeyID is the ID or ARN of the KMS key for the "aws:kms" and "aws:kms:dsse" algorithms
+	KmsKeyID string
+	// BatchSize is the number of objects to delete with a single request while emptying the bucket
+	BatchSize int
+	// ConfirmBucketName is the name of the bucket to delete, it confirms the deletion instead of the prompt
+	ConfirmBucketName string
+	*options.RootOptions
+}
+
+func (opts *BucketOptions) InitCreateFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&opts.ObjectLock, "object-lock", "", false, "enables Object Lock on the bucket, "+
+		"which also enables versioning (default false)")
+	cmd.Flags().BoolVarP(&opts.Versioning, "versioning", "", false, "enables versioning on the bucket (default false)")
+	cmd.Flags().StringToStringVarP(&opts.Tags, "tags", "", map[string]string{}, "comma separated key=value "+
+		"pairs to attach to the bucket as tags")
+	cmd.Flags().StringVarP(&opts.Encryption, "encryption", "", "", "default server-side encryption algorithm "+
+		"of the bucket, valid values are AES256, aws:kms and aws:kms:dsse (default AWS managed SSE-S3)")
+	cmd.Flags().StringVarP(&opts.KmsKeyID, "kms-key-id", "", "", "ID or ARN of the KMS key for the aws:kms "+
+		"and aws:kms:dsse algorithms, empty string means the AWS managed key \"aws/s3\"")
+}
+
+func (opts *BucketOptions) InitEmptyFlags(cmd *cobra.Command) {
+	cmd.Flags().IntVarP(&opts.BatchSize, "batch-size", "", MaxBatchSize, "number of objects to delete with "+
+		"a single request, must be between 1 and 1000")
+}
+
+func (opts *BucketOptions) InitDeleteFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.ConfirmBucketName, "confirm-bucket-name", "", "", "name of the target bucket "+
+		"to confirm the deletion without the prompt, it is required with '--auto-approve'")
+}
+
+// GetBucketOptions returns the pointer of BucketOptions
+func GetBucketOptions() *BucketOptions {
+	return bucketOpts
+}
+
+func (opts *BucketOptions) SetZeroValues() {
+	opts.ObjectLock = false
+	opts.Versioning = false
+	opts.Tags = map[string]string{}
+	opts.Encryption = ""
+	opts.KmsKeyID = ""
+	opts.BatchSize = MaxBatchSize
+	opts.ConfirmBucketName = ""
+}
@@ -0,0 +1,64 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBucketOptions(t *testing.T) {
+	opts := GetBucketOptions()
+	assert.NotNil(t, opts)
+	assert.Equal(t, MaxBatchSize, opts.BatchSize)
+	assert.Empty(t, opts.ConfirmBucketName)
+}
+
+func TestBucketOptions_InitCreateFlags(t *testing.T) {
+	cmd := cobra.Command{}
+	opts := GetBucketOptions()
+	opts.InitCreateFlags(&cmd)
+
+	for _, name := range []string{"object-lock", "versioning", "tags", "encryption", "kms-key-id"} {
+		assert.NotNil(t, cmd.Flags().Lookup(name))
+	}
+}
+
+func TestBucketOptions_InitEmptyFlags(t *testing.T) {
+	cmd := cobra.Command{}
+	opts := GetBucketOptions()
+	opts.InitEmptyFlags(&cmd)
+
+	assert.NotNil(t, cmd.Flags().Lookup("batch-size"))
+}
+
+func TestBucketOptions_InitDeleteFlags(t *testing.T) {
+	cmd := cobra.Command{}
+	opts := GetBucketOptions()
+	opts.InitDeleteFlags(&cmd)
+
+	assert.NotNil(t, cmd.Flags().Lookup("confirm-bucket-name"))
+}
+
+func TestBucketOptions_SetZeroValues(t *testing.T) {
+	opts := GetBucketOptions()
+	assert.NotNil(t, opts)
+
+	opts.ObjectLock = true
+	opts.Versioning = true
+	opts.Tags = map[string]string{"foo": "bar"}
+	opts.Encryption = "aws:kms"
+	opts.KmsKeyID = "foo"
+	opts.BatchSize = 10
+	opts.ConfirmBucketName = "foo"
+	opts.SetZeroValues()
+
+	assert.False(t, opts.ObjectLock)
+	assert.False(t, opts.Versioning)
+	assert.Empty(t, opts.Tags)
+	assert.Empty(t, opts.Encryption)
+	assert.Empty(t, opts.KmsKeyID)
+	assert.Equal(t, MaxBatchSize, opts.BatchSize)
+}
@@ -0,0 +1,170 @@
+package utils
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/bucket/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/tagging"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxTags is the maximum number of tags that a bucket can have
+	MaxTags = tagging.MaxTags
+	// MaxTagKeyLength is the maximum length of a tag key
+	MaxTagKeyLength = tagging.MaxKeyLength
+	// MaxTagValueLength is the maximum length of a tag value
+	MaxTagValueLength = tagging.MaxValueLength
+
+	ErrInvalidBatchSize  = "batch size must be between 1 and %d, got %d"
+	ErrKmsKeyWithoutKms  = "'--kms-key-id' flag can only be used with aws:kms and aws:kms:dsse encryption algorithms"
+	ErrBucketNotEmpty    = "target bucket is not empty, empty it first with 's3-manager bucket empty'"
+	ErrObjectsNotDeleted = "%d objects could not be deleted"
+	ErrDescribeFailed    = "none of the settings of target bucket could be fetched"
+	ErrConfirmRequired   = "'--confirm-bucket-name' flag must be specified with '--auto-approve' to delete target bucket"
+	ErrConfirmMismatch   = "'--confirm-bucket-name' flag %q does not match the name of target bucket %q"
+
+	WarnAlreadyEmpty  = "target bucket is already empty, skipping"
+	WarnPartialReport = "some settings could not be fetched and are reported as unknown"
+
+	InfWillCreate = "will attempt to create target bucket with below configuration"
+	InfCreated    = "successfully created target bucket"
+	InfWillEmpty  = "will attempt to delete all the objects, versions and delete markers of target bucket"
+	InfEmptied    = "successfully emptied target bucket"
+	InfWillDelete = "will attempt to delete target bucket"
+	InfDeleted    = "successfully deleted target bucket"
+)
+
+var bucketNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+// ValidateBucketName checks the name against the bucket naming rules of S3 for general purpose buckets.
+func ValidateBucketName(name string) error {
+	if !bucketNameRegex.MatchString(name) {
+		return fmt.Errorf("bucket name %q must be 3-63 characters long and can only contain lowercase letters, "+
+			"numbers, dots and hyphens, beginning and ending with a letter or number", name)
+	}
+
+	if strings.Contains(name, "..") {
+		return fmt.Errorf("bucket name %q can not contain two adjacent dots", name)
+	}
+
+	if net.ParseIP(name) != nil {
+		return fmt.Errorf("bucket name %q can not be formatted as an IP address", name)
+	}
+
+	for _, prefix := range []string{"xn--", "sthree-", "sthree-configurator"} {
+		if strings.HasPrefix(name, prefix) {
+			return fmt.Errorf("bucket name %q can not start with %q", name, prefix)
+		}
+	}
+
+	for _, suffix := range []string{"-s3alias", "--ol-s3"} {
+		if strings.HasSuffix(name, suffix) {
+			return fmt.Errorf("bucket name %q can not end with %q", name, suffix)
+		}
+	}
+
+	return nil
+}
+
+// ValidateCreate checks the options of the create command before any request is sent to S3.
+func ValidateCreate(opts *options.BucketOptions) error {
+	if err := ValidateBucketName(opts.BucketName); err != nil {
+		return err
+	}
+
+	if err := ValidateEncryption(opts.Encryption, opts.KmsKeyID); err != nil {
+		return err
+	}
+
+	return ValidateTags(opts.Tags)
+}
+
+// ValidateEncryption checks the default encryption algorithm and the KMS key of a bucket, an empty algorithm
+// keeps the AWS default.
+func ValidateEncryption(algorithm, kmsKeyID string) error {
+	if algorithm != "" {
+		valid := false
+		for _, v := range types.ServerSideEncryption("").Values() {
+			if string(v) == algorithm {
+				valid = true
+				break
+			}
+		}
+
+		if !valid {
+			return fmt.Errorf("unsupported encryption algorithm %q, valid values are AES256, aws:kms and "+
+				"aws:kms:dsse", algorithm)
+		}
+	}
+
+	if kmsKeyID != "" && !strings.HasPrefix(algorithm, string(types.ServerSideEncryptionAwsKms)) {
+		return errors.New(ErrKmsKeyWithoutKms)
+	}
+
+	return nil
+}
+
+// ValidateTags checks the tags of a bucket against the tagging limits of S3.
+func ValidateTags(tags map[string]string) error {
+	return tagging.Validate(tags)
+}
+
+// ValidateBatchSize checks the batch size of the empty command against the limit of DeleteObjects.
+func ValidateBatchSize(batchSize int) error {
+	if batchSize < 1 || batchSize > options.MaxBatchSize {
+		return fmt.Errorf(ErrInvalidBatchSize, options.MaxBatchSize, batchSize)
+	}
+
+	return nil
+}
+
+// ValidateDelete checks the confirmation of the delete command. '--auto-approve' does not skip the confirmation of
+// the bucket name, it must be given with '--confirm-bucket-name' instead of the prompt.
+func ValidateDelete(opts *options.BucketOptions) error {
+	if opts.ConfirmBucketName == "" {
+		if opts.AutoApprove {
+			return errors.New(ErrConfirmRequired)
+		}
+
+		return nil
+	}
+
+	if opts.ConfirmBucketName != opts.BucketName {
+		return fmt.Errorf(ErrConfirmMismatch, opts.ConfirmBucketName, opts.BucketName)
+	}
+
+	return nil
+}
+
+// FormatCreatePlan returns the human-readable lines that describe the bucket that the create command will create.
+func FormatCreatePlan(opts *options.BucketOptions) []string {
+	encryption := "AES256 (AWS default)"
+	if opts.Encryption != "" {
+		encryption = opts.Encryption
+		if opts.KmsKeyID != "" {
+			encryption = fmt.Sprintf("%s (kmsKeyId=%s)", opts.Encryption, opts.KmsKeyID)
+		}
+	}
+
+	tags := make([]string, 0, len(opts.Tags))
+	for key, value := range opts.Tags {
+		tags = append(tags, key+"="+value)
+	}
+
+	sort.Strings(tags)
+
+	return []string{
+		fmt.Sprintf("name: %s", opts.BucketName),
+		fmt.Sprintf("region: %s", opts.Region),
+		fmt.Sprintf("object lock: %t", opts.ObjectLock),
+		fmt.Sprintf("versioning: %t", opts.Versioning || opts.ObjectLock),
+		fmt.Sprintf("encryption: %s", encryption),
+		fmt.Sprintf("tags: %s", strings.Join(tags, ",")),
+	}
+}
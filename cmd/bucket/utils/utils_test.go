@@ -0,0 +1,141 @@
+//go:build unit
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/bucket/options"
+	rootoptions "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateBucketName(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		name       string
+		shouldPass bool
+	}{
+		{"Success", "thevpnbeast-releases-1", true},
+		{"Success with dots", "logs.example.com", true},
+		{"Failure too short", "ab", false},
+		{"Failure too long", strings.Repeat("a", 64), false},
+		{"Failure uppercase", "MyBucket", false},
+		{"Failure underscore", "my_bucket", false},
+		{"Failure starts with hyphen", "-bucket", false},
+		{"Failure adjacent dots", "my..bucket", false},
+		{"Failure ip address", "192.168.5.4", false},
+		{"Failure reserved prefix", "xn--bucket", false},
+		{"Failure reserved suffix", "bucket-s3alias", false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		err := ValidateBucketName(tc.name)
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestValidateCreate(t *testing.T) {
+	tooManyTags := make(map[string]string)
+	for i := 0; i <= MaxTags; i++ {
+		tooManyTags[fmt.Sprintf("key%d", i)] = "value"
+	}
+
+	cases := []struct {
+		caseName   string
+		bucketName string
+		encryption string
+		kmsKeyID   string
+		tags       map[string]string
+		shouldPass bool
+	}{
+		{"Success", "thisisbucketname", "", "", map[string]string{"team": "platform"}, true},
+		{"Success with kms key", "thisisbucketname", "aws:kms", "alias/foo", nil, true},
+		{"Success with dsse", "thisisbucketname", "aws:kms:dsse", "", nil, true},
+		{"Failure invalid name", "This_Is_Bucket", "", "", nil, false},
+		{"Failure unsupported encryption", "thisisbucketname", "DES", "", nil, false},
+		{"Failure kms key without kms", "thisisbucketname", "AES256", "alias/foo", nil, false},
+		{"Failure too many tags", "thisisbucketname", "", "", tooManyTags, false},
+		{"Failure empty tag key", "thisisbucketname", "", "", map[string]string{"": "foo"}, false},
+		{"Failure long tag value", "thisisbucketname", "", "", map[string]string{"foo": strings.Repeat("a", MaxTagValueLength+1)}, false},
+		{"Failure reserved tag key", "thisisbucketname", "", "", map[string]string{"aws:foo": "bar"}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		err := ValidateCreate(&options.BucketOptions{
+			Encryption:  tc.encryption,
+			KmsKeyID:    tc.kmsKeyID,
+			Tags:        tc.tags,
+			RootOptions: &rootoptions.RootOptions{BucketName: tc.bucketName},
+		})
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestValidateBatchSize(t *testing.T) {
+	assert.Nil(t, ValidateBatchSize(1))
+	assert.Nil(t, ValidateBatchSize(options.MaxBatchSize))
+	assert.NotNil(t, ValidateBatchSize(0))
+	assert.NotNil(t, ValidateBatchSize(options.MaxBatchSize+1))
+}
+
+func TestValidateDelete(t *testing.T) {
+	cases := []struct {
+		caseName          string
+		confirmBucketName string
+		autoApprove       bool
+		shouldPass        bool
+	}{
+		{"Success with prompt", "", false, true},
+		{"Success with confirm bucket name", "thisisbucketname", false, true},
+		{"Success with confirm bucket name and auto approve", "thisisbucketname", true, true},
+		{"Failure caused by auto approve without confirm bucket name", "", true, false},
+		{"Failure caused by confirm bucket name mismatch", "foo", true, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts := rootoptions.GetMockedRootOptions()
+		rootOpts.AutoApprove = tc.autoApprove
+		err := ValidateDelete(&options.BucketOptions{RootOptions: rootOpts, ConfirmBucketName: tc.confirmBucketName})
+		assert.Equal(t, tc.shouldPass, err == nil)
+	}
+}
+
+func TestFormatCreatePlan(t *testing.T) {
+	plan := FormatCreatePlan(&options.BucketOptions{
+		ObjectLock:  true,
+		Tags:        map[string]string{"team": "platform", "env": "prod"},
+		Encryption:  "aws:kms",
+		KmsKeyID:    "alias/foo",
+		RootOptions: &rootoptions.RootOptions{BucketName: "thisisbucketname", Region: "eu-west-1"},
+	})
+
+	assert.Equal(t, []string{
+		"name: thisisbucketname",
+		"region: eu-west-1",
+		"object lock: true",
+		"versioning: true",
+		"encryption: aws:kms (kmsKeyId=alias/foo)",
+		"tags: env=prod,team=platform",
+	}, plan)
+
+	plan = FormatCreatePlan(&options.BucketOptions{RootOptions: &rootoptions.RootOptions{BucketName: "thisisbucketname"}})
+	assert.Equal(t, "encryption: AES256 (AWS default)", plan[4])
+	assert.Equal(t, "versioning: false", plan[3])
+}
@@ -4,14 +4,14 @@ import (
 	"fmt"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
 	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/policy"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
-	"io"
-	"os"
 
 	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
 
 	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/options"
+	bucketpolicyutils "github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/utils"
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
 )
@@ -32,6 +32,9 @@ var (
 		SilenceErrors: true,
 		Example: `# add a bucket policy configuration onto target bucket
 s3-manager bucketpolicy add my_custom_policy.json
+
+# add a bucket policy generated from a template, the approval prompt can not read the piped standard input
+s3-manager bucketpolicy template deny-insecure-transport | s3-manager bucketpolicy add - --auto-approve
 		`,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			var rootOpts *rootopts.RootOptions
@@ -46,29 +49,46 @@ s3-manager bucketpolicy add my_custom_policy.json
 			logger = logger.With().Str("policyFilePath", args[0]).Logger()
 
 			logger.Info().Msg("trying to read target policy file")
-			file, err := os.Open(args[0])
+			content, err := bucketpolicyutils.ReadPolicyFile(args[0])
 			if err != nil {
-				logger.Error().Err(err).Msg("an error occurred while opening target policy file")
+				logger.Error().Err(err).Msg("an error occurred while reading target policy file")
 				return err
 			}
 
-			defer func() {
-				if err := file.Close(); err != nil {
-					logger.Warn().Err(err).Msg("an error occurred while closing target policy file")
-				}
-			}()
+			logger.Info().Msg("successfully read target policy file")
+			bucketPolicyOpts.BucketPolicyContent = string(content)
+
+			findings := policy.Lint(bucketPolicyOpts.BucketPolicyContent, bucketPolicyOpts.BucketName)
+			if err := bucketpolicyutils.ReportFindings(findings, logger); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
 
-			content, err := io.ReadAll(file)
+			current, err := aws.GetBucketPolicyDocument(svc, bucketPolicyOpts)
 			if err != nil {
 				logger.Error().Msg(err.Error())
 				return err
 			}
 
-			logger.Info().Msg("successfully read target policy file")
-			bucketPolicyOpts.BucketPolicyContent = string(content)
+			desired, err := policy.Parse(bucketPolicyOpts.BucketPolicyContent)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			changes, err := bucketpolicyutils.Diff(current, desired)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if changes == "" {
+				logger.Warn().Msg(bucketpolicyutils.InfNoChanges)
+				return nil
+			}
 
-			logger.Info().Msg("will attempt to add below bucket policy")
-			fmt.Println(bucketPolicyOpts.BucketPolicyContent)
+			logger.Info().Msg(bucketpolicyutils.InfWillSet)
+			fmt.Println(changes)
 
 			logger.Info().Msg("trying to add bucket policy")
 			_, err = aws.SetBucketPolicy(svc, bucketPolicyOpts, confirmRunner, logger)
@@ -7,15 +7,27 @@ import (
 	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"os"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
 	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
 	"github.com/stretchr/testify/assert"
 )
 
+func getBucketPolicyFunc(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "NoSuchBucketPolicy"}
+}
+
 func TestExecuteAddCmd(t *testing.T) {
 	rootOpts := options.GetMockedRootOptions()
+	// resources of the policy in testdata belong to that bucket, they are validated before setting the policy
+	rootOpts.BucketName = "thevpnbeast-releases-1"
+
+	content, err := os.ReadFile("../../../testdata/bucketpolicy.json")
+	assert.Nil(t, err)
 
 	ctx := context.Background()
 	AddCmd.SetContext(ctx)
@@ -24,6 +36,7 @@ func TestExecuteAddCmd(t *testing.T) {
 		caseName            string
 		args                []string
 		shouldPass          bool
+		getBucketPolicyFunc func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error)
 		putBucketPolicyFunc func(ctx context.Context, params *s3.PutBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.PutBucketPolicyOutput, error)
 		prompt.PromptRunner
 		dryRun      bool
@@ -33,6 +46,7 @@ func TestExecuteAddCmd(t *testing.T) {
 			"Success",
 			[]string{"../../../testdata/bucketpolicy.json"},
 			true,
+			getBucketPolicyFunc,
 			func(ctx context.Context, params *s3.PutBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.PutBucketPolicyOutput, error) {
 				return &s3.PutBucketPolicyOutput{}, nil
 			},
@@ -43,10 +57,35 @@ func TestExecuteAddCmd(t *testing.T) {
 			false,
 			false,
 		},
+		{
+			"Success when already at desired state",
+			[]string{"../../../testdata/bucketpolicy.json"},
+			true,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return &s3.GetBucketPolicyOutput{Policy: aws.String(string(content))}, nil
+			},
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by get error",
+			[]string{"../../../testdata/bucketpolicy.json"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
 		{
 			"Failure",
 			[]string{"../../../testdata/bucketpolicy.json"},
 			false,
+			getBucketPolicyFunc,
 			func(ctx context.Context, params *s3.PutBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.PutBucketPolicyOutput, error) {
 				return nil, constants.ErrInjected
 			},
@@ -57,11 +96,22 @@ func TestExecuteAddCmd(t *testing.T) {
 			false,
 			false,
 		},
+		{
+			"Failure caused by invalid policy",
+			[]string{"../../../testdata/file1.txt"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
 		{
 			"Failure caused by target file not found",
 			[]string{"../../../testdata/bucketpolicy.jsonnnn"},
 			false,
 			nil,
+			nil,
 			prompt.PromptMock{
 				Msg: "y",
 				Err: nil,
@@ -75,6 +125,7 @@ func TestExecuteAddCmd(t *testing.T) {
 			false,
 			nil,
 			nil,
+			nil,
 			false,
 			false,
 		},
@@ -84,6 +135,7 @@ func TestExecuteAddCmd(t *testing.T) {
 			false,
 			nil,
 			nil,
+			nil,
 			false,
 			false,
 		},
@@ -96,6 +148,7 @@ func TestExecuteAddCmd(t *testing.T) {
 		rootOpts.AutoApprove = tc.autoApprove
 
 		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketPolicyAPI = tc.getBucketPolicyFunc
 		mockS3.PutBucketPolicyAPI = tc.putBucketPolicyFunc
 
 		AddCmd.SetContext(context.WithValue(AddCmd.Context(), options.S3ClientKey{}, mockS3))
@@ -104,7 +157,7 @@ func TestExecuteAddCmd(t *testing.T) {
 
 		AddCmd.SetArgs(tc.args)
 
-		err := AddCmd.Execute()
+		err = AddCmd.Execute()
 		if tc.shouldPass {
 			assert.Nil(t, err)
 		} else {
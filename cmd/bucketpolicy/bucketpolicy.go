@@ -4,6 +4,10 @@ import (
 	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/add"
 	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/remove"
 	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/show"
+	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/simulate"
+	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/statement"
+	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/template"
+	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/validate"
 
 	"github.com/spf13/cobra"
 )
@@ -12,6 +16,10 @@ func init() {
 	BucketPolicyCmd.AddCommand(show.ShowCmd)
 	BucketPolicyCmd.AddCommand(add.AddCmd)
 	BucketPolicyCmd.AddCommand(remove.RemoveCmd)
+	BucketPolicyCmd.AddCommand(statement.StatementCmd)
+	BucketPolicyCmd.AddCommand(validate.ValidateCmd)
+	BucketPolicyCmd.AddCommand(template.TemplateCmd)
+	BucketPolicyCmd.AddCommand(simulate.SimulateCmd)
 }
 
 var (
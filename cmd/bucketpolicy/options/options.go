@@ -2,6 +2,7 @@ package options
 
 import (
 	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/spf13/cobra"
 )
 
 type BucketPolicyOptsKey struct{}
@@ -10,9 +11,57 @@ var bucketPolicyOpts = &BucketPolicyOptions{}
 
 type BucketPolicyOptions struct {
 	BucketPolicyContent string
+	// KmsKeyArn is the ARN of the only KMS key that is allowed by the enforce-sse-kms template
+	KmsKeyArn string
+	// DistributionArn is the ARN of the CloudFront distribution of the cloudfront-oac template
+	DistributionArn string
+	// AccountID is the AWS account that the read-only-account template grants read access
+	AccountID string
+	// VpcEndpointID is the VPC endpoint that the restrict-vpc-endpoint template restricts the access to
+	VpcEndpointID string
+	// Principal is the ARN of the principal whose request is simulated, "*" is the anonymous principal
+	Principal string
+	// Action is the action of the simulated request such as s3:GetObject
+	Action string
+	// Key is the object key of the simulated request, the request targets the bucket itself if it is empty
+	Key string
+	// PolicyFile is the proposed policy to simulate the request against instead of the current bucket policy
+	PolicyFile string
+	// SourceIP is the IP address that the simulated request is sent from
+	SourceIP string
+	// InsecureTransport simulates a request that is not sent over TLS
+	InsecureTransport bool
+	// Context is the additional condition keys of the simulated request
+	Context map[string]string
 	*options.RootOptions
 }
 
+func (opts *BucketPolicyOptions) InitTemplateFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.KmsKeyArn, "kms-key-arn", "", "", "ARN of the only KMS key that is "+
+		"allowed for uploads, used by enforce-sse-kms template, any KMS key is allowed if not specified")
+	cmd.Flags().StringVarP(&opts.DistributionArn, "distribution-arn", "", "", "ARN of the CloudFront "+
+		"distribution that reads the objects, required by cloudfront-oac template")
+	cmd.Flags().StringVarP(&opts.AccountID, "account-id", "", "", "ID of the AWS account that is granted "+
+		"read-only access, required by read-only-account template")
+	cmd.Flags().StringVarP(&opts.VpcEndpointID, "vpc-endpoint-id", "", "", "ID of the VPC endpoint that "+
+		"the access is restricted to, required by restrict-vpc-endpoint template")
+}
+
+func (opts *BucketPolicyOptions) InitSimulateFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.Principal, "principal", "", "*", "ARN of the principal or the name of "+
+		"the service principal that sends the request, the anonymous principal is used if not specified")
+	cmd.Flags().StringVarP(&opts.Action, "action", "", "", "action of the request such as s3:GetObject")
+	cmd.Flags().StringVarP(&opts.Key, "key", "", "", "object key of the request, the request targets the "+
+		"bucket itself if not specified")
+	cmd.Flags().StringVarP(&opts.PolicyFile, "policy-file", "", "", "proposed policy file to evaluate "+
+		"instead of the current bucket policy, \"-\" reads the standard input")
+	cmd.Flags().StringVarP(&opts.SourceIP, "source-ip", "", "", "IP address that the request is sent from")
+	cmd.Flags().BoolVarP(&opts.InsecureTransport, "insecure-transport", "", false, "simulate a request "+
+		"that is not sent over TLS")
+	cmd.Flags().StringToStringVarP(&opts.Context, "context", "", map[string]string{}, "comma separated "+
+		"key=value pairs of the additional condition keys, e.g. aws:SourceVpce=vpce-1a2b3c4d")
+}
+
 // GetBucketPolicyOptions returns the pointer of FindOptions
 func GetBucketPolicyOptions() *BucketPolicyOptions {
 	return bucketPolicyOpts
@@ -20,4 +69,15 @@ func GetBucketPolicyOptions() *BucketPolicyOptions {
 
 func (opts *BucketPolicyOptions) SetZeroValues() {
 	opts.BucketPolicyContent = ""
+	opts.KmsKeyArn = ""
+	opts.DistributionArn = ""
+	opts.AccountID = ""
+	opts.VpcEndpointID = ""
+	opts.Principal = "*"
+	opts.Action = ""
+	opts.Key = ""
+	opts.PolicyFile = ""
+	opts.SourceIP = ""
+	opts.InsecureTransport = false
+	opts.Context = map[string]string{}
 }
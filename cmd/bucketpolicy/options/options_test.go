@@ -17,5 +17,13 @@ func TestBucketPolicyOptions_SetZeroValues(t *testing.T) {
 	opts := GetBucketPolicyOptions()
 	assert.NotNil(t, opts)
 
+	opts.AccountID = "111122223333"
+	opts.VpcEndpointID = "vpce-1a2b3c4d"
+	opts.Principal = "arn:aws:iam::111122223333:root"
+	opts.InsecureTransport = true
 	opts.SetZeroValues()
+	assert.Empty(t, opts.AccountID)
+	assert.Empty(t, opts.VpcEndpointID)
+	assert.Equal(t, "*", opts.Principal)
+	assert.False(t, opts.InsecureTransport)
 }
@@ -0,0 +1,112 @@
+package simulate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/options"
+	bucketpolicyutils "github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/policy"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	bucketPolicyOpts = options.GetBucketPolicyOptions()
+	bucketPolicyOpts.InitSimulateFlags(SimulateCmd)
+}
+
+var (
+	svc              internalawstypes.S3ClientAPI
+	logger           zerolog.Logger
+	bucketPolicyOpts *options.BucketPolicyOptions
+	SimulateCmd      = &cobra.Command{
+		Use:   "simulate",
+		Short: "simulates whether a principal can do an action on the target bucket or an object in it",
+		Long: `simulates whether a principal can do an action on the target bucket or an object in it by evaluating the
+current bucket policy, or a proposed one with '--policy-file', locally. Explicit denies win over the allows, actions
+and resources are matched with their wildcards and the common condition operators such as StringEquals, StringLike,
+IpAddress and Bool are evaluated against the request context. The evaluation of each statement is printed along with
+the statement that decided the outcome. Only the bucket policy is evaluated, the identity policies of the principal,
+ACLs and the public access block are not taken into account`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# check if a role of another account can read an object
+s3-manager bucketpolicy simulate --principal arn:aws:iam::111122223333:role/reader --action s3:GetObject --key path/file.txt
+
+# check if anyone can list the bucket over plain HTTP from an IP address
+s3-manager bucketpolicy simulate --action s3:ListBucket --insecure-transport --source-ip 203.0.113.10
+
+# check a proposed policy before adding it, with an additional condition key
+s3-manager bucketpolicy simulate --policy-file my_custom_policy.json --action s3:PutObject --key uploads/file.csv --context aws:SourceVpce=vpce-1a2b3c4d
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			bucketPolicyOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while checking arguments")
+				return err
+			}
+
+			if bucketPolicyOpts.Action == "" {
+				err := errors.New(bucketpolicyutils.ErrNoAction)
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			var doc *policy.Document
+			if bucketPolicyOpts.PolicyFile != "" {
+				logger = logger.With().Str("policyFilePath", bucketPolicyOpts.PolicyFile).Logger()
+				content, err := bucketpolicyutils.ReadPolicyFile(bucketPolicyOpts.PolicyFile)
+				if err != nil {
+					logger.Error().Err(err).Msg("an error occurred while reading target policy file")
+					return err
+				}
+
+				if doc, err = policy.Parse(string(content)); err != nil {
+					logger.Error().Msg(err.Error())
+					return err
+				}
+			} else {
+				if doc, err = aws.GetBucketPolicyDocument(svc, bucketPolicyOpts); err != nil {
+					logger.Error().Msg(err.Error())
+					return err
+				}
+
+				if doc == nil {
+					err := errors.New(bucketpolicyutils.ErrNoPolicy)
+					logger.Error().Msg(err.Error())
+					return err
+				}
+			}
+
+			req := bucketpolicyutils.NewRequest(bucketPolicyOpts)
+			logger.Info().Str("principal", req.Principal).Str("action", req.Action).Str("resource", req.Resource).
+				Msg("simulating the request against the bucket policy")
+
+			res, err := doc.Simulate(req)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			for _, evaluation := range res.Evaluations {
+				fmt.Println(evaluation.String())
+			}
+
+			fmt.Println(res.String())
+
+			if res.Decision == policy.DecisionImplicitlyDenied {
+				logger.Warn().Msg(bucketpolicyutils.WarnImplicitDeny)
+			}
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,129 @@
+//go:build e2e
+
+package simulate
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteSimulateCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+	// resources of the policy in testdata belong to that bucket
+	rootOpts.BucketName = "thevpnbeast-releases-1"
+
+	content, err := os.ReadFile("../../../testdata/bucketpolicy.json")
+	assert.Nil(t, err)
+
+	getBucketPolicyFunc := func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+		return &s3.GetBucketPolicyOutput{Policy: aws.String(string(content))}, nil
+	}
+
+	ctx := context.Background()
+	SimulateCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName            string
+		args                []string
+		shouldPass          bool
+		getBucketPolicyFunc func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error)
+	}{
+		{
+			"Success with implicit deny",
+			[]string{"--action", "s3:GetObject", "--key", "path/file.txt"},
+			true,
+			getBucketPolicyFunc,
+		},
+		{
+			"Success with explicit deny",
+			[]string{"--principal", "arn:aws:iam::111122223333:role/reader", "--action", "s3:ListBucket",
+				"--insecure-transport", "--source-ip", "10.0.0.1", "--context", "aws:SourceVpce=vpce-1a2b3c4d"},
+			true,
+			getBucketPolicyFunc,
+		},
+		{
+			"Success with proposed policy",
+			[]string{"--action", "s3:GetObject", "--policy-file", "../../../testdata/bucketpolicy.json"},
+			true,
+			nil,
+		},
+		{
+			"Failure caused by missing action",
+			[]string{"--key", "path/file.txt"},
+			false,
+			nil,
+		},
+		{
+			"Failure caused by no bucket policy",
+			[]string{"--action", "s3:GetObject"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "NoSuchBucketPolicy"}
+			},
+		},
+		{
+			"Failure caused by get error",
+			[]string{"--action", "s3:GetObject"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+		{
+			"Failure caused by policy file not found",
+			[]string{"--action", "s3:GetObject", "--policy-file", "../../../testdata/bucketpolicy.jsonnnn"},
+			false,
+			nil,
+		},
+		{
+			"Failure caused by invalid policy file",
+			[]string{"--action", "s3:GetObject", "--policy-file", "../../../testdata/file1.txt"},
+			false,
+			nil,
+		},
+		{
+			"Failure caused by invalid source ip",
+			[]string{"--action", "s3:GetObject", "--source-ip", "foo"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return &s3.GetBucketPolicyOutput{Policy: aws.String(`{"Version": "2012-10-17", "Statement": {"Effect": "Allow",
+					"Principal": "*", "Action": "s3:GetObject", "Resource": "*", "Condition": {"IpAddress": {"aws:SourceIp": "10.0.0.0/8"}}}}`)}, nil
+			},
+		},
+		{
+			"Failure caused by too many arguments error",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketPolicyAPI = tc.getBucketPolicyFunc
+
+		SimulateCmd.SetContext(context.WithValue(SimulateCmd.Context(), options.S3ClientKey{}, mockS3))
+		SimulateCmd.SetContext(context.WithValue(SimulateCmd.Context(), options.OptsKey{}, rootOpts))
+		SimulateCmd.SetArgs(tc.args)
+
+		err := SimulateCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		bucketPolicyOpts.SetZeroValues()
+	}
+}
@@ -0,0 +1,108 @@
+package add
+
+import (
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/options"
+	bucketpolicyutils "github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/policy"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	bucketPolicyOpts = options.GetBucketPolicyOptions()
+}
+
+var (
+	svc              internalawstypes.S3ClientAPI
+	logger           zerolog.Logger
+	confirmRunner    prompt.PromptRunner
+	bucketPolicyOpts *options.BucketPolicyOptions
+	AddCmd           = &cobra.Command{
+		Use:   "add",
+		Short: "adds the statements in the specified file to the bucket policy of the target bucket",
+		Long: `adds the statements in the specified file to the bucket policy of the target bucket, the file can contain
+a single statement object, a list of statements or a whole bucket policy. Every statement must have a Sid that is not used in the
+bucket policy yet, the other statements of the bucket policy are kept as is. A new bucket policy is created if the
+target bucket does not have one`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# add the statements in my_statements.json to the bucket policy of target bucket
+s3-manager bucketpolicy statement add my_statements.json
+
+# add the statements of a template to the bucket policy of target bucket
+s3-manager bucketpolicy template read-only-account --account-id 111122223333 | s3-manager bucketpolicy statement add - --auto-approve
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			bucketPolicyOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 1); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while checking arguments")
+				return err
+			}
+
+			logger = logger.With().Str("statementFilePath", args[0]).Logger()
+
+			content, err := bucketpolicyutils.ReadPolicyFile(args[0])
+			if err != nil {
+				logger.Error().Err(err).Msg("an error occurred while reading target statement file")
+				return err
+			}
+
+			statements, err := policy.ParseStatements(string(content))
+			if err != nil {
+				logger.Error().Err(err).Msg("an error occurred while parsing target statement file")
+				return err
+			}
+
+			current, err := aws.GetBucketPolicyDocument(svc, bucketPolicyOpts)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			doc := bucketpolicyutils.NewDocument()
+			if current != nil {
+				doc = current.Clone()
+			}
+
+			if err := doc.AddStatements(statements); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if bucketPolicyOpts.BucketPolicyContent, err = doc.String(); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			changes, err := bucketpolicyutils.Diff(current, doc)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Msg(bucketpolicyutils.InfWillSet)
+			fmt.Println(changes)
+
+			if _, err := aws.SetBucketPolicy(svc, bucketPolicyOpts, confirmRunner, logger); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while setting bucket policy")
+				return err
+			}
+
+			if !bucketPolicyOpts.DryRun {
+				logger.Info().Msg(bucketpolicyutils.InfSuccess)
+			}
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,192 @@
+//go:build e2e
+
+package add
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func getBucketPolicyFunc(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+	content, err := os.ReadFile("../../../../testdata/bucketpolicy.json")
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3.GetBucketPolicyOutput{Policy: aws.String(string(content))}, nil
+}
+
+func TestExecuteAddCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	AddCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName            string
+		args                []string
+		shouldPass          bool
+		getBucketPolicyFunc func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error)
+		putBucketPolicyFunc func(ctx context.Context, params *s3.PutBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.PutBucketPolicyOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			[]string{"../../../../testdata/bucketpolicy_statements.json"},
+			true,
+			getBucketPolicyFunc,
+			func(ctx context.Context, params *s3.PutBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.PutBucketPolicyOutput, error) {
+				assert.Contains(t, *params.Policy, "RestrictToTLSRequestsOnly")
+				assert.Contains(t, *params.Policy, "AllowAnalyticsRead")
+				assert.Contains(t, *params.Policy, "AllowAnalyticsList")
+				return &s3.PutBucketPolicyOutput{}, nil
+			},
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success when bucket has no policy",
+			[]string{"../../../../testdata/bucketpolicy_statements.json"},
+			true,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "NoSuchBucketPolicy"}
+			},
+			func(ctx context.Context, params *s3.PutBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.PutBucketPolicyOutput, error) {
+				assert.Contains(t, *params.Policy, "2012-10-17")
+				assert.NotContains(t, *params.Policy, "RestrictToTLSRequestsOnly")
+				return &s3.PutBucketPolicyOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with dry run",
+			[]string{"../../../../testdata/bucketpolicy_statements.json"},
+			true,
+			getBucketPolicyFunc,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by duplicate Sid",
+			[]string{"../../../../testdata/bucketpolicy.json"},
+			false,
+			getBucketPolicyFunc,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by get error",
+			[]string{"../../../../testdata/bucketpolicy_statements.json"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by put error",
+			[]string{"../../../../testdata/bucketpolicy_statements.json"},
+			false,
+			getBucketPolicyFunc,
+			func(ctx context.Context, params *s3.PutBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.PutBucketPolicyOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated process",
+			[]string{"../../../../testdata/bucketpolicy_statements.json"},
+			false,
+			getBucketPolicyFunc,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by invalid statement file",
+			[]string{"../../../../testdata/file1.txt"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by target file not found",
+			[]string{"../../../../testdata/bucketpolicy_statements.jsonnnn"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by no arguments provided error",
+			[]string{},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketPolicyAPI = tc.getBucketPolicyFunc
+		mockS3.PutBucketPolicyAPI = tc.putBucketPolicyFunc
+
+		AddCmd.SetContext(context.WithValue(AddCmd.Context(), options.S3ClientKey{}, mockS3))
+		AddCmd.SetContext(context.WithValue(AddCmd.Context(), options.OptsKey{}, rootOpts))
+		AddCmd.SetContext(context.WithValue(AddCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		AddCmd.SetArgs(tc.args)
+
+		err := AddCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		bucketPolicyOpts.SetZeroValues()
+	}
+}
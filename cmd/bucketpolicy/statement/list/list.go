@@ -0,0 +1,65 @@
+package list
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/options"
+	bucketpolicyutils "github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	bucketPolicyOpts = options.GetBucketPolicyOptions()
+}
+
+var (
+	svc              internalawstypes.S3ClientAPI
+	logger           zerolog.Logger
+	bucketPolicyOpts *options.BucketPolicyOptions
+	ListCmd          = &cobra.Command{
+		Use:           "list",
+		Short:         "lists the statements of the bucket policy of the target bucket with their Sids",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# list the statements of the bucket policy of target bucket
+s3-manager bucketpolicy statement list
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			bucketPolicyOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while checking arguments")
+				return err
+			}
+
+			doc, err := aws.GetBucketPolicyDocument(svc, bucketPolicyOpts)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if doc == nil || len(doc.Statement) == 0 {
+				logger.Warn().Msg(bucketpolicyutils.InfNoStatements)
+				return nil
+			}
+
+			if duplicates := doc.DuplicateSids(); len(duplicates) > 0 {
+				logger.Warn().Str("sids", strings.Join(duplicates, ",")).Msg(bucketpolicyutils.WarnDuplicateSids)
+			}
+
+			for _, statement := range doc.Statement {
+				fmt.Println(bucketpolicyutils.FormatStatement(statement))
+			}
+
+			return nil
+		},
+	}
+)
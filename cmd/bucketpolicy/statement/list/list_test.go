@@ -0,0 +1,83 @@
+//go:build e2e
+
+package list
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteListCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	ListCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName            string
+		args                []string
+		shouldPass          bool
+		getBucketPolicyFunc func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error)
+	}{
+		{
+			"Success",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return &s3.GetBucketPolicyOutput{Policy: aws.String(`{"Version": "2012-10-17", "Statement": [
+					{"Sid": "A", "Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "*"},
+					{"Sid": "A", "Effect": "Deny", "Principal": "*", "Action": "s3:PutObject", "Resource": "*"}]}`)}, nil
+			},
+		},
+		{
+			"Success when bucket has no policy",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "NoSuchBucketPolicy"}
+			},
+		},
+		{
+			"Failure caused by get error",
+			[]string{},
+			false,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+		{
+			"Failure caused by too many arguments",
+			[]string{"foo"},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketPolicyAPI = tc.getBucketPolicyFunc
+
+		ListCmd.SetContext(context.WithValue(ListCmd.Context(), options.S3ClientKey{}, mockS3))
+		ListCmd.SetContext(context.WithValue(ListCmd.Context(), options.OptsKey{}, rootOpts))
+		ListCmd.SetArgs(tc.args)
+
+		err := ListCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		bucketPolicyOpts.SetZeroValues()
+	}
+}
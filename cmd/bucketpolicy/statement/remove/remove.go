@@ -0,0 +1,114 @@
+package remove
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/options"
+	bucketpolicyutils "github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	bucketPolicyOpts = options.GetBucketPolicyOptions()
+}
+
+var (
+	svc              internalawstypes.S3ClientAPI
+	logger           zerolog.Logger
+	confirmRunner    prompt.PromptRunner
+	bucketPolicyOpts *options.BucketPolicyOptions
+	RemoveCmd        = &cobra.Command{
+		Use:   "remove",
+		Short: "removes the statement with the specified Sid from the bucket policy of the target bucket",
+		Long: `removes the statement with the specified Sid from the bucket policy of the target bucket, the other
+statements of the bucket policy are kept as is. The bucket policy is deleted if no statements are left in it`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# remove the statement with Sid "AllowAnalyticsRead" from the bucket policy of target bucket
+s3-manager bucketpolicy statement remove AllowAnalyticsRead
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			bucketPolicyOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 1); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while checking arguments")
+				return err
+			}
+
+			logger = logger.With().Str("sid", args[0]).Logger()
+
+			current, err := aws.GetBucketPolicyDocument(svc, bucketPolicyOpts)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if current == nil {
+				err := errors.New(bucketpolicyutils.ErrNoPolicy)
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			doc := current.Clone()
+			if err := doc.RemoveStatement(args[0]); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if len(doc.Statement) == 0 {
+				changes, err := bucketpolicyutils.Diff(current, nil)
+				if err != nil {
+					logger.Error().Msg(err.Error())
+					return err
+				}
+
+				logger.Info().Msg(bucketpolicyutils.InfWillDelete)
+				fmt.Println(changes)
+				if _, err := aws.DeleteBucketPolicy(svc, bucketPolicyOpts, confirmRunner, logger); err != nil {
+					logger.Error().Err(err).Msg("an error occurred while deleting bucket policy")
+					return err
+				}
+
+				if !bucketPolicyOpts.DryRun {
+					logger.Info().Msg(bucketpolicyutils.InfSuccessDelete)
+				}
+
+				return nil
+			}
+
+			if bucketPolicyOpts.BucketPolicyContent, err = doc.String(); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			changes, err := bucketpolicyutils.Diff(current, doc)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Msg(bucketpolicyutils.InfWillSet)
+			fmt.Println(changes)
+
+			if _, err := aws.SetBucketPolicy(svc, bucketPolicyOpts, confirmRunner, logger); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while setting bucket policy")
+				return err
+			}
+
+			if !bucketPolicyOpts.DryRun {
+				logger.Info().Msg(bucketpolicyutils.InfSuccess)
+			}
+
+			return nil
+		},
+	}
+)
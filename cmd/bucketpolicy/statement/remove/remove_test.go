@@ -0,0 +1,198 @@
+//go:build e2e
+
+package remove
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+// policyWithStatements returns a bucket policy that has the statement of testdata/bucketpolicy.json and the
+// AllowAnalyticsRead statement
+const policyWithStatements = `{"Version": "2012-10-17", "Statement": [
+	{"Sid": "RestrictToTLSRequestsOnly", "Effect": "Deny", "Principal": "*", "Action": "s3:*", "Resource": "*",
+		"Condition": {"Bool": {"aws:SecureTransport": "false"}}},
+	{"Sid": "AllowAnalyticsRead", "Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::111122223333:role/analytics"},
+		"Action": "s3:GetObject", "Resource": "*"}
+]}`
+
+func getBucketPolicyFunc(content string) func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+	return func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+		return &s3.GetBucketPolicyOutput{Policy: aws.String(content)}, nil
+	}
+}
+
+func TestExecuteRemoveCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	singleStatement, err := os.ReadFile("../../../../testdata/bucketpolicy.json")
+	assert.Nil(t, err)
+
+	ctx := context.Background()
+	RemoveCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName               string
+		args                   []string
+		shouldPass             bool
+		getBucketPolicyFunc    func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error)
+		putBucketPolicyFunc    func(ctx context.Context, params *s3.PutBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.PutBucketPolicyOutput, error)
+		deleteBucketPolicyFunc func(ctx context.Context, params *s3.DeleteBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketPolicyOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			[]string{"AllowAnalyticsRead"},
+			true,
+			getBucketPolicyFunc(policyWithStatements),
+			func(ctx context.Context, params *s3.PutBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.PutBucketPolicyOutput, error) {
+				assert.Contains(t, *params.Policy, "RestrictToTLSRequestsOnly")
+				assert.NotContains(t, *params.Policy, "AllowAnalyticsRead")
+				return &s3.PutBucketPolicyOutput{}, nil
+			},
+			nil,
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success with last statement",
+			[]string{"RestrictToTLSRequestsOnly"},
+			true,
+			getBucketPolicyFunc(string(singleStatement)),
+			nil,
+			func(ctx context.Context, params *s3.DeleteBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketPolicyOutput, error) {
+				return &s3.DeleteBucketPolicyOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with dry run",
+			[]string{"AllowAnalyticsRead"},
+			true,
+			getBucketPolicyFunc(policyWithStatements),
+			nil,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by statement not found",
+			[]string{"AllowAnalyticsList"},
+			false,
+			getBucketPolicyFunc(policyWithStatements),
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by no bucket policy",
+			[]string{"AllowAnalyticsRead"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "NoSuchBucketPolicy"}
+			},
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by get error",
+			[]string{"AllowAnalyticsRead"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by put error",
+			[]string{"AllowAnalyticsRead"},
+			false,
+			getBucketPolicyFunc(policyWithStatements),
+			func(ctx context.Context, params *s3.PutBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.PutBucketPolicyOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by delete error",
+			[]string{"RestrictToTLSRequestsOnly"},
+			false,
+			getBucketPolicyFunc(string(singleStatement)),
+			nil,
+			func(ctx context.Context, params *s3.DeleteBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketPolicyOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by too many arguments",
+			[]string{"AllowAnalyticsRead", "foo"},
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketPolicyAPI = tc.getBucketPolicyFunc
+		mockS3.PutBucketPolicyAPI = tc.putBucketPolicyFunc
+		mockS3.DeleteBucketPolicyAPI = tc.deleteBucketPolicyFunc
+
+		RemoveCmd.SetContext(context.WithValue(RemoveCmd.Context(), options.S3ClientKey{}, mockS3))
+		RemoveCmd.SetContext(context.WithValue(RemoveCmd.Context(), options.OptsKey{}, rootOpts))
+		RemoveCmd.SetContext(context.WithValue(RemoveCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		RemoveCmd.SetArgs(tc.args)
+
+		err := RemoveCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		bucketPolicyOpts.SetZeroValues()
+	}
+}
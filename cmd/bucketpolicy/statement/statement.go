@@ -0,0 +1,23 @@
+package statement
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/statement/add"
+	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/statement/list"
+	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/statement/remove"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	StatementCmd.AddCommand(add.AddCmd)
+	StatementCmd.AddCommand(remove.RemoveCmd)
+	StatementCmd.AddCommand(list.ListCmd)
+}
+
+var (
+	StatementCmd = &cobra.Command{
+		Use:           "statement",
+		Short:         "adds, removes or lists the individual statements of the bucket policy by their Sids",
+		SilenceUsage:  false,
+		SilenceErrors: false,
+	}
+)
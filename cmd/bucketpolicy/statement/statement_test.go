@@ -0,0 +1,13 @@
+//go:build unit
+
+package statement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatementCmd(t *testing.T) {
+	assert.NotNil(t, StatementCmd)
+}
@@ -0,0 +1,77 @@
+package template
+
+import (
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/options"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/policy"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	bucketPolicyOpts = options.GetBucketPolicyOptions()
+	bucketPolicyOpts.InitTemplateFlags(TemplateCmd)
+}
+
+var (
+	logger           zerolog.Logger
+	bucketPolicyOpts *options.BucketPolicyOptions
+	TemplateCmd      = &cobra.Command{
+		Use:   "template",
+		Short: "generates a common bucket policy for the target bucket from a template",
+		Long: `generates a common bucket policy for the target bucket from a template and prints it to the standard output,
+so that it can be piped into the add or statement add commands with "-" as the file argument, the logs are written
+to the standard error. Available templates:
+
+  deny-insecure-transport  denies the requests that are not sent over TLS
+  enforce-sse-kms          denies the uploads that are not encrypted with SSE-KMS, optionally with the '--kms-key-arn' key
+  cloudfront-oac           allows the CloudFront distribution of '--distribution-arn' to read the objects with OAC
+  read-only-account        grants read-only access to the AWS account of '--account-id'
+  restrict-vpc-endpoint    denies the requests that are not sent through the VPC endpoint of '--vpc-endpoint-id',
+                           note that it also denies the requests from the console`,
+		ValidArgs:     policy.TemplateNames(),
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Annotations:   map[string]string{rootopts.AnnotationStdoutData: "true"},
+		Example: `# print the policy that denies the requests that are not sent over TLS
+s3-manager bucketpolicy template deny-insecure-transport
+
+# grant read-only access to an account by merging the template into the current bucket policy
+s3-manager bucketpolicy template read-only-account --account-id 111122223333 | s3-manager bucketpolicy statement add - --auto-approve
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			_, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			bucketPolicyOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 1); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while checking arguments")
+				return err
+			}
+
+			doc, err := policy.Template(args[0], policy.TemplateParams{
+				BucketName:      bucketPolicyOpts.BucketName,
+				KmsKeyArn:       bucketPolicyOpts.KmsKeyArn,
+				DistributionArn: bucketPolicyOpts.DistributionArn,
+				AccountID:       bucketPolicyOpts.AccountID,
+				VpcEndpointID:   bucketPolicyOpts.VpcEndpointID,
+			})
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if bucketPolicyOpts.BucketPolicyContent, err = doc.String(); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			fmt.Println(bucketPolicyOpts.BucketPolicyContent)
+
+			return nil
+		},
+	}
+)
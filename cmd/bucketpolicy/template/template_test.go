@@ -0,0 +1,51 @@
+//go:build e2e
+
+package template
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteTemplateCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	TemplateCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName   string
+		args       []string
+		shouldPass bool
+	}{
+		{"Success", []string{"deny-insecure-transport"}, true},
+		{"Success with kms key", []string{"enforce-sse-kms", "--kms-key-arn", "arn:aws:kms:us-east-1:111122223333:key/1234abcd"}, true},
+		{"Success with distribution", []string{"cloudfront-oac", "--distribution-arn", "arn:aws:cloudfront::111122223333:distribution/EDFDVBD6EXAMPLE"}, true},
+		{"Success with account", []string{"read-only-account", "--account-id", "111122223333"}, true},
+		{"Success with vpc endpoint", []string{"restrict-vpc-endpoint", "--vpc-endpoint-id", "vpce-1a2b3c4d"}, true},
+		{"Failure caused by missing account", []string{"read-only-account"}, false},
+		{"Failure caused by unknown template", []string{"allow-everything"}, false},
+		{"Failure caused by no arguments provided error", []string{}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		TemplateCmd.SetContext(context.WithValue(TemplateCmd.Context(), options.S3ClientKey{}, new(internalawstypes.MockS3Client)))
+		TemplateCmd.SetContext(context.WithValue(TemplateCmd.Context(), options.OptsKey{}, rootOpts))
+		TemplateCmd.SetArgs(tc.args)
+
+		err := TemplateCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		bucketPolicyOpts.SetZeroValues()
+	}
+}
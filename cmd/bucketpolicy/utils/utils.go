@@ -0,0 +1,147 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/diff"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/policy"
+	"github.com/rs/zerolog"
+)
+
+const (
+	ErrNoPolicy      = "target bucket does not have a bucket policy"
+	ErrInvalidPolicy = "bucket policy is invalid, see the errors above"
+	ErrNoAction      = "action of the request must be specified with --action flag"
+
+	InfValid          = "bucket policy is valid"
+	InfNoStatements   = "bucket policy of target bucket does not have any statements"
+	InfWillSet        = "will attempt to apply below changes on the bucket policy, + add, - remove"
+	InfNoChanges      = "bucket policy of target bucket is already in desired state, nothing to apply"
+	InfWillDelete     = "no statements left in the bucket policy, will attempt to delete it"
+	InfSuccess        = "successfully set bucket policy on target bucket"
+	InfSuccessDelete  = "successfully deleted bucket policy on target bucket"
+	WarnDuplicateSids = "bucket policy has multiple statements with the same Sid, they can not be managed individually"
+	WarnImplicitDeny  = "bucket policy does not allow the request, it can still be allowed by the identity policies " +
+		"of the principal if it belongs to the bucket owner account"
+)
+
+// ReadPolicyFile reads the policy file at the given path, the path "-" reads the standard input so that the output of
+// the template command can be piped.
+func ReadPolicyFile(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+
+	return os.ReadFile(path)
+}
+
+// Diff returns the unified diff between the current and the desired policy documents, a nil Document is treated as
+// no policy at all. The documents are compared with Document.Equal, so an empty string is returned for the documents
+// that differ only in the normalization S3 applies, the diff itself is only meant to be displayed.
+func Diff(current, desired *policy.Document) (string, error) {
+	if current == nil && desired == nil {
+		return "", nil
+	}
+
+	if current != nil && desired != nil && current.Equal(desired) {
+		return "", nil
+	}
+
+	currentContent, err := documentString(current)
+	if err != nil {
+		return "", err
+	}
+
+	desiredContent, err := documentString(desired)
+	if err != nil {
+		return "", err
+	}
+
+	if currentContent == desiredContent {
+		return "", nil
+	}
+
+	return diff.JSON(currentContent, desiredContent)
+}
+
+// NewRequest returns the simulated request of the options, it targets the object of the Key in the target bucket or the
+// bucket itself if no Key is specified.
+func NewRequest(opts *options.BucketPolicyOptions) policy.Request {
+	resource := fmt.Sprintf("arn:aws:s3:::%s", opts.BucketName)
+	if opts.Key != "" {
+		resource = fmt.Sprintf("%s/%s", resource, opts.Key)
+	}
+
+	context := map[string]string{"aws:SecureTransport": strconv.FormatBool(!opts.InsecureTransport)}
+	if opts.SourceIP != "" {
+		context["aws:SourceIp"] = opts.SourceIP
+	}
+
+	for key, value := range opts.Context {
+		context[key] = value
+	}
+
+	return policy.Request{Principal: opts.Principal, Action: opts.Action, Resource: resource, Context: context}
+}
+
+// NewDocument returns an empty policy Document to add the statements onto when the target bucket has no policy.
+func NewDocument() *policy.Document {
+	return &policy.Document{Version: policy.DefaultVersion, Statement: policy.Statements{}}
+}
+
+// FormatStatement returns the single line summary of a statement.
+func FormatStatement(statement policy.Statement) string {
+	sid := statement.Sid
+	if sid == "" {
+		sid = "-"
+	}
+
+	actions := "actions=" + strings.Join(statement.Action, ",")
+	if statement.NotAction != nil {
+		actions = "notActions=" + strings.Join(statement.NotAction, ",")
+	}
+
+	resources := "resources=" + strings.Join(statement.Resource, ",")
+	if statement.NotResource != nil {
+		resources = "notResources=" + strings.Join(statement.NotResource, ",")
+	}
+
+	return fmt.Sprintf("sid=%s, effect=%s, %s, %s", sid, statement.Effect, actions, resources)
+}
+
+// ReportFindings logs the findings of policy.Lint with their severities, and returns an error if any of them is an
+// error.
+func ReportFindings(findings []policy.Finding, logger zerolog.Logger) error {
+	for _, finding := range findings {
+		event := logger.Warn()
+		if finding.Severity == policy.SeverityError {
+			event = logger.Error()
+		}
+
+		if finding.Statement != "" {
+			event = event.Str("statement", finding.Statement)
+		}
+
+		event.Msg(finding.Message)
+	}
+
+	if policy.HasErrors(findings) {
+		return errors.New(ErrInvalidPolicy)
+	}
+
+	return nil
+}
+
+func documentString(doc *policy.Document) (string, error) {
+	if doc == nil {
+		return "", nil
+	}
+
+	return doc.String()
+}
@@ -0,0 +1,114 @@
+//go:build unit
+
+package utils
+
+import (
+	"testing"
+
+	bucketpolicyoptions "github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/diff"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/logging"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDocument(t *testing.T) {
+	doc := NewDocument()
+	assert.Equal(t, policy.DefaultVersion, doc.Version)
+	assert.Empty(t, doc.Statement)
+}
+
+func TestFormatStatement(t *testing.T) {
+	cases := []struct {
+		caseName  string
+		statement policy.Statement
+		expected  string
+	}{
+		{"Action and Resource", policy.Statement{Sid: "ReadOnly", Effect: "Allow", Action: policy.Value{"s3:GetObject", "s3:ListBucket"},
+			Resource: policy.Value{"arn:aws:s3:::thisisbucketname/*"}},
+			"sid=ReadOnly, effect=Allow, actions=s3:GetObject,s3:ListBucket, resources=arn:aws:s3:::thisisbucketname/*"},
+		{"NotAction and NotResource without Sid", policy.Statement{Effect: "Deny", NotAction: policy.Value{"s3:GetObject"},
+			NotResource: policy.Value{"*"}}, "sid=-, effect=Deny, notActions=s3:GetObject, notResources=*"},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+		assert.Equal(t, tc.expected, FormatStatement(tc.statement))
+	}
+}
+
+func TestReportFindings(t *testing.T) {
+	logger := logging.GetLogger(options.GetMockedRootOptions())
+
+	assert.Nil(t, ReportFindings(nil, logger))
+	assert.Nil(t, ReportFindings([]policy.Finding{
+		{Severity: policy.SeverityWarning, Statement: "Public", Message: "allows all the actions with *"},
+	}, logger))
+	assert.NotNil(t, ReportFindings([]policy.Finding{
+		{Severity: policy.SeverityWarning, Message: "Version is missing"},
+		{Severity: policy.SeverityError, Statement: "#1", Message: "one of Action and NotAction must be specified"},
+	}, logger))
+}
+
+func TestReadPolicyFile(t *testing.T) {
+	content, err := ReadPolicyFile("../../../testdata/bucketpolicy.json")
+	assert.Nil(t, err)
+	assert.Contains(t, string(content), "RestrictToTLSRequestsOnly")
+
+	_, err = ReadPolicyFile("../../../testdata/bucketpolicy.jsonnnn")
+	assert.NotNil(t, err)
+}
+
+func TestDiff(t *testing.T) {
+	diff.NoColor = true
+
+	current := &policy.Document{Version: policy.DefaultVersion, Statement: policy.Statements{{Sid: "A", Effect: "Allow"}}}
+	desired := &policy.Document{Version: policy.DefaultVersion, Statement: policy.Statements{{Sid: "A", Effect: "Deny"}}}
+
+	res, err := Diff(current, desired)
+	assert.Nil(t, err)
+	assert.Contains(t, res, "-       \"Effect\": \"Allow\"")
+	assert.Contains(t, res, "+       \"Effect\": \"Deny\"")
+
+	res, err = Diff(current, current)
+	assert.Nil(t, err)
+	assert.Empty(t, res)
+
+	// the normalized form that S3 returns the desired policy in is not a change
+	desired, err = policy.Parse(`{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow",
+"Principal":{"AWS":["123456789012"]},"Action":["s3:GetObject"],"Resource":"arn:aws:s3:::foo/*"}]}`)
+	assert.Nil(t, err)
+	normalized, err := policy.Parse(`{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow",
+"Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::foo/*"}]}`)
+	assert.Nil(t, err)
+
+	res, err = Diff(normalized, desired)
+	assert.Nil(t, err)
+	assert.Empty(t, res)
+
+	res, err = Diff(nil, desired)
+	assert.Nil(t, err)
+	assert.NotContains(t, res, "- ")
+
+	res, err = Diff(current, nil)
+	assert.Nil(t, err)
+	assert.NotContains(t, res, "+ ")
+}
+
+func TestNewRequest(t *testing.T) {
+	opts := &bucketpolicyoptions.BucketPolicyOptions{Principal: "*", Action: "s3:ListBucket",
+		RootOptions: options.GetMockedRootOptions()}
+	req := NewRequest(opts)
+	assert.Equal(t, "arn:aws:s3:::thisisbucketname", req.Resource)
+	assert.Equal(t, map[string]string{"aws:SecureTransport": "true"}, req.Context)
+
+	opts.Key = "path/file.txt"
+	opts.SourceIP = "10.0.0.1"
+	opts.InsecureTransport = true
+	opts.Context = map[string]string{"aws:SourceVpce": "vpce-1a2b3c4d"}
+	req = NewRequest(opts)
+	assert.Equal(t, "arn:aws:s3:::thisisbucketname/path/file.txt", req.Resource)
+	assert.Equal(t, map[string]string{"aws:SecureTransport": "false", "aws:SourceIp": "10.0.0.1",
+		"aws:SourceVpce": "vpce-1a2b3c4d"}, req.Context)
+}
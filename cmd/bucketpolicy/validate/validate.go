@@ -0,0 +1,64 @@
+package validate
+
+import (
+	"os"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/options"
+	bucketpolicyutils "github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/policy"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	bucketPolicyOpts = options.GetBucketPolicyOptions()
+}
+
+var (
+	logger           zerolog.Logger
+	bucketPolicyOpts *options.BucketPolicyOptions
+	ValidateCmd      = &cobra.Command{
+		Use:   "validate",
+		Short: "validates the specified bucket policy file offline without sending it to the target bucket",
+		Long: `validates the specified bucket policy file offline without sending it to the target bucket. The JSON structure,
+the policy grammar and the resources against the target bucket are checked as errors, the risky statements such as
+the ones that allow anyone without any conditions are reported as warnings. The same checks are done by the add
+command before setting the bucket policy`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# validate my_custom_policy.json for the target bucket
+s3-manager bucketpolicy validate my_custom_policy.json
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			_, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			bucketPolicyOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 1); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while checking arguments")
+				return err
+			}
+
+			logger = logger.With().Str("policyFilePath", args[0]).Logger()
+
+			content, err := os.ReadFile(args[0])
+			if err != nil {
+				logger.Error().Err(err).Msg("an error occurred while reading target policy file")
+				return err
+			}
+
+			bucketPolicyOpts.BucketPolicyContent = string(content)
+			findings := policy.Lint(bucketPolicyOpts.BucketPolicyContent, bucketPolicyOpts.BucketName)
+			if err := bucketpolicyutils.ReportFindings(findings, logger); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Int("warnings", len(findings)).Msg(bucketpolicyutils.InfValid)
+
+			return nil
+		},
+	}
+)
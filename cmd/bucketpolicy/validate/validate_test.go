@@ -0,0 +1,51 @@
+//go:build e2e
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteValidateCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	ValidateCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName   string
+		args       []string
+		bucketName string
+		shouldPass bool
+	}{
+		{"Success", []string{"../../../testdata/bucketpolicy.json"}, "thevpnbeast-releases-1", true},
+		{"Failure caused by resources of another bucket", []string{"../../../testdata/bucketpolicy.json"}, "thisisbucketname", false},
+		{"Failure caused by invalid json", []string{"../../../testdata/file1.txt"}, "thisisbucketname", false},
+		{"Failure caused by target file not found", []string{"../../../testdata/bucketpolicy.jsonnnn"}, "thisisbucketname", false},
+		{"Failure caused by no arguments provided error", []string{}, "thisisbucketname", false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.BucketName = tc.bucketName
+
+		ValidateCmd.SetContext(context.WithValue(ValidateCmd.Context(), options.S3ClientKey{}, new(internalawstypes.MockS3Client)))
+		ValidateCmd.SetContext(context.WithValue(ValidateCmd.Context(), options.OptsKey{}, rootOpts))
+		ValidateCmd.SetArgs(tc.args)
+
+		err := ValidateCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		bucketPolicyOpts.SetZeroValues()
+	}
+}
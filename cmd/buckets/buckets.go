@@ -0,0 +1,19 @@
+package buckets
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/buckets/list"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	BucketsCmd.AddCommand(list.ListCmd)
+}
+
+var (
+	BucketsCmd = &cobra.Command{
+		Use:           "buckets",
+		Short:         "lists all the buckets in the account",
+		SilenceUsage:  false,
+		SilenceErrors: false,
+	}
+)
@@ -0,0 +1,13 @@
+//go:build unit
+
+package buckets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketsCmd(t *testing.T) {
+	assert.NotNil(t, BucketsCmd)
+}
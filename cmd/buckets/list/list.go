@@ -0,0 +1,81 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/buckets/options"
+	bucketsutils "github.com/bilalcaliskan/s3-manager/cmd/buckets/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	bucketsOpts = options.GetBucketsOptions()
+	bucketsOpts.InitFlags(ListCmd)
+}
+
+var (
+	svc         internalawstypes.S3ClientAPI
+	logger      zerolog.Logger
+	bucketsOpts *options.BucketsOptions
+	ListCmd     = &cobra.Command{
+		Use:   "list",
+		Short: "lists all the buckets in the account with their regions and creation dates",
+		Long: `lists all the buckets in the account with their regions and creation dates, '--details' flag also reports
+the versioning, default encryption, transfer acceleration and bucket policy status of each bucket`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Annotations:   map[string]string{rootopts.AnnotationBucketNameOptional: "true"},
+		Example: `# list all the buckets in the account
+s3-manager buckets list
+
+# list all the buckets in the account with their versioning, encryption, acceleration and policy status
+s3-manager buckets list --details --concurrency 20
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			bucketsOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if bucketsOpts.Concurrency <= 0 {
+				err := errors.New(bucketsutils.ErrInvalidConcurrency)
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			infos, errs := aws.ListBuckets(svc, bucketsOpts)
+			for _, err := range errs {
+				logger.Error().Msg(err.Error())
+			}
+
+			if infos == nil && len(errs) > 0 {
+				return errs[0]
+			}
+
+			if len(infos) == 0 {
+				logger.Warn().Msg(bucketsutils.WarnNoBuckets)
+				return nil
+			}
+
+			for _, info := range infos {
+				fmt.Println(info.String(bucketsOpts.Details))
+			}
+
+			if len(errs) > 0 {
+				logger.Warn().Msg(bucketsutils.WarnPartialResult)
+			}
+
+			return nil
+		},
+	}
+)
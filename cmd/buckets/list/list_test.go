@@ -0,0 +1,131 @@
+//go:build e2e
+
+package list
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteListCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	ListCmd.SetContext(ctx)
+
+	listBucketsFunc := func(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+		return &s3.ListBucketsOutput{Buckets: []types.Bucket{
+			{Name: aws.String("thisisbucketname"), CreationDate: aws.Time(time.Now())},
+		}}, nil
+	}
+
+	cases := []struct {
+		caseName              string
+		args                  []string
+		shouldPass            bool
+		listBucketsFunc       func(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error)
+		getBucketLocationFunc func(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error)
+	}{
+		{
+			"Too many arguments",
+			[]string{"foo"},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"Invalid concurrency",
+			[]string{"--concurrency", "0"},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"Success",
+			[]string{},
+			true,
+			listBucketsFunc,
+			func(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+				return &s3.GetBucketLocationOutput{LocationConstraint: types.BucketLocationConstraintEuWest1}, nil
+			},
+		},
+		{
+			"Success with details",
+			[]string{"--details"},
+			true,
+			listBucketsFunc,
+			func(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+				return &s3.GetBucketLocationOutput{}, nil
+			},
+		},
+		{
+			"Success without buckets",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+				return &s3.ListBucketsOutput{}, nil
+			},
+			nil,
+		},
+		{
+			"Success with unknown region",
+			[]string{},
+			true,
+			listBucketsFunc,
+			func(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+		{
+			"Failure caused by list error",
+			[]string{},
+			false,
+			func(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListBucketsAPI = tc.listBucketsFunc
+		mockS3.GetBucketLocationAPI = tc.getBucketLocationFunc
+		mockS3.GetBucketVersioningAPI = func(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
+			return &s3.GetBucketVersioningOutput{}, nil
+		}
+		mockS3.GetBucketEncryptionAPI = func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+			return &s3.GetBucketEncryptionOutput{}, nil
+		}
+		mockS3.GetBucketAccelerateConfigurationAPI = func(ctx context.Context, params *s3.GetBucketAccelerateConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketAccelerateConfigurationOutput, error) {
+			return &s3.GetBucketAccelerateConfigurationOutput{}, nil
+		}
+		mockS3.GetBucketPolicyAPI = func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+			return nil, constants.ErrInjected
+		}
+
+		ListCmd.SetContext(context.WithValue(ListCmd.Context(), options.S3ClientKey{}, mockS3))
+		ListCmd.SetContext(context.WithValue(ListCmd.Context(), options.OptsKey{}, rootOpts))
+		ListCmd.SetArgs(tc.args)
+
+		err := ListCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		bucketsOpts.SetZeroValues()
+	}
+}
@@ -0,0 +1,38 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/spf13/cobra"
+)
+
+type BucketsOptsKey struct{}
+
+var bucketsOpts = &BucketsOptions{
+	Concurrency: 10,
+}
+
+// BucketsOptions contains frequent command line and application options.
+type BucketsOptions struct {
+	// Details enables fetching the versioning, encryption, acceleration and policy status of each bucket
+	Details bool
+	// Concurrency is the number of buckets whose settings are fetched in parallel
+	Concurrency int
+	*options.RootOptions
+}
+
+func (opts *BucketsOptions) InitFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&opts.Details, "details", "", false, "also report the versioning, encryption, "+
+		"transfer acceleration and bucket policy status of each bucket (default false)")
+	cmd.Flags().IntVarP(&opts.Concurrency, "concurrency", "", 10, "number of buckets whose settings are "+
+		"fetched in parallel")
+}
+
+// GetBucketsOptions returns the pointer of BucketsOptions
+func GetBucketsOptions() *BucketsOptions {
+	return bucketsOpts
+}
+
+func (opts *BucketsOptions) SetZeroValues() {
+	opts.Details = false
+	opts.Concurrency = 10
+}
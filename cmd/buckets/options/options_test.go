@@ -0,0 +1,25 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBucketsOptions(t *testing.T) {
+	opts := GetBucketsOptions()
+	assert.NotNil(t, opts)
+}
+
+func TestBucketsOptions_SetZeroValues(t *testing.T) {
+	opts := GetBucketsOptions()
+	assert.NotNil(t, opts)
+
+	opts.Details = true
+	opts.Concurrency = 1
+	opts.SetZeroValues()
+	assert.False(t, opts.Details)
+	assert.Equal(t, 10, opts.Concurrency)
+}
@@ -0,0 +1,8 @@
+package utils
+
+const (
+	ErrInvalidConcurrency = "'--concurrency' flag must be positive"
+
+	WarnNoBuckets     = "there are no buckets in the account"
+	WarnPartialResult = "some settings could not be fetched and are reported as unknown"
+)
@@ -32,6 +32,9 @@ var (
 		SilenceErrors: true,
 		Example: `# clean the desired files on target bucket
 s3-manager clean --min-size-mb=1 --max-size-mb=1000 --keep-last-n-files=2 --sort-by=lastModificationDate --order=ascending
+
+# print the equivalent lifecycle configuration of the clean settings instead of cleaning the files
+s3-manager clean --prefix=logs/ --older-than-days=30 --noncurrent-version-days=7 --expired-delete-markers --abort-incomplete-multipart-days=3 --keep-last-n-files=0 --emit-lifecycle
 		`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var err error
@@ -63,6 +66,16 @@ s3-manager clean --min-size-mb=1 --max-size-mb=1000 --keep-last-n-files=2 --sort
 				return err
 			}
 
+			if cleanOpts.OlderThanDays < 0 || cleanOpts.NoncurrentVersionDays < 0 || cleanOpts.AbortIncompleteMultipartDays < 0 {
+				err = fmt.Errorf("flags '--older-than-days', '--noncurrent-version-days' and '--abort-incomplete-multipart-days' can not be negative")
+				logger.Error().Str("error", err.Error()).Msg("an error occurred while validating flags")
+				return err
+			}
+
+			if cleanOpts.EmitLifecycle {
+				return emitLifecycle()
+			}
+
 			logger = logger.With().
 				Int("keepLastNFiles", cleanOpts.KeepLastNFiles).
 				Str("sortBy", cleanOpts.SortBy).
@@ -79,3 +92,28 @@ s3-manager clean --min-size-mb=1 --max-size-mb=1000 --keep-last-n-files=2 --sort
 		},
 	}
 )
+
+// emitLifecycle prints the lifecycle configuration that is equivalent to the clean settings, and warns about the
+// settings that can not be enforced by S3 itself.
+func emitLifecycle() error {
+	cfg, notes, err := cleaner.BuildLifecycleConfiguration(cleanOpts)
+	for _, note := range notes {
+		logger.Warn().Msg(note)
+	}
+
+	if err != nil {
+		logger.Error().Str("error", err.Error()).Msg("an error occurred while building lifecycle configuration")
+		return err
+	}
+
+	out, err := cfg.String()
+	if err != nil {
+		logger.Error().Str("error", err.Error()).Msg("an error occurred while rendering lifecycle configuration")
+		return err
+	}
+
+	logger.Info().Msg("equivalent lifecycle configuration of the clean settings is below")
+	fmt.Println(out)
+
+	return nil
+}
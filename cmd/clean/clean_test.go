@@ -67,6 +67,34 @@ func TestExecuteCleanCmd(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			"Success while emitting lifecycle configuration",
+			[]string{"--emit-lifecycle", "--prefix=logs/", "--older-than-days=30", "--expired-delete-markers"},
+			true,
+			nil,
+			nil,
+		},
+		{
+			"Failure caused by regex that can not be emitted as a prefix",
+			[]string{"--emit-lifecycle", "--regex=.*.log", "--older-than-days=30"},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"Failure caused by no lifecycle rule to emit",
+			[]string{"--emit-lifecycle"},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"Failure caused by negative days flag",
+			[]string{"--older-than-days=-1"},
+			false,
+			nil,
+			nil,
+		},
 		{
 			"Failure caused by wrong number of arguments",
 			[]string{"foo", "bar"},
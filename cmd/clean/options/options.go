@@ -17,6 +17,18 @@ type CleanOptions struct {
 	KeepLastNFiles int
 	SortBy         string
 	Order          string
+	// Prefix is the key prefix of the target files, empty string means all files
+	Prefix string
+	// OlderThanDays is the minimum age of the target files in days, 0 means no age limit
+	OlderThanDays int
+	// NoncurrentVersionDays is the number of days after which noncurrent versions expire, only used while emitting lifecycle
+	NoncurrentVersionDays int
+	// ExpiredDeleteMarkers is the flag that removes expired delete markers, only used while emitting lifecycle
+	ExpiredDeleteMarkers bool
+	// AbortIncompleteMultipartDays is the number of days after which incomplete multipart uploads are aborted, only used while emitting lifecycle
+	AbortIncompleteMultipartDays int
+	// EmitLifecycle is the flag that prints the equivalent lifecycle configuration instead of cleaning
+	EmitLifecycle bool
 	*options.RootOptions
 }
 
@@ -35,6 +47,20 @@ func (opts *CleanOptions) InitFlags(cmd *cobra.Command) {
 			"flag \"--order\", valid options are \"lastModificationDate\" and \"size\"")
 	cmd.Flags().StringVarP(&opts.Order, "order", "", "descending",
 		"specifies the ordering strategy to sort objects in the \"--sort-by\" flag, valid options are \"ascending\" and \"descending\"")
+	cmd.Flags().StringVarP(&opts.Prefix, "prefix", "", "",
+		"key prefix of the target files to clean from target bucket, empty string means all files")
+	cmd.Flags().IntVarP(&opts.OlderThanDays, "older-than-days", "", 0,
+		"minimum age in days of the target files to clean from target bucket, 0 means no age limit")
+	cmd.Flags().IntVarP(&opts.NoncurrentVersionDays, "noncurrent-version-days", "", 0,
+		"number of days after which noncurrent object versions expire, 0 means disabled, only used with "+
+			"\"--emit-lifecycle\" flag")
+	cmd.Flags().BoolVarP(&opts.ExpiredDeleteMarkers, "expired-delete-markers", "", false,
+		"removes expired object delete markers, only used with \"--emit-lifecycle\" flag")
+	cmd.Flags().IntVarP(&opts.AbortIncompleteMultipartDays, "abort-incomplete-multipart-days", "", 0,
+		"number of days after which incomplete multipart uploads are aborted, 0 means disabled, only used "+
+			"with \"--emit-lifecycle\" flag")
+	cmd.Flags().BoolVarP(&opts.EmitLifecycle, "emit-lifecycle", "", false,
+		"prints the equivalent lifecycle configuration of the given flags as JSON instead of cleaning the files")
 }
 
 func (opts *CleanOptions) SetZeroValues() {
@@ -44,6 +70,12 @@ func (opts *CleanOptions) SetZeroValues() {
 	opts.KeepLastNFiles = 2
 	opts.SortBy = "lastModificationDate"
 	opts.Order = "descending"
+	opts.Prefix = ""
+	opts.OlderThanDays = 0
+	opts.NoncurrentVersionDays = 0
+	opts.ExpiredDeleteMarkers = false
+	opts.AbortIncompleteMultipartDays = 0
+	opts.EmitLifecycle = false
 }
 
 // GetCleanOptions returns the pointer of CleanOptions
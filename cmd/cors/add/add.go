@@ -0,0 +1,84 @@
+package add
+
+import (
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/cors/options"
+	corsutils "github.com/bilalcaliskan/s3-manager/cmd/cors/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	corsOpts = options.GetCorsOptions()
+}
+
+var (
+	svc           internalawstypes.S3ClientAPI
+	logger        zerolog.Logger
+	confirmRunner prompt.PromptRunner
+	corsOpts      *options.CorsOptions
+	AddCmd        = &cobra.Command{
+		Use:           "add",
+		Short:         "adds the CORS rules in a JSON or YAML file to the existing CORS configuration of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# add the CORS rules in a JSON file to the CORS configuration of target bucket
+s3-manager cors add new_rules.json
+
+# add the CORS rules in a YAML file to the CORS configuration of target bucket
+s3-manager cors add new_rules.yaml
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			corsOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 1); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while checking arguments")
+				return err
+			}
+
+			logger = logger.With().Str("corsFilePath", args[0]).Logger()
+
+			newCfg, err := corsutils.ReadConfiguration(args[0])
+			if err != nil {
+				logger.Error().Err(err).Msg("an error occurred while reading CORS configuration")
+				return err
+			}
+
+			if corsOpts.Configuration, err = aws.GetCorsConfiguration(svc, corsOpts); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while getting CORS configuration")
+				return err
+			}
+
+			if err := corsOpts.Configuration.Add(newCfg.CORSRules...); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while adding CORS rules")
+				return err
+			}
+
+			content, err := corsOpts.Configuration.String()
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Msg(corsutils.InfWillApply)
+			fmt.Println(content)
+
+			if _, err := aws.SetBucketCors(svc, corsOpts, confirmRunner, logger); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while applying CORS configuration")
+				return err
+			}
+
+			logger.Info().Msg(corsutils.InfSuccess)
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,179 @@
+//go:build e2e
+
+package add
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func getBucketCorsOutput(id string) *s3.GetBucketCorsOutput {
+	return &s3.GetBucketCorsOutput{
+		CORSRules: []types.CORSRule{
+			{
+				ID:             aws.String(id),
+				AllowedMethods: []string{"GET"},
+				AllowedOrigins: []string{"*"},
+			},
+		},
+	}
+}
+
+func TestExecuteAddCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	AddCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName          string
+		args              []string
+		shouldPass        bool
+		getBucketCorsFunc func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error)
+		putBucketCorsFunc func(ctx context.Context, params *s3.PutBucketCorsInput, optFns ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			[]string{"../../../testdata/cors.json"},
+			true,
+			func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+				return getBucketCorsOutput("existing"), nil
+			},
+			func(ctx context.Context, params *s3.PutBucketCorsInput, optFns ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error) {
+				if len(params.CORSConfiguration.CORSRules) != 2 {
+					return nil, constants.ErrInjected
+				}
+
+				return &s3.PutBucketCorsOutput{}, nil
+			},
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success when CORS is not configured",
+			[]string{"../../../testdata/cors.yaml"},
+			true,
+			func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "NoSuchCORSConfiguration"}
+			},
+			func(ctx context.Context, params *s3.PutBucketCorsInput, optFns ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error) {
+				return &s3.PutBucketCorsOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with dry run",
+			[]string{"../../../testdata/cors.json"},
+			true,
+			func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+				return getBucketCorsOutput("existing"), nil
+			},
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by duplicate rule id",
+			[]string{"../../../testdata/cors.json"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+				return getBucketCorsOutput("frontend"), nil
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by get bucket cors error",
+			[]string{"../../../testdata/cors.json"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by put bucket cors error",
+			[]string{"../../../testdata/cors.json"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+				return getBucketCorsOutput("existing"), nil
+			},
+			func(ctx context.Context, params *s3.PutBucketCorsInput, optFns ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by target file not found",
+			[]string{"../../../testdata/cors.jsonnnn"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by too many arguments error",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketCorsAPI = tc.getBucketCorsFunc
+		mockS3.PutBucketCorsAPI = tc.putBucketCorsFunc
+
+		AddCmd.SetContext(context.WithValue(AddCmd.Context(), options.S3ClientKey{}, mockS3))
+		AddCmd.SetContext(context.WithValue(AddCmd.Context(), options.OptsKey{}, rootOpts))
+		AddCmd.SetContext(context.WithValue(AddCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		AddCmd.SetArgs(tc.args)
+
+		err := AddCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		corsOpts.SetZeroValues()
+	}
+}
@@ -0,0 +1,73 @@
+package apply
+
+import (
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/cors/options"
+	corsutils "github.com/bilalcaliskan/s3-manager/cmd/cors/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	corsOpts = options.GetCorsOptions()
+}
+
+var (
+	svc           internalawstypes.S3ClientAPI
+	logger        zerolog.Logger
+	confirmRunner prompt.PromptRunner
+	corsOpts      *options.CorsOptions
+	ApplyCmd      = &cobra.Command{
+		Use:           "apply",
+		Short:         "replaces the whole CORS configuration of the target bucket with the rules in a JSON or YAML file",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# replace the CORS configuration of target bucket with the rules in a JSON file
+s3-manager cors apply cors.json
+
+# replace the CORS configuration of target bucket with the rules in a YAML file
+s3-manager cors apply cors.yaml
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			corsOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 1); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while checking arguments")
+				return err
+			}
+
+			logger = logger.With().Str("corsFilePath", args[0]).Logger()
+
+			if corsOpts.Configuration, err = corsutils.ReadConfiguration(args[0]); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while reading CORS configuration")
+				return err
+			}
+
+			content, err := corsOpts.Configuration.String()
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Msg(corsutils.InfWillApply)
+			fmt.Println(content)
+
+			if _, err := aws.SetBucketCors(svc, corsOpts, confirmRunner, logger); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while applying CORS configuration")
+				return err
+			}
+
+			logger.Info().Msg(corsutils.InfSuccess)
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,144 @@
+//go:build e2e
+
+package apply
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteApplyCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	ApplyCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName          string
+		args              []string
+		shouldPass        bool
+		putBucketCorsFunc func(ctx context.Context, params *s3.PutBucketCorsInput, optFns ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success json",
+			[]string{"../../../testdata/cors.json"},
+			true,
+			func(ctx context.Context, params *s3.PutBucketCorsInput, optFns ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error) {
+				return &s3.PutBucketCorsOutput{}, nil
+			},
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success yaml with auto approve",
+			[]string{"../../../testdata/cors.yaml"},
+			true,
+			func(ctx context.Context, params *s3.PutBucketCorsInput, optFns ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error) {
+				return &s3.PutBucketCorsOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with dry run",
+			[]string{"../../../testdata/cors.json"},
+			true,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by invalid configuration",
+			[]string{"../../../testdata/cors_invalid.json"},
+			false,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure",
+			[]string{"../../../testdata/cors.json"},
+			false,
+			func(ctx context.Context, params *s3.PutBucketCorsInput, optFns ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by user terminated process",
+			[]string{"../../../testdata/cors.json"},
+			false,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by target file not found",
+			[]string{"../../../testdata/cors.jsonnnn"},
+			false,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by no arguments provided error",
+			[]string{},
+			false,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.PutBucketCorsAPI = tc.putBucketCorsFunc
+
+		ApplyCmd.SetContext(context.WithValue(ApplyCmd.Context(), options.S3ClientKey{}, mockS3))
+		ApplyCmd.SetContext(context.WithValue(ApplyCmd.Context(), options.OptsKey{}, rootOpts))
+		ApplyCmd.SetContext(context.WithValue(ApplyCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		ApplyCmd.SetArgs(tc.args)
+
+		err := ApplyCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		corsOpts.SetZeroValues()
+	}
+}
@@ -0,0 +1,25 @@
+package cors
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/cors/add"
+	"github.com/bilalcaliskan/s3-manager/cmd/cors/apply"
+	"github.com/bilalcaliskan/s3-manager/cmd/cors/remove"
+	"github.com/bilalcaliskan/s3-manager/cmd/cors/show"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	CorsCmd.AddCommand(show.ShowCmd)
+	CorsCmd.AddCommand(add.AddCmd)
+	CorsCmd.AddCommand(remove.RemoveCmd)
+	CorsCmd.AddCommand(apply.ApplyCmd)
+}
+
+var (
+	CorsCmd = &cobra.Command{
+		Use:           "cors",
+		Short:         "shows/sets the CORS configuration of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: false,
+	}
+)
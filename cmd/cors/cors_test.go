@@ -0,0 +1,13 @@
+//go:build unit
+
+package cors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCorsCmd(t *testing.T) {
+	assert.NotNil(t, CorsCmd)
+}
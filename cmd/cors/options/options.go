@@ -0,0 +1,43 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/cors"
+	"github.com/spf13/cobra"
+)
+
+type CorsOptsKey struct{}
+
+var corsOpts = &CorsOptions{RuleIndex: -1}
+
+// CorsOptions contains frequent command line and application options.
+type CorsOptions struct {
+	// Configuration is the desired CORS configuration of the target bucket
+	Configuration *cors.Configuration
+	// RuleID is the ID of the CORS rule to remove
+	RuleID string
+	// RuleIndex is the zero based index of the CORS rule to remove
+	RuleIndex int
+	// All removes the whole CORS configuration of the target bucket
+	All bool
+	*options.RootOptions
+}
+
+func (opts *CorsOptions) InitFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.RuleID, "id", "", "", "ID of the CORS rule to remove")
+	cmd.Flags().IntVarP(&opts.RuleIndex, "index", "", -1, "zero based index of the CORS rule to remove, "+
+		"useful for the rules without an ID")
+	cmd.Flags().BoolVarP(&opts.All, "all", "", false, "removes the whole CORS configuration of the target bucket")
+}
+
+// GetCorsOptions returns the pointer of CorsOptions
+func GetCorsOptions() *CorsOptions {
+	return corsOpts
+}
+
+func (opts *CorsOptions) SetZeroValues() {
+	opts.Configuration = nil
+	opts.RuleID = ""
+	opts.RuleIndex = -1
+	opts.All = false
+}
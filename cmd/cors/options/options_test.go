@@ -0,0 +1,42 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/cors"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCorsOptions(t *testing.T) {
+	opts := GetCorsOptions()
+	assert.NotNil(t, opts)
+}
+
+func TestCorsOptions_InitFlags(t *testing.T) {
+	cmd := cobra.Command{}
+	opts := GetCorsOptions()
+	opts.InitFlags(&cmd)
+
+	assert.NotNil(t, cmd.Flags().Lookup("id"))
+	assert.NotNil(t, cmd.Flags().Lookup("index"))
+	assert.NotNil(t, cmd.Flags().Lookup("all"))
+}
+
+func TestCorsOptions_SetZeroValues(t *testing.T) {
+	opts := GetCorsOptions()
+	assert.NotNil(t, opts)
+
+	opts.Configuration = &cors.Configuration{}
+	opts.RuleID = "foo"
+	opts.RuleIndex = 2
+	opts.All = true
+	opts.SetZeroValues()
+
+	assert.Nil(t, opts.Configuration)
+	assert.Empty(t, opts.RuleID)
+	assert.Equal(t, -1, opts.RuleIndex)
+	assert.False(t, opts.All)
+}
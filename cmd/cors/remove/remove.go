@@ -0,0 +1,129 @@
+package remove
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/cors/options"
+	corsutils "github.com/bilalcaliskan/s3-manager/cmd/cors/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	corsOpts = options.GetCorsOptions()
+	corsOpts.InitFlags(RemoveCmd)
+}
+
+var (
+	svc           internalawstypes.S3ClientAPI
+	logger        zerolog.Logger
+	confirmRunner prompt.PromptRunner
+	corsOpts      *options.CorsOptions
+	RemoveCmd     = &cobra.Command{
+		Use:           "remove",
+		Short:         "removes a single CORS rule by its ID or index, or the whole CORS configuration of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# remove the CORS rule with ID "frontend" from target bucket
+s3-manager cors remove --id frontend
+
+# remove the first CORS rule of target bucket
+s3-manager cors remove --index 0
+
+# remove the whole CORS configuration of target bucket
+s3-manager cors remove --all
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			corsOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while checking arguments")
+				return err
+			}
+
+			if err := checkFlags(); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if corsOpts.Configuration, err = aws.GetCorsConfiguration(svc, corsOpts); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while getting CORS configuration")
+				return err
+			}
+
+			if len(corsOpts.Configuration.CORSRules) == 0 {
+				logger.Warn().Msg(corsutils.InfNotConfigured)
+				return nil
+			}
+
+			if !corsOpts.All {
+				if corsOpts.RuleID != "" {
+					err = corsOpts.Configuration.RemoveByID(corsOpts.RuleID)
+				} else {
+					err = corsOpts.Configuration.RemoveByIndex(corsOpts.RuleIndex)
+				}
+
+				if err != nil {
+					logger.Error().Msg(err.Error())
+					return err
+				}
+			}
+
+			if corsOpts.All || len(corsOpts.Configuration.CORSRules) == 0 {
+				logger.Info().Msg("will attempt to remove the whole CORS configuration")
+				if _, err := aws.DeleteBucketCors(svc, corsOpts, confirmRunner, logger); err != nil {
+					logger.Error().Err(err).Msg("an error occurred while removing CORS configuration")
+					return err
+				}
+
+				logger.Info().Msg(corsutils.InfRemoved)
+
+				return nil
+			}
+
+			content, err := corsOpts.Configuration.String()
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Msg(corsutils.InfWillApply)
+			fmt.Println(content)
+
+			if _, err := aws.SetBucketCors(svc, corsOpts, confirmRunner, logger); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while applying CORS configuration")
+				return err
+			}
+
+			logger.Info().Msg(corsutils.InfSuccess)
+
+			return nil
+		},
+	}
+)
+
+func checkFlags() error {
+	selected := 0
+	for _, set := range []bool{corsOpts.RuleID != "", corsOpts.RuleIndex != -1, corsOpts.All} {
+		if set {
+			selected++
+		}
+	}
+
+	switch selected {
+	case 0:
+		return errors.New(corsutils.ErrNoRuleSelector)
+	case 1:
+		return nil
+	default:
+		return errors.New(corsutils.ErrMultipleRuleSelector)
+	}
+}
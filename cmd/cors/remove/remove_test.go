@@ -0,0 +1,275 @@
+//go:build e2e
+
+package remove
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func getBucketCorsFunc(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+	return &s3.GetBucketCorsOutput{
+		CORSRules: []types.CORSRule{
+			{
+				ID:             aws.String("frontend"),
+				AllowedMethods: []string{"GET"},
+				AllowedOrigins: []string{"*"},
+			},
+			{
+				AllowedMethods: []string{"PUT"},
+				AllowedOrigins: []string{"https://example.com"},
+			},
+		},
+	}, nil
+}
+
+func TestExecuteRemoveCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	RemoveCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName             string
+		args                 []string
+		shouldPass           bool
+		getBucketCorsFunc    func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error)
+		putBucketCorsFunc    func(ctx context.Context, params *s3.PutBucketCorsInput, optFns ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error)
+		deleteBucketCorsFunc func(ctx context.Context, params *s3.DeleteBucketCorsInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketCorsOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success by id",
+			[]string{"--id", "frontend"},
+			true,
+			getBucketCorsFunc,
+			func(ctx context.Context, params *s3.PutBucketCorsInput, optFns ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error) {
+				if len(params.CORSConfiguration.CORSRules) != 1 {
+					return nil, constants.ErrInjected
+				}
+
+				return &s3.PutBucketCorsOutput{}, nil
+			},
+			nil,
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success by index",
+			[]string{"--index", "1"},
+			true,
+			getBucketCorsFunc,
+			func(ctx context.Context, params *s3.PutBucketCorsInput, optFns ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error) {
+				return &s3.PutBucketCorsOutput{}, nil
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success remove all",
+			[]string{"--all"},
+			true,
+			getBucketCorsFunc,
+			nil,
+			func(ctx context.Context, params *s3.DeleteBucketCorsInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketCorsOutput, error) {
+				return &s3.DeleteBucketCorsOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success remove last rule",
+			[]string{"--index", "0"},
+			true,
+			func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+				return &s3.GetBucketCorsOutput{
+					CORSRules: []types.CORSRule{{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}}},
+				}, nil
+			},
+			nil,
+			func(ctx context.Context, params *s3.DeleteBucketCorsInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketCorsOutput, error) {
+				return &s3.DeleteBucketCorsOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success when CORS is not configured",
+			[]string{"--all"},
+			true,
+			func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "NoSuchCORSConfiguration"}
+			},
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with dry run",
+			[]string{"--id", "frontend"},
+			true,
+			getBucketCorsFunc,
+			nil,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by unknown id",
+			[]string{"--id", "backend"},
+			false,
+			getBucketCorsFunc,
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by index out of range",
+			[]string{"--index", "5"},
+			false,
+			getBucketCorsFunc,
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by delete error",
+			[]string{"--all"},
+			false,
+			getBucketCorsFunc,
+			nil,
+			func(ctx context.Context, params *s3.DeleteBucketCorsInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketCorsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by put error",
+			[]string{"--id", "frontend"},
+			false,
+			getBucketCorsFunc,
+			func(ctx context.Context, params *s3.PutBucketCorsInput, optFns ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by get error",
+			[]string{"--all"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated process",
+			[]string{"--all"},
+			false,
+			getBucketCorsFunc,
+			nil,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by no selector",
+			[]string{},
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by multiple selectors",
+			[]string{"--id", "frontend", "--all"},
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketCorsAPI = tc.getBucketCorsFunc
+		mockS3.PutBucketCorsAPI = tc.putBucketCorsFunc
+		mockS3.DeleteBucketCorsAPI = tc.deleteBucketCorsFunc
+
+		RemoveCmd.SetContext(context.WithValue(RemoveCmd.Context(), options.S3ClientKey{}, mockS3))
+		RemoveCmd.SetContext(context.WithValue(RemoveCmd.Context(), options.OptsKey{}, rootOpts))
+		RemoveCmd.SetContext(context.WithValue(RemoveCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		RemoveCmd.SetArgs(tc.args)
+
+		err := RemoveCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		corsOpts.SetZeroValues()
+	}
+}
@@ -0,0 +1,67 @@
+package show
+
+import (
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/cors/options"
+	corsutils "github.com/bilalcaliskan/s3-manager/cmd/cors/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	corsOpts = options.GetCorsOptions()
+}
+
+var (
+	svc      internalawstypes.S3ClientAPI
+	logger   zerolog.Logger
+	corsOpts *options.CorsOptions
+	ShowCmd  = &cobra.Command{
+		Use:           "show",
+		Short:         "shows the CORS configuration of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# show the current CORS configuration for target bucket
+s3-manager cors show
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			corsOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			cfg, err := aws.GetCorsConfiguration(svc, corsOpts)
+			if err != nil {
+				logger.Error().
+					Str("error", err.Error()).
+					Msg("an error occurred while getting CORS configuration")
+				return err
+			}
+
+			if len(cfg.CORSRules) == 0 {
+				logger.Info().Msg(corsutils.InfNotConfigured)
+				return nil
+			}
+
+			content, err := cfg.String()
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Msg("fetched CORS configuration successfully")
+			fmt.Println(content)
+
+			return nil
+		},
+	}
+)
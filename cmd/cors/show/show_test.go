@@ -0,0 +1,88 @@
+//go:build e2e
+
+package show
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteShowCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	ShowCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName          string
+		args              []string
+		shouldPass        bool
+		getBucketCorsFunc func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error)
+	}{
+		{
+			"Too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+		},
+		{
+			"Success",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+				return &s3.GetBucketCorsOutput{
+					CORSRules: []types.CORSRule{
+						{
+							AllowedMethods: []string{"GET"},
+							AllowedOrigins: []string{"*"},
+						},
+					},
+				}, nil
+			},
+		},
+		{
+			"Success when CORS is not configured",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "NoSuchCORSConfiguration"}
+			},
+		},
+		{
+			"Failure",
+			[]string{},
+			false,
+			func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketCorsAPI = tc.getBucketCorsFunc
+
+		ShowCmd.SetContext(context.WithValue(ShowCmd.Context(), options.S3ClientKey{}, mockS3))
+		ShowCmd.SetContext(context.WithValue(ShowCmd.Context(), options.OptsKey{}, rootOpts))
+		ShowCmd.SetArgs(tc.args)
+
+		err := ShowCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		corsOpts.SetZeroValues()
+	}
+}
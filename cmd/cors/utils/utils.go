@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"os"
+
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/cors"
+	"github.com/pkg/errors"
+)
+
+const (
+	ErrNoRuleSelector       = "one of '--id', '--index' or '--all' flags must be specified"
+	ErrMultipleRuleSelector = "only one of '--id', '--index' or '--all' flags can be specified"
+
+	InfNotConfigured = "target bucket does not have any CORS configuration"
+	InfSuccess       = "successfully applied CORS configuration on target bucket"
+	InfRemoved       = "successfully removed CORS configuration from target bucket"
+	InfWillApply     = "will attempt to apply below CORS configuration"
+)
+
+// ReadConfiguration reads the JSON or YAML CORS configuration file at the given path and parses it.
+func ReadConfiguration(path string) (*cors.Configuration, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "an error occurred while reading CORS configuration file")
+	}
+
+	return cors.Parse(content)
+}
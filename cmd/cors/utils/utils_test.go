@@ -0,0 +1,36 @@
+//go:build unit
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadConfiguration(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		path       string
+		shouldPass bool
+		rules      int
+	}{
+		{"Success json", "../../../testdata/cors.json", true, 1},
+		{"Success yaml", "../../../testdata/cors.yaml", true, 1},
+		{"Failure file not found", "../../../testdata/cors.jsonnnn", false, 0},
+		{"Failure invalid content", "../../../testdata/file1.txt", false, 0},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		cfg, err := ReadConfiguration(tc.path)
+		if !tc.shouldPass {
+			assert.NotNil(t, err)
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Len(t, cfg.CORSRules, tc.rules)
+	}
+}
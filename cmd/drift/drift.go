@@ -0,0 +1,91 @@
+package drift
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/drift/options"
+	driftutils "github.com/bilalcaliskan/s3-manager/cmd/drift/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/diff"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/manifest"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	driftOpts = options.GetDriftOptions()
+	driftOpts.InitFlags(DriftCmd)
+}
+
+var (
+	svc       internalawstypes.S3ClientAPI
+	logger    zerolog.Logger
+	driftOpts *options.DriftOptions
+	DriftCmd  = &cobra.Command{
+		Use:   "drift",
+		Short: "detects the drifts of the target bucket from a JSON or YAML manifest",
+		Long: `detects the drifts of the target bucket from a JSON or YAML manifest without changing anything, the current
+settings are compared with the manifest just like the apply command and the differences are printed. The process
+exits with 0 when the bucket is in sync with the manifest, 2 when it has drifted and 1 when an error occurs, so
+that it can be used in CI pipelines`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# check if the target bucket still matches bucket.yaml
+s3-manager drift -f bucket.yaml
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			driftOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if driftOpts.ManifestFile == "" {
+				err := errors.New(driftutils.ErrNoManifest)
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger = logger.With().Str("manifestFilePath", driftOpts.ManifestFile).Logger()
+
+			if driftOpts.Manifest, err = manifest.ReadFile(driftOpts.ManifestFile); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			current, err := aws.GetManifest(svc, driftOpts.RootOptions)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			changes, err := manifest.Diff(current, driftOpts.Manifest)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if len(changes) == 0 {
+				logger.Info().Msg(driftutils.InfInSync)
+				return nil
+			}
+
+			logger.Warn().Int("drifts", len(changes)).Msg(driftutils.WarnDrifts)
+			for _, change := range changes {
+				fmt.Println(diff.Colorize(change.String()))
+			}
+
+			// drifts are the expected outcome of the command, not a misuse of it
+			cmd.SilenceUsage = true
+
+			return &utils.ExitCodeError{Code: driftutils.ExitCodeDrifted, Err: errors.New(driftutils.ErrDrifted)}
+		},
+	}
+)
@@ -0,0 +1,88 @@
+//go:build e2e
+
+package drift
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMockS3 returns a client that reports a bucket with the given tag and versioning status, none of the other
+// settings of a manifest are configured
+func newMockS3(team string, versioning types.BucketVersioningStatus, versioningErr error) *internalawstypes.MockS3Client {
+	mockS3 := new(internalawstypes.MockS3Client)
+	mockS3.GetBucketTaggingAPI = func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+		return &s3.GetBucketTaggingOutput{TagSet: []types.Tag{{Key: aws.String("team"), Value: aws.String(team)}}}, nil
+	}
+	mockS3.GetBucketVersioningAPI = func(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
+		return &s3.GetBucketVersioningOutput{Status: versioning}, versioningErr
+	}
+	mockS3.GetBucketAccelerateConfigurationAPI = func(ctx context.Context, params *s3.GetBucketAccelerateConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketAccelerateConfigurationOutput, error) {
+		return &s3.GetBucketAccelerateConfigurationOutput{}, nil
+	}
+	mockS3.GetBucketPolicyAPI = func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+		return nil, &smithy.GenericAPIError{Code: "NoSuchBucketPolicy"}
+	}
+	mockS3.GetBucketLifecycleConfigurationAPI = func(ctx context.Context, params *s3.GetBucketLifecycleConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+		return nil, &smithy.GenericAPIError{Code: "NoSuchLifecycleConfiguration"}
+	}
+	mockS3.GetBucketEncryptionAPI = func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+		return &s3.GetBucketEncryptionOutput{ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+			Rules: []types.ServerSideEncryptionRule{{
+				ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{SSEAlgorithm: types.ServerSideEncryptionAes256},
+			}},
+		}}, nil
+	}
+
+	return mockS3
+}
+
+func TestExecuteDriftCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	DriftCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName string
+		args     []string
+		exitCode int
+		mockS3   *internalawstypes.MockS3Client
+	}{
+		{"Success in sync", []string{"-f", "../../testdata/manifest.json"}, 0,
+			newMockS3("platform", types.BucketVersioningStatusEnabled, nil)},
+		{"Success drifted", []string{"-f", "../../testdata/manifest.json"}, 2,
+			newMockS3("data", types.BucketVersioningStatusSuspended, nil)},
+		{"Success drifted with yaml manifest", []string{"--file", "../../testdata/manifest.yaml"}, 2,
+			newMockS3("platform", types.BucketVersioningStatusEnabled, nil)},
+		{"Failure caused by too many arguments", []string{"foo", "-f", "../../testdata/manifest.json"}, 1, nil},
+		{"Failure caused by missing manifest file flag", []string{}, 1, nil},
+		{"Failure caused by manifest file not found", []string{"-f", "../../testdata/manifest_notfound.yaml"}, 1, nil},
+		{"Failure caused by invalid manifest", []string{"-f", "../../testdata/manifest_invalid.yaml"}, 1, nil},
+		{"Failure caused by get error", []string{"-f", "../../testdata/manifest.json"}, 1,
+			newMockS3("platform", types.BucketVersioningStatusEnabled, constants.ErrInjected)},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		DriftCmd.SetContext(context.WithValue(DriftCmd.Context(), options.S3ClientKey{}, tc.mockS3))
+		DriftCmd.SetContext(context.WithValue(DriftCmd.Context(), options.OptsKey{}, rootOpts))
+		DriftCmd.SetArgs(tc.args)
+
+		err := DriftCmd.Execute()
+		assert.Equal(t, tc.exitCode, utils.ExitCode(err))
+
+		driftOpts.SetZeroValues()
+	}
+}
@@ -0,0 +1,35 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/manifest"
+	"github.com/spf13/cobra"
+)
+
+type DriftOptsKey struct{}
+
+var driftOpts = &DriftOptions{}
+
+// DriftOptions contains frequent command line and application options.
+type DriftOptions struct {
+	// ManifestFile is the path of the JSON or YAML file that declares the desired settings of the target bucket
+	ManifestFile string
+	// Manifest is the desired configuration of the target bucket parsed from ManifestFile
+	Manifest *manifest.Manifest
+	*options.RootOptions
+}
+
+func (opts *DriftOptions) InitFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.ManifestFile, "file", "f", "", "path of the JSON or YAML manifest that "+
+		"declares the desired settings of the target bucket")
+}
+
+// GetDriftOptions returns the pointer of DriftOptions
+func GetDriftOptions() *DriftOptions {
+	return driftOpts
+}
+
+func (opts *DriftOptions) SetZeroValues() {
+	opts.ManifestFile = ""
+	opts.Manifest = nil
+}
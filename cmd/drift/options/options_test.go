@@ -0,0 +1,24 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDriftOptions(t *testing.T) {
+	opts := GetDriftOptions()
+	assert.NotNil(t, opts)
+}
+
+func TestDriftOptions_SetZeroValues(t *testing.T) {
+	opts := GetDriftOptions()
+	assert.NotNil(t, opts)
+
+	opts.ManifestFile = "bucket.yaml"
+	opts.SetZeroValues()
+	assert.Empty(t, opts.ManifestFile)
+	assert.Nil(t, opts.Manifest)
+}
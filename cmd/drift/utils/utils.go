@@ -0,0 +1,13 @@
+package utils
+
+const (
+	// ExitCodeDrifted is the exit code of the process when the target bucket has drifted from the manifest, the exit
+	// code is 0 when it is in sync and 1 when an error occurs
+	ExitCodeDrifted = 2
+
+	ErrNoManifest = "'--file' flag must be specified"
+	ErrDrifted    = "target bucket has drifted from the manifest"
+
+	InfInSync  = "target bucket is in sync with the manifest"
+	WarnDrifts = "target bucket has drifted from the manifest, + missing, ~ different, - unexpected"
+)
@@ -0,0 +1,23 @@
+package encryption
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/encryption/remove"
+	"github.com/bilalcaliskan/s3-manager/cmd/encryption/set"
+	"github.com/bilalcaliskan/s3-manager/cmd/encryption/show"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	EncryptionCmd.AddCommand(show.ShowCmd)
+	EncryptionCmd.AddCommand(set.SetCmd)
+	EncryptionCmd.AddCommand(remove.RemoveCmd)
+}
+
+var (
+	EncryptionCmd = &cobra.Command{
+		Use:           "encryption",
+		Short:         "shows/sets the default encryption configuration of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: false,
+	}
+)
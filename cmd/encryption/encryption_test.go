@@ -0,0 +1,13 @@
+//go:build unit
+
+package encryption
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncryptionCmd(t *testing.T) {
+	assert.NotNil(t, EncryptionCmd)
+}
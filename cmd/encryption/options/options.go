@@ -0,0 +1,44 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/spf13/cobra"
+)
+
+type EncryptionOptsKey struct{}
+
+var encryptionOpts = &EncryptionOptions{}
+
+// State is the default encryption configuration of a bucket
+type State struct {
+	// Algorithm is the server-side encryption algorithm, valid values are "AES256", "aws:kms" and "aws:kms:dsse"
+	Algorithm string
+	// KmsKeyID is the ID or ARN of the KMS key, empty string means the AWS managed key
+	KmsKeyID string
+	// BucketKeyEnabled is the flag that reduces the KMS request costs by using an S3 Bucket Key
+	BucketKeyEnabled bool
+}
+
+// EncryptionOptions contains frequent command line and application options.
+type EncryptionOptions struct {
+	ActualState  State
+	DesiredState State
+	*options.RootOptions
+}
+
+func (opts *EncryptionOptions) InitFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.DesiredState.KmsKeyID, "kms-key-id", "", "",
+		"ID or ARN of the KMS key to encrypt objects with, empty string means the AWS managed key \"aws/s3\"")
+	cmd.Flags().BoolVarP(&opts.DesiredState.BucketKeyEnabled, "bucket-key-enabled", "", false,
+		"enables S3 Bucket Key to reduce the request costs of KMS (default false)")
+}
+
+// GetEncryptionOptions returns the pointer of EncryptionOptions
+func GetEncryptionOptions() *EncryptionOptions {
+	return encryptionOpts
+}
+
+func (opts *EncryptionOptions) SetZeroValues() {
+	opts.ActualState = State{}
+	opts.DesiredState = State{}
+}
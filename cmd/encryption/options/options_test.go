@@ -0,0 +1,33 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEncryptionOptions(t *testing.T) {
+	opts := GetEncryptionOptions()
+	assert.NotNil(t, opts)
+}
+
+func TestEncryptionOptions_InitFlags(t *testing.T) {
+	cmd := cobra.Command{}
+	opts := GetEncryptionOptions()
+	opts.InitFlags(&cmd)
+
+	assert.NotNil(t, cmd.Flags().Lookup("kms-key-id"))
+	assert.NotNil(t, cmd.Flags().Lookup("bucket-key-enabled"))
+}
+
+func TestEncryptionOptions_SetZeroValues(t *testing.T) {
+	opts := GetEncryptionOptions()
+	assert.NotNil(t, opts)
+
+	opts.DesiredState.Algorithm = "aws:kms"
+	opts.SetZeroValues()
+	assert.Equal(t, State{}, opts.DesiredState)
+}
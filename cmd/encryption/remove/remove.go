@@ -0,0 +1,75 @@
+package remove
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/encryption/options"
+	encryptionutils "github.com/bilalcaliskan/s3-manager/cmd/encryption/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	encryptionOpts = options.GetEncryptionOptions()
+}
+
+var (
+	svc            internalawstypes.S3ClientAPI
+	logger         zerolog.Logger
+	confirmRunner  prompt.PromptRunner
+	encryptionOpts *options.EncryptionOptions
+	RemoveCmd      = &cobra.Command{
+		Use:           "remove",
+		Short:         "removes the default encryption configuration of the target bucket, which resets it to SSE-S3",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# remove the current default encryption configuration of the target bucket
+s3-manager encryption remove
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			encryptionOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			res, err := aws.GetBucketEncryption(svc, encryptionOpts)
+			if err != nil {
+				logger.Error().
+					Str("error", err.Error()).
+					Msg("an error occurred while getting default encryption configuration")
+				return err
+			}
+
+			if err := encryptionutils.DecideActualState(res, encryptionOpts); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if encryptionOpts.ActualState.Algorithm == "" {
+				logger.Warn().Msg("there is no default encryption configuration to remove, skipping")
+				return nil
+			}
+
+			logger.Info().Msgf("will attempt to remove the default encryption configuration %s",
+				encryptionutils.FormatState(encryptionOpts.ActualState))
+
+			if _, err := aws.DeleteBucketEncryption(svc, encryptionOpts, confirmRunner, logger); err != nil {
+				logger.Error().
+					Str("error", err.Error()).
+					Msg("an error occurred while removing default encryption configuration")
+				return err
+			}
+
+			logger.Info().Msg("successfully removed default encryption configuration of the target bucket")
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,156 @@
+//go:build e2e
+
+package remove
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func defaultGetBucketEncryptionFunc(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+	return &s3.GetBucketEncryptionOutput{
+		ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+			Rules: []types.ServerSideEncryptionRule{
+				{
+					ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{
+						SSEAlgorithm: types.ServerSideEncryptionAwsKms,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func TestExecuteRemoveCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	RemoveCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName                   string
+		args                       []string
+		shouldPass                 bool
+		getBucketEncryptionFunc    func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error)
+		deleteBucketEncryptionFunc func(ctx context.Context, params *s3.DeleteBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketEncryptionOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Too many arguments",
+			[]string{"foo"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Success",
+			[]string{},
+			true,
+			defaultGetBucketEncryptionFunc,
+			internalawstypes.DefaultDeleteBucketEncryptionFunc,
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success when dry-run enabled",
+			[]string{},
+			true,
+			defaultGetBucketEncryptionFunc,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Success when there is nothing to remove",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+				return &s3.GetBucketEncryptionOutput{}, nil
+			},
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by get error",
+			[]string{},
+			false,
+			func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by delete error",
+			[]string{},
+			false,
+			defaultGetBucketEncryptionFunc,
+			func(ctx context.Context, params *s3.DeleteBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketEncryptionOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated the process",
+			[]string{},
+			false,
+			defaultGetBucketEncryptionFunc,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketEncryptionAPI = tc.getBucketEncryptionFunc
+		mockS3.DeleteBucketEncryptionAPI = tc.deleteBucketEncryptionFunc
+
+		RemoveCmd.SetContext(context.WithValue(RemoveCmd.Context(), options.S3ClientKey{}, mockS3))
+		RemoveCmd.SetContext(context.WithValue(RemoveCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		RemoveCmd.SetContext(context.WithValue(RemoveCmd.Context(), options.OptsKey{}, rootOpts))
+		RemoveCmd.SetArgs(tc.args)
+
+		err := RemoveCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		encryptionOpts.SetZeroValues()
+	}
+}
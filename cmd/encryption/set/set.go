@@ -0,0 +1,21 @@
+package set
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/encryption/set/ssekms"
+	"github.com/bilalcaliskan/s3-manager/cmd/encryption/set/sses3"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	SetCmd.AddCommand(sses3.SseS3Cmd)
+	SetCmd.AddCommand(ssekms.SseKmsCmd)
+}
+
+var (
+	SetCmd = &cobra.Command{
+		Use:           "set",
+		Short:         "sets the default encryption configuration for the target bucket (sse-s3/sse-kms)",
+		SilenceUsage:  false,
+		SilenceErrors: false,
+	}
+)
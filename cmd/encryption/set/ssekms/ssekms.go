@@ -0,0 +1,52 @@
+package ssekms
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/encryption/options"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	encryptionOpts = options.GetEncryptionOptions()
+	encryptionOpts.InitFlags(SseKmsCmd)
+}
+
+var (
+	svc            internalawstypes.S3ClientAPI
+	logger         zerolog.Logger
+	confirmRunner  prompt.PromptRunner
+	encryptionOpts *options.EncryptionOptions
+	SseKmsCmd      = &cobra.Command{
+		Use:           "sse-kms",
+		Short:         "sets the default encryption configuration for the target bucket as SSE-KMS (aws:kms)",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# set the default encryption configuration for bucket as SSE-KMS with the AWS managed key
+s3-manager encryption set sse-kms
+
+# set the default encryption configuration for bucket as SSE-KMS with a customer managed key and bucket key enabled
+s3-manager encryption set sse-kms --kms-key-id 1234abcd-12ab-34cd-56ef-1234567890ab --bucket-key-enabled
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			encryptionOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().
+					Msg(err.Error())
+				return err
+			}
+
+			encryptionOpts.DesiredState.Algorithm = string(types.ServerSideEncryptionAwsKms)
+
+			return aws.SetBucketEncryption(svc, encryptionOpts, confirmRunner, logger)
+		},
+	}
+)
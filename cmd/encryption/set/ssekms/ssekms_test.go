@@ -0,0 +1,158 @@
+//go:build e2e
+
+package ssekms
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func encryptionOutput(algorithm types.ServerSideEncryption, bucketKeyEnabled bool) func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+	return func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+		return &s3.GetBucketEncryptionOutput{
+			ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+				Rules: []types.ServerSideEncryptionRule{
+					{
+						ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{
+							SSEAlgorithm: algorithm,
+						},
+						BucketKeyEnabled: aws.Bool(bucketKeyEnabled),
+					},
+				},
+			},
+		}, nil
+	}
+}
+
+func TestExecuteSseKmsCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	SseKmsCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName                string
+		args                    []string
+		shouldPass              bool
+		getBucketEncryptionFunc func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error)
+		putBucketEncryptionFunc func(ctx context.Context, params *s3.PutBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Too many arguments",
+			[]string{"foo", "bar"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Success",
+			[]string{"--bucket-key-enabled"},
+			true,
+			encryptionOutput(types.ServerSideEncryptionAes256, false),
+			internalawstypes.DefaultPutBucketEncryptionFunc,
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success when dry-run enabled",
+			[]string{"--bucket-key-enabled"},
+			true,
+			nil,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Success while already at desired state",
+			[]string{"--bucket-key-enabled"},
+			true,
+			encryptionOutput(types.ServerSideEncryptionAwsKms, true),
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by get error",
+			[]string{"--bucket-key-enabled"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by put error",
+			[]string{"--bucket-key-enabled"},
+			false,
+			encryptionOutput(types.ServerSideEncryptionAes256, false),
+			func(ctx context.Context, params *s3.PutBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated the process",
+			[]string{"--bucket-key-enabled"},
+			false,
+			nil,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketEncryptionAPI = tc.getBucketEncryptionFunc
+		mockS3.PutBucketEncryptionAPI = tc.putBucketEncryptionFunc
+
+		SseKmsCmd.SetContext(context.WithValue(SseKmsCmd.Context(), options.S3ClientKey{}, mockS3))
+		SseKmsCmd.SetContext(context.WithValue(SseKmsCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		SseKmsCmd.SetContext(context.WithValue(SseKmsCmd.Context(), options.OptsKey{}, rootOpts))
+		SseKmsCmd.SetArgs(tc.args)
+
+		err := SseKmsCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		encryptionOpts.SetZeroValues()
+	}
+}
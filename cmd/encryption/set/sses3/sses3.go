@@ -0,0 +1,48 @@
+package sses3
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/encryption/options"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	encryptionOpts = options.GetEncryptionOptions()
+}
+
+var (
+	svc            internalawstypes.S3ClientAPI
+	logger         zerolog.Logger
+	confirmRunner  prompt.PromptRunner
+	encryptionOpts *options.EncryptionOptions
+	SseS3Cmd       = &cobra.Command{
+		Use:           "sse-s3",
+		Short:         "sets the default encryption configuration for the target bucket as SSE-S3 (AES256)",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# set the default encryption configuration for bucket as SSE-S3
+s3-manager encryption set sse-s3
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			encryptionOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().
+					Msg(err.Error())
+				return err
+			}
+
+			encryptionOpts.DesiredState = options.State{Algorithm: string(types.ServerSideEncryptionAes256)}
+
+			return aws.SetBucketEncryption(svc, encryptionOpts, confirmRunner, logger)
+		},
+	}
+)
@@ -0,0 +1,56 @@
+package show
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/encryption/options"
+	encryptionutils "github.com/bilalcaliskan/s3-manager/cmd/encryption/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	encryptionOpts = options.GetEncryptionOptions()
+}
+
+var (
+	svc            internalawstypes.S3ClientAPI
+	logger         zerolog.Logger
+	encryptionOpts *options.EncryptionOptions
+	ShowCmd        = &cobra.Command{
+		Use:           "show",
+		Short:         "shows the default encryption configuration for the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# show the current default encryption configuration for bucket
+s3-manager encryption show
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			encryptionOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			res, err := aws.GetBucketEncryption(svc, encryptionOpts)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if err := encryptionutils.DecideActualState(res, encryptionOpts); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Msgf(encryptionutils.InfCurrentState, encryptionutils.FormatState(encryptionOpts.ActualState))
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,103 @@
+//go:build e2e
+
+package show
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteShowCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	ShowCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName                string
+		args                    []string
+		shouldPass              bool
+		getBucketEncryptionFunc func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error)
+	}{
+		{
+			"Too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+		},
+		{
+			"Success",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+				return &s3.GetBucketEncryptionOutput{
+					ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+						Rules: []types.ServerSideEncryptionRule{
+							{
+								ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{
+									SSEAlgorithm: types.ServerSideEncryptionAes256,
+								},
+							},
+						},
+					},
+				}, nil
+			},
+		},
+		{
+			"Success when encryption is not configured",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "ServerSideEncryptionConfigurationNotFoundError"}
+			},
+		},
+		{
+			"Failure",
+			[]string{},
+			false,
+			func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+		{
+			"Failure caused by multiple rules",
+			[]string{},
+			false,
+			func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+				return &s3.GetBucketEncryptionOutput{
+					ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+						Rules: []types.ServerSideEncryptionRule{{}, {}},
+					},
+				}, nil
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketEncryptionAPI = tc.getBucketEncryptionFunc
+
+		ShowCmd.SetContext(context.WithValue(ShowCmd.Context(), options.S3ClientKey{}, mockS3))
+		ShowCmd.SetContext(context.WithValue(ShowCmd.Context(), options.OptsKey{}, rootOpts))
+		ShowCmd.SetArgs(tc.args)
+
+		err := ShowCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		encryptionOpts.SetZeroValues()
+	}
+}
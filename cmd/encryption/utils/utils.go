@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/bilalcaliskan/s3-manager/cmd/encryption/options"
+)
+
+const (
+	ErrMultipleRules = "expected a single encryption rule but %d rules returned from AWS SDK"
+
+	WarnDesiredState = "default encryption is already at the desired state, skipping configuration"
+
+	InfSuccess           = "successfully configured default encryption as %s"
+	InfCurrentState      = "current default encryption configuration is %s"
+	InfSettingEncryption = "setting default encryption as %s"
+	InfNotConfigured     = "none"
+)
+
+// DecideActualState fills the ActualState of EncryptionOptions with the default encryption rule returned from the
+// GetBucketEncryption call. A bucket without any encryption rule is reported with an empty Algorithm.
+func DecideActualState(res *s3.GetBucketEncryptionOutput, opts *options.EncryptionOptions) error {
+	opts.ActualState = options.State{}
+	if res == nil || res.ServerSideEncryptionConfiguration == nil || len(res.ServerSideEncryptionConfiguration.Rules) == 0 {
+		return nil
+	}
+
+	rules := res.ServerSideEncryptionConfiguration.Rules
+	if len(rules) > 1 {
+		return fmt.Errorf(ErrMultipleRules, len(rules))
+	}
+
+	if rules[0].ApplyServerSideEncryptionByDefault != nil {
+		opts.ActualState.Algorithm = string(rules[0].ApplyServerSideEncryptionByDefault.SSEAlgorithm)
+		opts.ActualState.KmsKeyID = aws.ToString(rules[0].ApplyServerSideEncryptionByDefault.KMSMasterKeyID)
+	}
+
+	opts.ActualState.BucketKeyEnabled = aws.ToBool(rules[0].BucketKeyEnabled)
+
+	return nil
+}
+
+// FormatState returns the human-readable representation of a default encryption configuration.
+func FormatState(state options.State) string {
+	if state.Algorithm == "" {
+		return InfNotConfigured
+	}
+
+	if state.KmsKeyID == "" && !state.BucketKeyEnabled {
+		return state.Algorithm
+	}
+
+	return fmt.Sprintf("%s (kmsKeyId=%s, bucketKeyEnabled=%t)", state.Algorithm, state.KmsKeyID, state.BucketKeyEnabled)
+}
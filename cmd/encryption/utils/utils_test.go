@@ -0,0 +1,90 @@
+//go:build unit
+
+package utils
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/encryption/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecideActualState(t *testing.T) {
+	tests := []struct {
+		name          string
+		res           *s3.GetBucketEncryptionOutput
+		expected      error
+		expectedState options.State
+	}{
+		{
+			"Success sse-s3",
+			&s3.GetBucketEncryptionOutput{
+				ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+					Rules: []types.ServerSideEncryptionRule{
+						{
+							ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{
+								SSEAlgorithm: types.ServerSideEncryptionAes256,
+							},
+						},
+					},
+				},
+			},
+			nil,
+			options.State{Algorithm: "AES256"},
+		},
+		{
+			"Success sse-kms",
+			&s3.GetBucketEncryptionOutput{
+				ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+					Rules: []types.ServerSideEncryptionRule{
+						{
+							ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{
+								SSEAlgorithm:   types.ServerSideEncryptionAwsKms,
+								KMSMasterKeyID: aws.String("thisiskmskeyid"),
+							},
+							BucketKeyEnabled: aws.Bool(true),
+						},
+					},
+				},
+			},
+			nil,
+			options.State{Algorithm: "aws:kms", KmsKeyID: "thisiskmskeyid", BucketKeyEnabled: true},
+		},
+		{
+			"Success not configured",
+			&s3.GetBucketEncryptionOutput{},
+			nil,
+			options.State{},
+		},
+		{
+			"Failure caused by multiple rules",
+			&s3.GetBucketEncryptionOutput{
+				ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+					Rules: []types.ServerSideEncryptionRule{{}, {}},
+				},
+			},
+			fmt.Errorf(ErrMultipleRules, 2),
+			options.State{},
+		},
+	}
+
+	opts := options.GetEncryptionOptions()
+	for _, test := range tests {
+		t.Logf("starting case %s", test.name)
+
+		err := DecideActualState(test.res, opts)
+		assert.Equal(t, test.expected, err)
+		assert.Equal(t, test.expectedState, opts.ActualState)
+	}
+}
+
+func TestFormatState(t *testing.T) {
+	assert.Equal(t, InfNotConfigured, FormatState(options.State{}))
+	assert.Equal(t, "AES256", FormatState(options.State{Algorithm: "AES256"}))
+	assert.Equal(t, "aws:kms (kmsKeyId=foo, bucketKeyEnabled=true)",
+		FormatState(options.State{Algorithm: "aws:kms", KmsKeyID: "foo", BucketKeyEnabled: true}))
+}
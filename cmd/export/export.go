@@ -0,0 +1,83 @@
+package export
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/export/options"
+	exportutils "github.com/bilalcaliskan/s3-manager/cmd/export/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	exportOpts = options.GetExportOptions()
+	exportOpts.InitFlags(ExportCmd)
+}
+
+var (
+	svc        internalawstypes.S3ClientAPI
+	logger     zerolog.Logger
+	exportOpts *options.ExportOptions
+	ExportCmd  = &cobra.Command{
+		Use:   "export",
+		Short: "exports the current configuration of the target bucket as a JSON or YAML manifest",
+		Long: `exports the current configuration of the target bucket as a JSON or YAML manifest, the manifest contains the
+tags, versioning, transfer acceleration, policy, lifecycle and default encryption of the bucket. The settings that
+are not configured on the bucket are left out of the manifest. The manifest can be applied on another bucket with
+the apply command. The manifest is printed to the standard output if '--output' flag is not specified, the logs are
+written to the standard error so that the output can be redirected to a file`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Annotations:   map[string]string{rootopts.AnnotationStdoutData: "true"},
+		Example: `# print the configuration of the target bucket as a YAML manifest
+s3-manager export
+
+# save the configuration of the target bucket to bucket.yaml through the standard output
+s3-manager export > bucket.yaml
+
+# write the configuration of the target bucket to bucket.json as a JSON manifest
+s3-manager export -o bucket.json
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			exportOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			format, err := exportutils.DecideFormat(exportOpts.Format, exportOpts.OutputFile, cmd.Flags().Changed("format"))
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			current, err := aws.GetManifest(svc, exportOpts.RootOptions)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			content, err := current.Marshal(format)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if err := exportutils.WriteManifest(exportOpts.OutputFile, content); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if exportOpts.OutputFile != "" {
+				logger.Info().Str("manifestFilePath", exportOpts.OutputFile).Msg(exportutils.InfSuccess)
+			}
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,129 @@
+//go:build e2e
+
+package export
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/manifest"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMockS3 returns a client that reports a bucket with tags, versioning and default encryption configured, versioning
+// is reported with the given error
+func newMockS3(versioningErr error) *internalawstypes.MockS3Client {
+	mockS3 := new(internalawstypes.MockS3Client)
+	mockS3.GetBucketTaggingAPI = func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+		return &s3.GetBucketTaggingOutput{TagSet: []types.Tag{{Key: aws.String("team"), Value: aws.String("platform")}}}, nil
+	}
+	mockS3.GetBucketVersioningAPI = func(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
+		return &s3.GetBucketVersioningOutput{Status: types.BucketVersioningStatusEnabled}, versioningErr
+	}
+	mockS3.GetBucketAccelerateConfigurationAPI = func(ctx context.Context, params *s3.GetBucketAccelerateConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketAccelerateConfigurationOutput, error) {
+		return &s3.GetBucketAccelerateConfigurationOutput{}, nil
+	}
+	mockS3.GetBucketPolicyAPI = func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+		return nil, &smithy.GenericAPIError{Code: "NoSuchBucketPolicy"}
+	}
+	mockS3.GetBucketLifecycleConfigurationAPI = func(ctx context.Context, params *s3.GetBucketLifecycleConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+		return nil, &smithy.GenericAPIError{Code: "NoSuchLifecycleConfiguration"}
+	}
+	mockS3.GetBucketEncryptionAPI = func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+		return &s3.GetBucketEncryptionOutput{ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+			Rules: []types.ServerSideEncryptionRule{{
+				ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{SSEAlgorithm: types.ServerSideEncryptionAes256},
+			}},
+		}}, nil
+	}
+
+	return mockS3
+}
+
+func TestExecuteExportCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+	outputFile := filepath.Join(t.TempDir(), "bucket.json")
+
+	ctx := context.Background()
+	ExportCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName   string
+		args       []string
+		shouldPass bool
+		mockS3     *internalawstypes.MockS3Client
+	}{
+		{"Success", []string{}, true, newMockS3(nil)},
+		{"Success with output file", []string{"-o", outputFile}, true, newMockS3(nil)},
+		{"Failure caused by too many arguments", []string{"foo"}, false, newMockS3(nil)},
+		{"Failure caused by get error", []string{}, false, newMockS3(constants.ErrInjected)},
+		{"Failure caused by write error", []string{"-o", filepath.Join(t.TempDir(), "notexist", "bucket.yaml")}, false, newMockS3(nil)},
+		// keep it as the last case, cobra does not reset the changed state of the flags between the executions
+		{"Failure caused by invalid format", []string{"--format", "toml"}, false, newMockS3(nil)},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		ExportCmd.SetContext(context.WithValue(ExportCmd.Context(), options.S3ClientKey{}, tc.mockS3))
+		ExportCmd.SetContext(context.WithValue(ExportCmd.Context(), options.OptsKey{}, rootOpts))
+		ExportCmd.SetArgs(tc.args)
+
+		err := ExportCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		exportOpts.SetZeroValues()
+	}
+
+	content, err := os.ReadFile(outputFile)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"Tags":{"team":"platform"},"Versioning":"Enabled","Encryption":{"Algorithm":"AES256"}}`, string(content))
+}
+
+func TestExecuteExportCmdStdout(t *testing.T) {
+	// the banner and the logs of the root command must not end up in the redirected manifest
+	assert.Equal(t, "true", ExportCmd.Annotations[options.AnnotationStdoutData])
+
+	reader, writer, err := os.Pipe()
+	assert.Nil(t, err)
+
+	stdout := os.Stdout
+	os.Stdout = writer
+	defer func() {
+		os.Stdout = stdout
+	}()
+
+	ExportCmd.SetContext(context.Background())
+	ExportCmd.SetContext(context.WithValue(ExportCmd.Context(), options.S3ClientKey{}, newMockS3(nil)))
+	ExportCmd.SetContext(context.WithValue(ExportCmd.Context(), options.OptsKey{}, options.GetMockedRootOptions()))
+	ExportCmd.SetArgs([]string{"--format", "yaml"})
+
+	err = ExportCmd.Execute()
+	os.Stdout = stdout
+	assert.Nil(t, writer.Close())
+	assert.Nil(t, err)
+
+	content, err := io.ReadAll(reader)
+	assert.Nil(t, err)
+
+	parsed, err := manifest.Parse(content)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "platform"}, parsed.Tags)
+	assert.Equal(t, manifest.StatusEnabled, parsed.Versioning)
+
+	exportOpts.SetZeroValues()
+}
@@ -0,0 +1,40 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/manifest"
+	"github.com/spf13/cobra"
+)
+
+type ExportOptsKey struct{}
+
+var exportOpts = &ExportOptions{
+	Format: manifest.FormatYAML,
+}
+
+// ExportOptions contains frequent command line and application options.
+type ExportOptions struct {
+	// OutputFile is the path of the file that the manifest is written to, the manifest is printed to the standard
+	// output if it is empty
+	OutputFile string
+	// Format is the format of the manifest, valid values are "yaml" and "json"
+	Format string
+	*options.RootOptions
+}
+
+func (opts *ExportOptions) InitFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.OutputFile, "output", "o", "", "path of the file that the manifest "+
+		"is written to, the manifest is printed to the standard output if not specified")
+	cmd.Flags().StringVarP(&opts.Format, "format", "", manifest.FormatYAML, "format of the manifest, valid "+
+		"values are yaml and json, inferred from the extension of '--output' if not specified")
+}
+
+// GetExportOptions returns the pointer of ExportOptions
+func GetExportOptions() *ExportOptions {
+	return exportOpts
+}
+
+func (opts *ExportOptions) SetZeroValues() {
+	opts.OutputFile = ""
+	opts.Format = manifest.FormatYAML
+}
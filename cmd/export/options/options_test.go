@@ -0,0 +1,25 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetExportOptions(t *testing.T) {
+	opts := GetExportOptions()
+	assert.NotNil(t, opts)
+}
+
+func TestExportOptions_SetZeroValues(t *testing.T) {
+	opts := GetExportOptions()
+	assert.NotNil(t, opts)
+
+	opts.OutputFile = "bucket.json"
+	opts.Format = "json"
+	opts.SetZeroValues()
+	assert.Empty(t, opts.OutputFile)
+	assert.Equal(t, "yaml", opts.Format)
+}
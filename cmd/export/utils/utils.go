@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/manifest"
+	"github.com/pkg/errors"
+)
+
+const (
+	ErrInvalidFormat = "'--format' flag must be yaml or json"
+
+	InfSuccess = "successfully exported the configuration of target bucket"
+)
+
+// DecideFormat returns the format of the manifest. The extension of the output file takes precedence over the default
+// format unless the format is explicitly specified.
+func DecideFormat(format, outputFile string, formatChanged bool) (string, error) {
+	if !formatChanged {
+		switch strings.ToLower(filepath.Ext(outputFile)) {
+		case ".json":
+			return manifest.FormatJSON, nil
+		case ".yaml", ".yml":
+			return manifest.FormatYAML, nil
+		}
+	}
+
+	format = strings.ToLower(format)
+	if format != manifest.FormatYAML && format != manifest.FormatJSON {
+		return "", errors.New(ErrInvalidFormat)
+	}
+
+	return format, nil
+}
+
+// WriteManifest writes the content to the file at the given path, or prints it to the standard output if the path
+// is empty.
+func WriteManifest(path string, content []byte) error {
+	if path == "" {
+		fmt.Print(string(content))
+		return nil
+	}
+
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		return errors.Wrap(err, "an error occurred while writing manifest file")
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+//go:build unit
+
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecideFormat(t *testing.T) {
+	cases := []struct {
+		caseName      string
+		format        string
+		outputFile    string
+		formatChanged bool
+		shouldPass    bool
+		expected      string
+	}{
+		{"Success default format", "yaml", "", false, true, "yaml"},
+		{"Success json extension", "yaml", "bucket.json", false, true, "json"},
+		{"Success yml extension", "yaml", "bucket.yml", false, true, "yaml"},
+		{"Success unknown extension", "yaml", "bucket.txt", false, true, "yaml"},
+		{"Success explicit format", "JSON", "bucket.yaml", true, true, "json"},
+		{"Failure invalid format", "toml", "", true, false, ""},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		format, err := DecideFormat(tc.format, tc.outputFile, tc.formatChanged)
+		if !tc.shouldPass {
+			assert.NotNil(t, err)
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, tc.expected, format)
+	}
+}
+
+func TestWriteManifest(t *testing.T) {
+	assert.Nil(t, WriteManifest("", []byte("Versioning: Enabled\n")))
+
+	path := filepath.Join(t.TempDir(), "bucket.yaml")
+	assert.Nil(t, WriteManifest(path, []byte("Versioning: Enabled\n")))
+
+	content, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "Versioning: Enabled\n", string(content))
+
+	assert.NotNil(t, WriteManifest(filepath.Join(t.TempDir(), "notexist", "bucket.yaml"), []byte{}))
+}
@@ -0,0 +1,23 @@
+package inventory
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/inventory/remove"
+	"github.com/bilalcaliskan/s3-manager/cmd/inventory/set"
+	"github.com/bilalcaliskan/s3-manager/cmd/inventory/show"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	InventoryCmd.AddCommand(show.ShowCmd)
+	InventoryCmd.AddCommand(set.SetCmd)
+	InventoryCmd.AddCommand(remove.RemoveCmd)
+}
+
+var (
+	InventoryCmd = &cobra.Command{
+		Use:           "inventory",
+		Short:         "shows/sets the S3 Inventory report configurations of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: false,
+	}
+)
@@ -0,0 +1,13 @@
+//go:build unit
+
+package inventory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInventoryCmd(t *testing.T) {
+	assert.NotNil(t, InventoryCmd)
+}
@@ -0,0 +1,80 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/spf13/cobra"
+)
+
+type InventoryOptsKey struct{}
+
+var inventoryOpts = &InventoryOptions{
+	Format:           "CSV",
+	Schedule:         "Daily",
+	IncludedVersions: "Current",
+}
+
+// InventoryOptions contains frequent command line and application options.
+type InventoryOptions struct {
+	// ID is the identifier of the inventory configuration
+	ID string
+	// DestinationBucket is the name or ARN of the bucket that the inventory reports are delivered to
+	DestinationBucket string
+	// DestinationPrefix is the key prefix of the delivered inventory reports
+	DestinationPrefix string
+	// DestinationAccount is the ID of the account that owns the destination bucket
+	DestinationAccount string
+	// Format is the format of the inventory reports, valid values are "CSV", "ORC" and "Parquet"
+	Format string
+	// Schedule is the frequency of the inventory reports, valid values are "Daily" and "Weekly"
+	Schedule string
+	// IncludedVersions is the object versions included in the inventory reports, valid values are "All" and "Current"
+	IncludedVersions string
+	// Prefix limits the objects included in the inventory reports
+	Prefix string
+	// Fields are the optional fields included in the inventory reports
+	Fields []string
+	*options.RootOptions
+}
+
+// InitSetFlags initializes the flags of the desired inventory configuration.
+func (opts *InventoryOptions) InitSetFlags(cmd *cobra.Command) {
+	opts.InitRemoveFlags(cmd)
+	cmd.Flags().StringVarP(&opts.DestinationBucket, "destination-bucket", "", "",
+		"name or ARN of the bucket that the inventory reports are delivered to")
+	cmd.Flags().StringVarP(&opts.DestinationPrefix, "destination-prefix", "", "",
+		"key prefix of the delivered inventory reports")
+	cmd.Flags().StringVarP(&opts.DestinationAccount, "destination-account", "", "",
+		"ID of the account that owns the destination bucket, recommended for cross-account destinations")
+	cmd.Flags().StringVarP(&opts.Format, "format", "", "CSV",
+		"format of the inventory reports, valid values are CSV, ORC and Parquet")
+	cmd.Flags().StringVarP(&opts.Schedule, "schedule", "", "Daily",
+		"frequency of the inventory reports, valid values are Daily and Weekly")
+	cmd.Flags().StringVarP(&opts.IncludedVersions, "included-versions", "", "Current",
+		"object versions included in the inventory reports, valid values are All and Current")
+	cmd.Flags().StringVarP(&opts.Prefix, "prefix", "", "",
+		"only include the objects under the given prefix in the inventory reports")
+	cmd.Flags().StringSliceVarP(&opts.Fields, "fields", "", []string{},
+		"comma separated optional fields included in the inventory reports, e.g. Size,LastModifiedDate,StorageClass")
+}
+
+// InitRemoveFlags initializes the flags that identify an inventory configuration.
+func (opts *InventoryOptions) InitRemoveFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.ID, "id", "", "", "identifier of the inventory configuration")
+}
+
+// GetInventoryOptions returns the pointer of InventoryOptions
+func GetInventoryOptions() *InventoryOptions {
+	return inventoryOpts
+}
+
+func (opts *InventoryOptions) SetZeroValues() {
+	opts.ID = ""
+	opts.DestinationBucket = ""
+	opts.DestinationPrefix = ""
+	opts.DestinationAccount = ""
+	opts.Format = "CSV"
+	opts.Schedule = "Daily"
+	opts.IncludedVersions = "Current"
+	opts.Prefix = ""
+	opts.Fields = []string{}
+}
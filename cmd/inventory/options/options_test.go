@@ -0,0 +1,61 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetInventoryOptions(t *testing.T) {
+	opts := GetInventoryOptions()
+	assert.NotNil(t, opts)
+}
+
+func TestInventoryOptions_InitSetFlags(t *testing.T) {
+	cmd := cobra.Command{}
+	opts := GetInventoryOptions()
+	opts.InitSetFlags(&cmd)
+
+	for _, name := range []string{"id", "destination-bucket", "destination-prefix", "destination-account", "format",
+		"schedule", "included-versions", "prefix", "fields"} {
+		assert.NotNil(t, cmd.Flags().Lookup(name), name)
+	}
+}
+
+func TestInventoryOptions_InitRemoveFlags(t *testing.T) {
+	cmd := cobra.Command{}
+	opts := GetInventoryOptions()
+	opts.InitRemoveFlags(&cmd)
+
+	assert.NotNil(t, cmd.Flags().Lookup("id"))
+	assert.Nil(t, cmd.Flags().Lookup("format"))
+}
+
+func TestInventoryOptions_SetZeroValues(t *testing.T) {
+	opts := GetInventoryOptions()
+	assert.NotNil(t, opts)
+
+	opts.ID = "daily"
+	opts.DestinationBucket = "thevpnbeast-inventory"
+	opts.DestinationPrefix = "inventory/"
+	opts.DestinationAccount = "123456789012"
+	opts.Format = "ORC"
+	opts.Schedule = "Weekly"
+	opts.IncludedVersions = "All"
+	opts.Prefix = "foo/"
+	opts.Fields = []string{"Size"}
+	opts.SetZeroValues()
+
+	assert.Empty(t, opts.ID)
+	assert.Empty(t, opts.DestinationBucket)
+	assert.Empty(t, opts.DestinationPrefix)
+	assert.Empty(t, opts.DestinationAccount)
+	assert.Equal(t, "CSV", opts.Format)
+	assert.Equal(t, "Daily", opts.Schedule)
+	assert.Equal(t, "Current", opts.IncludedVersions)
+	assert.Empty(t, opts.Prefix)
+	assert.Empty(t, opts.Fields)
+}
@@ -0,0 +1,72 @@
+package remove
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/inventory/options"
+	inventoryutils "github.com/bilalcaliskan/s3-manager/cmd/inventory/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	inventoryOpts = options.GetInventoryOptions()
+	inventoryOpts.InitRemoveFlags(RemoveCmd)
+}
+
+var (
+	svc           internalawstypes.S3ClientAPI
+	logger        zerolog.Logger
+	confirmRunner prompt.PromptRunner
+	inventoryOpts *options.InventoryOptions
+	RemoveCmd     = &cobra.Command{
+		Use:           "remove",
+		Short:         "removes an S3 Inventory report configuration of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# remove the inventory configuration with id 'daily' from the target bucket
+s3-manager inventory remove --id daily
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			inventoryOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if err := inventoryutils.ValidateID(inventoryOpts); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			current, err := aws.GetInventoryConfiguration(svc, inventoryOpts)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if current == nil {
+				logger.Warn().Msgf(inventoryutils.WarnNotFound, inventoryOpts.ID)
+				return nil
+			}
+
+			logger.Info().Msgf(inventoryutils.InfRemoving, inventoryutils.FormatConfiguration(current))
+			if _, err := aws.DeleteInventoryConfiguration(svc, inventoryOpts, confirmRunner, logger); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if !inventoryOpts.DryRun {
+				logger.Info().Msgf(inventoryutils.InfRemoved, inventoryOpts.ID)
+			}
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,76 @@
+package set
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/inventory/options"
+	inventoryutils "github.com/bilalcaliskan/s3-manager/cmd/inventory/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	inventoryOpts = options.GetInventoryOptions()
+	inventoryOpts.InitSetFlags(SetCmd)
+}
+
+var (
+	svc           internalawstypes.S3ClientAPI
+	logger        zerolog.Logger
+	confirmRunner prompt.PromptRunner
+	inventoryOpts *options.InventoryOptions
+	SetCmd        = &cobra.Command{
+		Use:   "set",
+		Short: "creates or replaces an S3 Inventory report configuration of the target bucket",
+		Long: `creates or replaces an S3 Inventory report configuration of the target bucket, the destination bucket must
+allow the service principal 's3.amazonaws.com' to put objects with its bucket policy`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# deliver a daily CSV inventory of the current object versions to another bucket
+s3-manager inventory set --id daily --destination-bucket thevpnbeast-inventory
+
+# deliver a weekly Parquet inventory of all the object versions under 'backups/' with optional fields
+s3-manager inventory set --id weekly-backups --destination-bucket thevpnbeast-inventory --destination-prefix inventory/ --format Parquet --schedule Weekly --included-versions All --prefix backups/ --fields Size,LastModifiedDate,StorageClass
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			inventoryOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if err := inventoryutils.Validate(inventoryOpts); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			current, err := aws.GetInventoryConfiguration(svc, inventoryOpts)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if current != nil {
+				logger.Info().Msgf(inventoryutils.InfCurrentState, inventoryutils.FormatConfiguration(current))
+			}
+
+			logger.Info().Msgf(inventoryutils.InfSetting, inventoryutils.FormatConfiguration(inventoryutils.ToConfiguration(inventoryOpts)))
+			if _, err := aws.SetInventoryConfiguration(svc, inventoryOpts, confirmRunner, logger); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if !inventoryOpts.DryRun {
+				logger.Info().Msgf(inventoryutils.InfSuccess, inventoryOpts.ID)
+			}
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,162 @@
+//go:build e2e
+
+package set
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func getBucketInventoryConfigurationFunc(ctx context.Context, params *s3.GetBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketInventoryConfigurationOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "NoSuchConfiguration"}
+}
+
+func TestExecuteSetCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	SetCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName                            string
+		args                                []string
+		shouldPass                          bool
+		getBucketInventoryConfigurationFunc func(ctx context.Context, params *s3.GetBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketInventoryConfigurationOutput, error)
+		putBucketInventoryConfigurationFunc func(ctx context.Context, params *s3.PutBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			[]string{"--id", "daily", "--destination-bucket", "thevpnbeast-inventory"},
+			true,
+			getBucketInventoryConfigurationFunc,
+			func(ctx context.Context, params *s3.PutBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error) {
+				return &s3.PutBucketInventoryConfigurationOutput{}, nil
+			},
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success while replacing existing configuration",
+			[]string{"--id", "weekly", "--destination-bucket", "thevpnbeast-inventory", "--format", "Parquet",
+				"--schedule", "Weekly", "--included-versions", "All", "--prefix", "backups/", "--fields", "Size,StorageClass"},
+			true,
+			func(ctx context.Context, params *s3.GetBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketInventoryConfigurationOutput, error) {
+				return &s3.GetBucketInventoryConfigurationOutput{InventoryConfiguration: &types.InventoryConfiguration{Id: params.Id}}, nil
+			},
+			func(ctx context.Context, params *s3.PutBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error) {
+				return &s3.PutBucketInventoryConfigurationOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with dry run",
+			[]string{"--id", "daily", "--destination-bucket", "thevpnbeast-inventory"},
+			true,
+			getBucketInventoryConfigurationFunc,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by invalid flags",
+			[]string{"--id", "daily", "--destination-bucket", "thevpnbeast-inventory", "--format", "JSON"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by get error",
+			[]string{"--id", "daily", "--destination-bucket", "thevpnbeast-inventory"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketInventoryConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by put error",
+			[]string{"--id", "daily", "--destination-bucket", "thevpnbeast-inventory"},
+			false,
+			getBucketInventoryConfigurationFunc,
+			func(ctx context.Context, params *s3.PutBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated process",
+			[]string{"--id", "daily", "--destination-bucket", "thevpnbeast-inventory"},
+			false,
+			getBucketInventoryConfigurationFunc,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketInventoryConfigurationAPI = tc.getBucketInventoryConfigurationFunc
+		mockS3.PutBucketInventoryConfigurationAPI = tc.putBucketInventoryConfigurationFunc
+
+		SetCmd.SetContext(context.WithValue(SetCmd.Context(), options.S3ClientKey{}, mockS3))
+		SetCmd.SetContext(context.WithValue(SetCmd.Context(), options.OptsKey{}, rootOpts))
+		SetCmd.SetContext(context.WithValue(SetCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		SetCmd.SetArgs(tc.args)
+
+		err := SetCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		inventoryOpts.SetZeroValues()
+	}
+}
@@ -0,0 +1,60 @@
+package show
+
+import (
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/inventory/options"
+	inventoryutils "github.com/bilalcaliskan/s3-manager/cmd/inventory/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	inventoryOpts = options.GetInventoryOptions()
+}
+
+var (
+	svc           internalawstypes.S3ClientAPI
+	logger        zerolog.Logger
+	inventoryOpts *options.InventoryOptions
+	ShowCmd       = &cobra.Command{
+		Use:           "show",
+		Short:         "shows the S3 Inventory report configurations of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# show the S3 Inventory report configurations of the target bucket
+s3-manager inventory show
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			inventoryOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			configurations, err := aws.ListInventoryConfigurations(svc, inventoryOpts)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if len(configurations) == 0 {
+				logger.Info().Msg(inventoryutils.InfNotConfigured)
+				return nil
+			}
+
+			for i := range configurations {
+				fmt.Println(inventoryutils.FormatConfiguration(&configurations[i]))
+			}
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,95 @@
+//go:build e2e
+
+package show
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteShowCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	ShowCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName                              string
+		args                                  []string
+		shouldPass                            bool
+		listBucketInventoryConfigurationsFunc func(ctx context.Context, params *s3.ListBucketInventoryConfigurationsInput, optFns ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error)
+	}{
+		{
+			"Too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+		},
+		{
+			"Success",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.ListBucketInventoryConfigurationsInput, optFns ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+				return &s3.ListBucketInventoryConfigurationsOutput{
+					InventoryConfigurationList: []types.InventoryConfiguration{
+						{
+							Id:        aws.String("daily"),
+							IsEnabled: aws.Bool(true),
+							Destination: &types.InventoryDestination{S3BucketDestination: &types.InventoryS3BucketDestination{
+								Bucket: aws.String("arn:aws:s3:::thevpnbeast-inventory"),
+								Format: types.InventoryFormatCsv,
+							}},
+							Schedule:               &types.InventorySchedule{Frequency: types.InventoryFrequencyDaily},
+							IncludedObjectVersions: types.InventoryIncludedObjectVersionsCurrent,
+						},
+					},
+					IsTruncated: aws.Bool(false),
+				}, nil
+			},
+		},
+		{
+			"Success when not configured",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.ListBucketInventoryConfigurationsInput, optFns ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+				return &s3.ListBucketInventoryConfigurationsOutput{IsTruncated: aws.Bool(false)}, nil
+			},
+		},
+		{
+			"Failure",
+			[]string{},
+			false,
+			func(ctx context.Context, params *s3.ListBucketInventoryConfigurationsInput, optFns ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListBucketInventoryConfigurationsAPI = tc.listBucketInventoryConfigurationsFunc
+
+		ShowCmd.SetContext(context.WithValue(ShowCmd.Context(), options.S3ClientKey{}, mockS3))
+		ShowCmd.SetContext(context.WithValue(ShowCmd.Context(), options.OptsKey{}, rootOpts))
+		ShowCmd.SetArgs(tc.args)
+
+		err := ShowCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		inventoryOpts.SetZeroValues()
+	}
+}
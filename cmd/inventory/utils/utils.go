@@ -0,0 +1,169 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/inventory/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxIDLength is the maximum length of the identifier of an inventory configuration
+	MaxIDLength = 64
+
+	bucketArnPrefix = "arn:aws:s3:::"
+
+	ErrMissingID                = "'--id' flag is required"
+	ErrIDTooLong                = "'--id' flag can be at most %d characters long"
+	ErrMissingDestinationBucket = "'--destination-bucket' flag is required"
+	ErrInvalidValue             = "no such %s called %q, valid values are %v"
+	ErrDuplicateField           = "optional field %q is given more than once"
+
+	WarnNotFound = "inventory configuration %q not found on target bucket, skipping operation"
+
+	InfNotConfigured = "target bucket does not have any inventory configuration"
+	InfCurrentState  = "current inventory configuration is %s"
+	InfSetting       = "setting inventory configuration as %s"
+	InfSuccess       = "successfully configured inventory %q on target bucket"
+	InfRemoving      = "will attempt to remove inventory configuration %s"
+	InfRemoved       = "successfully removed inventory %q from target bucket"
+)
+
+// ValidateID validates the identifier of the inventory configuration of InventoryOptions.
+func ValidateID(opts *options.InventoryOptions) error {
+	if opts.ID == "" {
+		return errors.New(ErrMissingID)
+	}
+
+	if len(opts.ID) > MaxIDLength {
+		return fmt.Errorf(ErrIDTooLong, MaxIDLength)
+	}
+
+	return nil
+}
+
+// Validate validates the desired inventory configuration of InventoryOptions against the values accepted by S3.
+func Validate(opts *options.InventoryOptions) error {
+	if err := ValidateID(opts); err != nil {
+		return err
+	}
+
+	if opts.DestinationBucket == "" {
+		return errors.New(ErrMissingDestinationBucket)
+	}
+
+	if err := validateValue("format", opts.Format, toStrings(types.InventoryFormat("").Values())); err != nil {
+		return err
+	}
+
+	if err := validateValue("schedule", opts.Schedule, toStrings(types.InventoryFrequency("").Values())); err != nil {
+		return err
+	}
+
+	if err := validateValue("included versions", opts.IncludedVersions, toStrings(types.InventoryIncludedObjectVersions("").Values())); err != nil {
+		return err
+	}
+
+	validFields := toStrings(types.InventoryOptionalField("").Values())
+	seen := make(map[string]bool)
+	for _, field := range opts.Fields {
+		if err := validateValue("optional field", field, validFields); err != nil {
+			return err
+		}
+
+		if seen[field] {
+			return fmt.Errorf(ErrDuplicateField, field)
+		}
+
+		seen[field] = true
+	}
+
+	return nil
+}
+
+func validateValue(name, value string, valid []string) error {
+	if !utils.Contains(valid, value) {
+		return fmt.Errorf(ErrInvalidValue, name, value, valid)
+	}
+
+	return nil
+}
+
+func toStrings[T ~string](values []T) []string {
+	res := make([]string, 0, len(values))
+	for _, v := range values {
+		res = append(res, string(v))
+	}
+
+	return res
+}
+
+// ToConfiguration converts the desired inventory configuration of InventoryOptions into an InventoryConfiguration.
+// The destination bucket is converted to its ARN if it is given by name.
+func ToConfiguration(opts *options.InventoryOptions) *types.InventoryConfiguration {
+	destination := &types.InventoryS3BucketDestination{
+		Bucket: aws.String(opts.DestinationBucket),
+		Format: types.InventoryFormat(opts.Format),
+	}
+
+	if !strings.HasPrefix(opts.DestinationBucket, "arn:") {
+		destination.Bucket = aws.String(bucketArnPrefix + opts.DestinationBucket)
+	}
+
+	if opts.DestinationPrefix != "" {
+		destination.Prefix = aws.String(opts.DestinationPrefix)
+	}
+
+	if opts.DestinationAccount != "" {
+		destination.AccountId = aws.String(opts.DestinationAccount)
+	}
+
+	cfg := &types.InventoryConfiguration{
+		Id:                     aws.String(opts.ID),
+		IsEnabled:              aws.Bool(true),
+		Destination:            &types.InventoryDestination{S3BucketDestination: destination},
+		Schedule:               &types.InventorySchedule{Frequency: types.InventoryFrequency(opts.Schedule)},
+		IncludedObjectVersions: types.InventoryIncludedObjectVersions(opts.IncludedVersions),
+	}
+
+	if opts.Prefix != "" {
+		cfg.Filter = &types.InventoryFilter{Prefix: aws.String(opts.Prefix)}
+	}
+
+	for _, field := range opts.Fields {
+		cfg.OptionalFields = append(cfg.OptionalFields, types.InventoryOptionalField(field))
+	}
+
+	return cfg
+}
+
+// FormatConfiguration returns the human-readable representation of an inventory configuration.
+func FormatConfiguration(cfg *types.InventoryConfiguration) string {
+	parts := []string{fmt.Sprintf("id=%s", aws.ToString(cfg.Id)), fmt.Sprintf("enabled=%t", aws.ToBool(cfg.IsEnabled))}
+
+	if cfg.Destination != nil && cfg.Destination.S3BucketDestination != nil {
+		destination := cfg.Destination.S3BucketDestination
+		parts = append(parts, fmt.Sprintf("destination=%s/%s", aws.ToString(destination.Bucket), aws.ToString(destination.Prefix)),
+			fmt.Sprintf("format=%s", destination.Format))
+	}
+
+	if cfg.Schedule != nil {
+		parts = append(parts, fmt.Sprintf("schedule=%s", cfg.Schedule.Frequency))
+	}
+
+	parts = append(parts, fmt.Sprintf("versions=%s", cfg.IncludedObjectVersions))
+
+	if cfg.Filter != nil && aws.ToString(cfg.Filter.Prefix) != "" {
+		parts = append(parts, fmt.Sprintf("prefix=%s", aws.ToString(cfg.Filter.Prefix)))
+	}
+
+	if len(cfg.OptionalFields) != 0 {
+		parts = append(parts, fmt.Sprintf("fields=%s", strings.Join(toStrings(cfg.OptionalFields), ",")))
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,83 @@
+//go:build unit
+
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/inventory/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func validOptions() *options.InventoryOptions {
+	return &options.InventoryOptions{
+		ID:                "daily",
+		DestinationBucket: "thevpnbeast-inventory",
+		Format:            "CSV",
+		Schedule:          "Daily",
+		IncludedVersions:  "Current",
+		Fields:            []string{"Size", "StorageClass"},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		modify     func(opts *options.InventoryOptions)
+		shouldPass bool
+	}{
+		{"Success", func(opts *options.InventoryOptions) {}, true},
+		{"Failure caused by missing id", func(opts *options.InventoryOptions) { opts.ID = "" }, false},
+		{"Failure caused by too long id", func(opts *options.InventoryOptions) { opts.ID = strings.Repeat("a", MaxIDLength+1) }, false},
+		{"Failure caused by missing destination", func(opts *options.InventoryOptions) { opts.DestinationBucket = "" }, false},
+		{"Failure caused by invalid format", func(opts *options.InventoryOptions) { opts.Format = "JSON" }, false},
+		{"Failure caused by invalid schedule", func(opts *options.InventoryOptions) { opts.Schedule = "Monthly" }, false},
+		{"Failure caused by invalid versions", func(opts *options.InventoryOptions) { opts.IncludedVersions = "None" }, false},
+		{"Failure caused by invalid field", func(opts *options.InventoryOptions) { opts.Fields = []string{"Color"} }, false},
+		{"Failure caused by duplicate field", func(opts *options.InventoryOptions) { opts.Fields = []string{"Size", "Size"} }, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		opts := validOptions()
+		tc.modify(opts)
+
+		err := Validate(opts)
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestToConfiguration(t *testing.T) {
+	opts := validOptions()
+	cfg := ToConfiguration(opts)
+	assert.Equal(t, "arn:aws:s3:::thevpnbeast-inventory", aws.ToString(cfg.Destination.S3BucketDestination.Bucket))
+	assert.Nil(t, cfg.Destination.S3BucketDestination.Prefix)
+	assert.Nil(t, cfg.Filter)
+	assert.Equal(t, []types.InventoryOptionalField{"Size", "StorageClass"}, cfg.OptionalFields)
+
+	opts.DestinationBucket = "arn:aws:s3:::thevpnbeast-inventory"
+	opts.DestinationPrefix = "inventory/"
+	opts.DestinationAccount = "123456789012"
+	opts.Prefix = "backups/"
+	cfg = ToConfiguration(opts)
+	assert.Equal(t, "arn:aws:s3:::thevpnbeast-inventory", aws.ToString(cfg.Destination.S3BucketDestination.Bucket))
+	assert.Equal(t, "inventory/", aws.ToString(cfg.Destination.S3BucketDestination.Prefix))
+	assert.Equal(t, "123456789012", aws.ToString(cfg.Destination.S3BucketDestination.AccountId))
+	assert.Equal(t, "backups/", aws.ToString(cfg.Filter.Prefix))
+}
+
+func TestFormatConfiguration(t *testing.T) {
+	opts := validOptions()
+	opts.Prefix = "backups/"
+	assert.Equal(t, "id=daily, enabled=true, destination=arn:aws:s3:::thevpnbeast-inventory/, format=CSV, "+
+		"schedule=Daily, versions=Current, prefix=backups/, fields=Size,StorageClass", FormatConfiguration(ToConfiguration(opts)))
+	assert.Equal(t, "id=empty, enabled=false, versions=", FormatConfiguration(&types.InventoryConfiguration{Id: aws.String("empty")}))
+}
@@ -8,13 +8,13 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestGetTagOptions(t *testing.T) {
-	opts := GetTagOptions()
+func TestGetListOptions(t *testing.T) {
+	opts := GetListOptions()
 	assert.NotNil(t, opts)
 }
 
-func TestTagOptions_SetZeroValues(t *testing.T) {
-	opts := GetTagOptions()
+func TestListOptions_SetZeroValues(t *testing.T) {
+	opts := GetListOptions()
 	assert.NotNil(t, opts)
 
 	opts.SetZeroValues()
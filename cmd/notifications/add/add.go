@@ -0,0 +1,93 @@
+package add
+
+import (
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/notifications/options"
+	notificationsutils "github.com/bilalcaliskan/s3-manager/cmd/notifications/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/notification"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	notificationsOpts = options.GetNotificationsOptions()
+	notificationsOpts.InitAddFlags(AddCmd)
+}
+
+var (
+	svc               internalawstypes.S3ClientAPI
+	logger            zerolog.Logger
+	confirmRunner     prompt.PromptRunner
+	notificationsOpts *options.NotificationsOptions
+	AddCmd            = &cobra.Command{
+		Use:           "add",
+		Short:         "adds a notification rule that sends the events of the target bucket to an SQS queue, SNS topic or Lambda function",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# send the created events of the objects under "incoming/" prefix to an SQS queue
+s3-manager notifications add --id ingestion --type queue --arn arn:aws:sqs:us-east-1:123456789012:ingestion --events s3:ObjectCreated:* --prefix incoming/
+
+# invoke a Lambda function for the uploaded and copied JPEG files, replaces the rule with the same ID if exists
+s3-manager notifications add --id thumbnail --type lambda --arn arn:aws:lambda:us-east-1:123456789012:function:thumbnail --events s3:ObjectCreated:Put,s3:ObjectCreated:Copy --suffix .jpg
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			notificationsOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while checking arguments")
+				return err
+			}
+
+			rule := notification.Rule{
+				ID:     notificationsOpts.ID,
+				Type:   notificationsOpts.Type,
+				Arn:    notificationsOpts.Arn,
+				Events: notificationsOpts.Events,
+				Prefix: notificationsOpts.Prefix,
+				Suffix: notificationsOpts.Suffix,
+			}
+
+			if err := rule.Validate(); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			current, err := aws.GetNotificationConfiguration(svc, notificationsOpts)
+			if err != nil {
+				logger.Error().Err(err).Msg("an error occurred while getting notification configuration")
+				return err
+			}
+
+			notificationsOpts.Configuration = current.Clone()
+			notificationsOpts.Configuration.Put(rule)
+
+			changes := notification.Diff(current, notificationsOpts.Configuration)
+			if len(changes) == 0 {
+				logger.Warn().Msg(notificationsutils.WarnNoChanges)
+				return nil
+			}
+
+			logger.Info().Msg(notificationsutils.InfWillApply)
+			for _, change := range changes {
+				fmt.Println(notificationsutils.FormatChange(change))
+			}
+
+			if _, err := aws.SetNotificationConfiguration(svc, notificationsOpts, confirmRunner, logger); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while applying notification configuration")
+				return err
+			}
+
+			logger.Info().Msg(notificationsutils.InfSuccess)
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,190 @@
+//go:build e2e
+
+package add
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func getBucketNotificationConfigurationFunc(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+	return &s3.GetBucketNotificationConfigurationOutput{
+		QueueConfigurations: []types.QueueConfiguration{
+			{
+				Id:       aws.String("ingestion"),
+				QueueArn: aws.String("arn:aws:sqs:us-east-1:123456789012:ingestion"),
+				Events:   []types.Event{types.EventS3ObjectCreated},
+				Filter: &types.NotificationConfigurationFilter{Key: &types.S3KeyFilter{FilterRules: []types.FilterRule{
+					{Name: types.FilterRuleNamePrefix, Value: aws.String("incoming/")},
+				}}},
+			},
+		},
+	}, nil
+}
+
+func putBucketNotificationConfigurationFunc(ctx context.Context, params *s3.PutBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error) {
+	return &s3.PutBucketNotificationConfigurationOutput{}, nil
+}
+
+func TestExecuteAddCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	AddCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName                               string
+		args                                   []string
+		shouldPass                             bool
+		getBucketNotificationConfigurationFunc func(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error)
+		putBucketNotificationConfigurationFunc func(ctx context.Context, params *s3.PutBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			[]string{"--id", "thumbnail", "--type", "lambda", "--arn", "arn:aws:lambda:us-east-1:123456789012:function:thumbnail", "--events", "s3:ObjectCreated:Put,s3:ObjectCreated:Copy", "--prefix", "images/", "--suffix", ".jpg"},
+			true,
+			getBucketNotificationConfigurationFunc,
+			putBucketNotificationConfigurationFunc,
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success while updating existing rule",
+			[]string{"--id", "ingestion", "--type", "queue", "--arn", "arn:aws:sqs:us-east-1:123456789012:ingestion", "--events", "s3:ObjectCreated:*", "--prefix", "incoming/images/"},
+			true,
+			getBucketNotificationConfigurationFunc,
+			putBucketNotificationConfigurationFunc,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success when desired rule already exists",
+			[]string{"--id", "ingestion", "--type", "queue", "--arn", "arn:aws:sqs:us-east-1:123456789012:ingestion", "--events", "s3:ObjectCreated:*", "--prefix", "incoming/"},
+			true,
+			getBucketNotificationConfigurationFunc,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			[]string{"--id", "thumbnail", "--type", "lambda", "--arn", "arn:aws:lambda:us-east-1:123456789012:function:thumbnail", "--events", "s3:ObjectCreated:Put,s3:ObjectCreated:Copy", "--prefix", "images/", "--suffix", ".jpg"},
+			true,
+			getBucketNotificationConfigurationFunc,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by invalid rule",
+			[]string{"--id", "thumbnail", "--type", "lambda", "--arn", "arn:aws:sqs:us-east-1:123456789012:ingestion", "--events", "s3:ObjectCreated:*"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by overlapping filters",
+			[]string{"--id", "uploads", "--type", "topic", "--arn", "arn:aws:sns:us-east-1:123456789012:uploads", "--events", "s3:ObjectCreated:Put", "--prefix", "incoming/images/"},
+			false,
+			getBucketNotificationConfigurationFunc,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by get error",
+			[]string{"--id", "thumbnail", "--type", "lambda", "--arn", "arn:aws:lambda:us-east-1:123456789012:function:thumbnail", "--events", "s3:ObjectCreated:Put,s3:ObjectCreated:Copy", "--prefix", "images/", "--suffix", ".jpg"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by put error",
+			[]string{"--id", "thumbnail", "--type", "lambda", "--arn", "arn:aws:lambda:us-east-1:123456789012:function:thumbnail", "--events", "s3:ObjectCreated:Put,s3:ObjectCreated:Copy", "--prefix", "images/", "--suffix", ".jpg"},
+			false,
+			getBucketNotificationConfigurationFunc,
+			func(ctx context.Context, params *s3.PutBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated process",
+			[]string{"--id", "thumbnail", "--type", "lambda", "--arn", "arn:aws:lambda:us-east-1:123456789012:function:thumbnail", "--events", "s3:ObjectCreated:Put,s3:ObjectCreated:Copy", "--prefix", "images/", "--suffix", ".jpg"},
+			false,
+			getBucketNotificationConfigurationFunc,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketNotificationConfigurationAPI = tc.getBucketNotificationConfigurationFunc
+		mockS3.PutBucketNotificationConfigurationAPI = tc.putBucketNotificationConfigurationFunc
+
+		AddCmd.SetContext(context.WithValue(AddCmd.Context(), options.S3ClientKey{}, mockS3))
+		AddCmd.SetContext(context.WithValue(AddCmd.Context(), options.OptsKey{}, rootOpts))
+		AddCmd.SetContext(context.WithValue(AddCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		AddCmd.SetArgs(tc.args)
+
+		err := AddCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		notificationsOpts.SetZeroValues()
+	}
+}
@@ -0,0 +1,23 @@
+package notifications
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/notifications/add"
+	"github.com/bilalcaliskan/s3-manager/cmd/notifications/remove"
+	"github.com/bilalcaliskan/s3-manager/cmd/notifications/show"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	NotificationsCmd.AddCommand(show.ShowCmd)
+	NotificationsCmd.AddCommand(add.AddCmd)
+	NotificationsCmd.AddCommand(remove.RemoveCmd)
+}
+
+var (
+	NotificationsCmd = &cobra.Command{
+		Use:           "notifications",
+		Short:         "shows/sets the event notification configuration of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: false,
+	}
+)
@@ -0,0 +1,13 @@
+//go:build unit
+
+package notifications
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationsCmd(t *testing.T) {
+	assert.NotNil(t, NotificationsCmd)
+}
@@ -0,0 +1,66 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/notification"
+	"github.com/spf13/cobra"
+)
+
+type NotificationsOptsKey struct{}
+
+var notificationsOpts = &NotificationsOptions{}
+
+// NotificationsOptions contains frequent command line and application options.
+type NotificationsOptions struct {
+	// Configuration is the desired notification configuration of the target bucket
+	Configuration *notification.Configuration
+	// ID is the ID of the notification rule to add or remove
+	ID string
+	// Type is the destination type of the notification rule, one of queue, topic or lambda
+	Type string
+	// Arn is the ARN of the destination queue, topic or lambda function
+	Arn string
+	// Events are the event types that trigger the notification
+	Events []string
+	// Prefix is the object key prefix filter of the notification rule
+	Prefix string
+	// Suffix is the object key suffix filter of the notification rule
+	Suffix string
+	// All removes all the notification rules of the target bucket
+	All bool
+	*options.RootOptions
+}
+
+func (opts *NotificationsOptions) InitAddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.ID, "id", "", "", "ID of the notification rule, an existing rule with the same "+
+		"ID is replaced")
+	cmd.Flags().StringVarP(&opts.Type, "type", "", "", "destination type of the notification rule, one of "+
+		"queue, topic or lambda")
+	cmd.Flags().StringVarP(&opts.Arn, "arn", "", "", "ARN of the destination SQS queue, SNS topic or Lambda "+
+		"function")
+	cmd.Flags().StringSliceVarP(&opts.Events, "events", "", []string{}, "comma separated event types that "+
+		"trigger the notification, e.g. s3:ObjectCreated:*")
+	cmd.Flags().StringVarP(&opts.Prefix, "prefix", "", "", "object key prefix filter of the notification rule")
+	cmd.Flags().StringVarP(&opts.Suffix, "suffix", "", "", "object key suffix filter of the notification rule")
+}
+
+func (opts *NotificationsOptions) InitRemoveFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.ID, "id", "", "", "ID of the notification rule to remove")
+	cmd.Flags().BoolVarP(&opts.All, "all", "", false, "removes all the notification rules of the target bucket")
+}
+
+// GetNotificationsOptions returns the pointer of NotificationsOptions
+func GetNotificationsOptions() *NotificationsOptions {
+	return notificationsOpts
+}
+
+func (opts *NotificationsOptions) SetZeroValues() {
+	opts.Configuration = nil
+	opts.ID = ""
+	opts.Type = ""
+	opts.Arn = ""
+	opts.Events = []string{}
+	opts.Prefix = ""
+	opts.Suffix = ""
+	opts.All = false
+}
@@ -0,0 +1,59 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/notification"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNotificationsOptions(t *testing.T) {
+	opts := GetNotificationsOptions()
+	assert.NotNil(t, opts)
+}
+
+func TestNotificationsOptions_InitAddFlags(t *testing.T) {
+	cmd := cobra.Command{}
+	opts := GetNotificationsOptions()
+	opts.InitAddFlags(&cmd)
+
+	for _, name := range []string{"id", "type", "arn", "events", "prefix", "suffix"} {
+		assert.NotNil(t, cmd.Flags().Lookup(name))
+	}
+}
+
+func TestNotificationsOptions_InitRemoveFlags(t *testing.T) {
+	cmd := cobra.Command{}
+	opts := GetNotificationsOptions()
+	opts.InitRemoveFlags(&cmd)
+
+	assert.NotNil(t, cmd.Flags().Lookup("id"))
+	assert.NotNil(t, cmd.Flags().Lookup("all"))
+}
+
+func TestNotificationsOptions_SetZeroValues(t *testing.T) {
+	opts := GetNotificationsOptions()
+	assert.NotNil(t, opts)
+
+	opts.Configuration = &notification.Configuration{}
+	opts.ID = "foo"
+	opts.Type = "queue"
+	opts.Arn = "arn"
+	opts.Events = []string{"s3:ObjectCreated:*"}
+	opts.Prefix = "images/"
+	opts.Suffix = ".jpg"
+	opts.All = true
+	opts.SetZeroValues()
+
+	assert.Nil(t, opts.Configuration)
+	assert.Empty(t, opts.ID)
+	assert.Empty(t, opts.Type)
+	assert.Empty(t, opts.Arn)
+	assert.Empty(t, opts.Events)
+	assert.Empty(t, opts.Prefix)
+	assert.Empty(t, opts.Suffix)
+	assert.False(t, opts.All)
+}
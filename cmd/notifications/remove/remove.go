@@ -0,0 +1,100 @@
+package remove
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/notifications/options"
+	notificationsutils "github.com/bilalcaliskan/s3-manager/cmd/notifications/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/notification"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	notificationsOpts = options.GetNotificationsOptions()
+	notificationsOpts.InitRemoveFlags(RemoveCmd)
+}
+
+var (
+	svc               internalawstypes.S3ClientAPI
+	logger            zerolog.Logger
+	confirmRunner     prompt.PromptRunner
+	notificationsOpts *options.NotificationsOptions
+	RemoveCmd         = &cobra.Command{
+		Use:           "remove",
+		Short:         "removes a single notification rule by its ID, or all the notification rules of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# remove the notification rule with ID "ingestion" from target bucket
+s3-manager notifications remove --id ingestion
+
+# remove all the notification rules of target bucket
+s3-manager notifications remove --all
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			notificationsOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while checking arguments")
+				return err
+			}
+
+			if err := checkFlags(); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			current, err := aws.GetNotificationConfiguration(svc, notificationsOpts)
+			if err != nil {
+				logger.Error().Err(err).Msg("an error occurred while getting notification configuration")
+				return err
+			}
+
+			if len(current.Rules) == 0 {
+				logger.Warn().Msg(notificationsutils.InfNotConfigured)
+				return nil
+			}
+
+			notificationsOpts.Configuration = current.Clone()
+			if notificationsOpts.All {
+				notificationsOpts.Configuration.Rules = []notification.Rule{}
+			} else if err := notificationsOpts.Configuration.RemoveByID(notificationsOpts.ID); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Msg(notificationsutils.InfWillApply)
+			for _, change := range notification.Diff(current, notificationsOpts.Configuration) {
+				fmt.Println(notificationsutils.FormatChange(change))
+			}
+
+			if _, err := aws.SetNotificationConfiguration(svc, notificationsOpts, confirmRunner, logger); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while applying notification configuration")
+				return err
+			}
+
+			logger.Info().Msg(notificationsutils.InfSuccess)
+
+			return nil
+		},
+	}
+)
+
+func checkFlags() error {
+	switch {
+	case notificationsOpts.ID == "" && !notificationsOpts.All:
+		return errors.New(notificationsutils.ErrNoRuleSelector)
+	case notificationsOpts.ID != "" && notificationsOpts.All:
+		return errors.New(notificationsutils.ErrMultipleRuleSelector)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,202 @@
+//go:build e2e
+
+package remove
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func getBucketNotificationConfigurationFunc(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+	return &s3.GetBucketNotificationConfigurationOutput{
+		QueueConfigurations: []types.QueueConfiguration{
+			{
+				Id:       aws.String("ingestion"),
+				QueueArn: aws.String("arn:aws:sqs:us-east-1:123456789012:ingestion"),
+				Events:   []types.Event{types.EventS3ObjectCreated},
+				Filter: &types.NotificationConfigurationFilter{Key: &types.S3KeyFilter{FilterRules: []types.FilterRule{
+					{Name: types.FilterRuleNamePrefix, Value: aws.String("incoming/")},
+				}}},
+			},
+		},
+	}, nil
+}
+
+func putBucketNotificationConfigurationFunc(ctx context.Context, params *s3.PutBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error) {
+	return &s3.PutBucketNotificationConfigurationOutput{}, nil
+}
+
+func TestExecuteRemoveCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	RemoveCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName                               string
+		args                                   []string
+		shouldPass                             bool
+		getBucketNotificationConfigurationFunc func(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error)
+		putBucketNotificationConfigurationFunc func(ctx context.Context, params *s3.PutBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			[]string{"--id", "ingestion"},
+			true,
+			getBucketNotificationConfigurationFunc,
+			putBucketNotificationConfigurationFunc,
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success with all",
+			[]string{"--all"},
+			true,
+			getBucketNotificationConfigurationFunc,
+			putBucketNotificationConfigurationFunc,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success when not configured",
+			[]string{"--all"},
+			true,
+			func(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+				return &s3.GetBucketNotificationConfigurationOutput{}, nil
+			},
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			[]string{"--id", "ingestion"},
+			true,
+			getBucketNotificationConfigurationFunc,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by missing selector",
+			[]string{},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by multiple selectors",
+			[]string{"--id", "ingestion", "--all"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by unknown id",
+			[]string{"--id", "foo"},
+			false,
+			getBucketNotificationConfigurationFunc,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by get error",
+			[]string{"--id", "ingestion"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by put error",
+			[]string{"--id", "ingestion"},
+			false,
+			getBucketNotificationConfigurationFunc,
+			func(ctx context.Context, params *s3.PutBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated process",
+			[]string{"--id", "ingestion"},
+			false,
+			getBucketNotificationConfigurationFunc,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketNotificationConfigurationAPI = tc.getBucketNotificationConfigurationFunc
+		mockS3.PutBucketNotificationConfigurationAPI = tc.putBucketNotificationConfigurationFunc
+
+		RemoveCmd.SetContext(context.WithValue(RemoveCmd.Context(), options.S3ClientKey{}, mockS3))
+		RemoveCmd.SetContext(context.WithValue(RemoveCmd.Context(), options.OptsKey{}, rootOpts))
+		RemoveCmd.SetContext(context.WithValue(RemoveCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		RemoveCmd.SetArgs(tc.args)
+
+		err := RemoveCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		notificationsOpts.SetZeroValues()
+	}
+}
@@ -0,0 +1,67 @@
+package show
+
+import (
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/notifications/options"
+	notificationsutils "github.com/bilalcaliskan/s3-manager/cmd/notifications/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	notificationsOpts = options.GetNotificationsOptions()
+}
+
+var (
+	svc               internalawstypes.S3ClientAPI
+	logger            zerolog.Logger
+	notificationsOpts *options.NotificationsOptions
+	ShowCmd           = &cobra.Command{
+		Use:           "show",
+		Short:         "shows the event notification configuration of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# show the current event notification configuration for target bucket
+s3-manager notifications show
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			notificationsOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			cfg, err := aws.GetNotificationConfiguration(svc, notificationsOpts)
+			if err != nil {
+				logger.Error().
+					Str("error", err.Error()).
+					Msg("an error occurred while getting notification configuration")
+				return err
+			}
+
+			if len(cfg.Rules) == 0 {
+				logger.Info().Msg(notificationsutils.InfNotConfigured)
+				return nil
+			}
+
+			content, err := cfg.String()
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Msg("fetched notification configuration successfully")
+			fmt.Println(content)
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/notification"
+)
+
+const (
+	ErrNoRuleSelector       = "one of '--id' or '--all' flags must be specified"
+	ErrMultipleRuleSelector = "only one of '--id' or '--all' flags can be specified"
+
+	WarnNoChanges    = "desired notification configuration is already applied on target bucket, skipping"
+	InfNotConfigured = "target bucket does not have any event notification configuration"
+	InfWillApply     = "will attempt to apply below changes to the notification configuration"
+	InfSuccess       = "successfully applied notification configuration on target bucket"
+)
+
+var changeSymbols = map[string]string{
+	notification.ChangeAdded:   "+",
+	notification.ChangeRemoved: "-",
+	notification.ChangeUpdated: "~",
+}
+
+// FormatChange returns a single line representation of a rule level change prefixed with +, - or ~ symbols.
+func FormatChange(change notification.Change) string {
+	return fmt.Sprintf("%s %s", changeSymbols[change.Action], change.Rule.String())
+}
@@ -0,0 +1,35 @@
+//go:build unit
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatChange(t *testing.T) {
+	rule := notification.Rule{
+		ID:     "images",
+		Type:   notification.TypeQueue,
+		Arn:    "arn:aws:sqs:us-east-1:123456789012:ingestion",
+		Events: []string{"s3:ObjectCreated:*"},
+		Prefix: "images/",
+	}
+
+	cases := []struct {
+		caseName string
+		action   string
+		expected string
+	}{
+		{"Added", notification.ChangeAdded, "+ " + rule.String()},
+		{"Removed", notification.ChangeRemoved, "- " + rule.String()},
+		{"Updated", notification.ChangeUpdated, "~ " + rule.String()},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+		assert.Equal(t, tc.expected, FormatChange(notification.Change{Action: tc.action, Rule: rule}))
+	}
+}
@@ -0,0 +1,61 @@
+package head
+
+import (
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/object/options"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/objectinfo"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	objectOpts = options.GetObjectOptions()
+	objectOpts.InitHeadFlags(HeadCmd)
+}
+
+var (
+	svc        internalawstypes.S3ClientAPI
+	logger     zerolog.Logger
+	objectOpts *options.ObjectOptions
+	HeadCmd    = &cobra.Command{
+		Use:   "head",
+		Short: "shows the metadata of an object of the target bucket",
+		Long: `shows the metadata of an object of the target bucket without downloading it, that are its content type,
+headers, storage class, server-side encryption, checksum, version, Object Lock state and user metadata`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# show the metadata of the latest version of an object
+s3-manager object head logs/app.log
+
+# show the metadata of a specific version of an object
+s3-manager object head logs/app.log --version-id 3HL4kqtJlcpXroDTDmJ+rmSpXd3dIbrHY
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			objectOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 1); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			head, err := aws.HeadObject(svc, objectOpts, args[0])
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			for _, line := range objectinfo.Report(args[0], head).Lines() {
+				fmt.Println(line)
+			}
+
+			return nil
+		},
+	}
+)
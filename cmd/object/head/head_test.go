@@ -0,0 +1,88 @@
+//go:build e2e
+
+package head
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteHeadCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	HeadCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName       string
+		args           []string
+		shouldPass     bool
+		headObjectFunc func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	}{
+		{
+			"Success",
+			[]string{"logs/app.log"},
+			true,
+			func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+				return &s3.HeadObjectOutput{
+					ContentType:   aws.String("text/plain"),
+					ContentLength: aws.Int64(1024),
+					Metadata:      map[string]string{"owner": "data"},
+				}, nil
+			},
+		},
+		{
+			"Success with version",
+			[]string{"logs/app.log", "--version-id", "v1"},
+			true,
+			func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+				if aws.ToString(params.VersionId) != "v1" {
+					return nil, constants.ErrInjected
+				}
+
+				return &s3.HeadObjectOutput{VersionId: params.VersionId}, nil
+			},
+		},
+		{
+			"Failure caused by head error",
+			[]string{"logs/app.log"},
+			false,
+			func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+		{
+			"Failure caused by missing key",
+			[]string{},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.HeadObjectAPI = tc.headObjectFunc
+
+		HeadCmd.SetContext(context.WithValue(HeadCmd.Context(), options.S3ClientKey{}, mockS3))
+		HeadCmd.SetContext(context.WithValue(HeadCmd.Context(), options.OptsKey{}, rootOpts))
+		HeadCmd.SetArgs(tc.args)
+
+		err := HeadCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		objectOpts.SetZeroValues()
+	}
+}
@@ -0,0 +1,21 @@
+package object
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/object/head"
+	"github.com/bilalcaliskan/s3-manager/cmd/object/setmetadata"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	ObjectCmd.AddCommand(head.HeadCmd)
+	ObjectCmd.AddCommand(setmetadata.SetMetadataCmd)
+}
+
+var (
+	ObjectCmd = &cobra.Command{
+		Use:           "object",
+		Short:         "shows/edits the metadata of the objects of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: false,
+	}
+)
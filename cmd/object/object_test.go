@@ -0,0 +1,13 @@
+//go:build unit
+
+package object
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectCmd(t *testing.T) {
+	assert.NotNil(t, ObjectCmd)
+}
@@ -0,0 +1,76 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/spf13/cobra"
+)
+
+type ObjectOptsKey struct{}
+
+var objectOpts = &ObjectOptions{}
+
+// ObjectOptions contains frequent command line and application options.
+type ObjectOptions struct {
+	// VersionID is the version of the target object, empty string means the latest version
+	VersionID string
+	// Regex is the regex of the target objects, used instead of a single object key
+	Regex string
+	// Metadata is the user metadata to set on the target objects
+	Metadata map[string]string
+	// RemoveMetadata is the keys of the user metadata to remove from the target objects
+	RemoveMetadata []string
+	// ContentType is the Content-Type header to set on the target objects
+	ContentType string
+	// CacheControl is the Cache-Control header to set on the target objects
+	CacheControl string
+	// ContentDisposition is the Content-Disposition header to set on the target objects
+	ContentDisposition string
+	// ContentEncoding is the Content-Encoding header to set on the target objects
+	ContentEncoding string
+	// ContentLanguage is the Content-Language header to set on the target objects
+	ContentLanguage string
+	*options.RootOptions
+}
+
+// InitHeadFlags initializes the flags of the head command.
+func (opts *ObjectOptions) InitHeadFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.VersionID, "version-id", "", "", "version of the target object, empty "+
+		"string means the latest version")
+}
+
+// InitSetMetadataFlags initializes the flags of the set-metadata command.
+func (opts *ObjectOptions) InitSetMetadataFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.Regex, "regex", "", "", "regex of the target objects, used instead of "+
+		"a single object key")
+	cmd.Flags().StringToStringVarP(&opts.Metadata, "metadata", "", map[string]string{}, "comma separated "+
+		"key=value pairs of user metadata to set on the target objects")
+	cmd.Flags().StringSliceVarP(&opts.RemoveMetadata, "remove-metadata", "", []string{}, "comma "+
+		"separated keys of user metadata to remove from the target objects")
+	cmd.Flags().StringVarP(&opts.ContentType, "content-type", "", "", "Content-Type header to set on "+
+		"the target objects")
+	cmd.Flags().StringVarP(&opts.CacheControl, "cache-control", "", "", "Cache-Control header to set on "+
+		"the target objects")
+	cmd.Flags().StringVarP(&opts.ContentDisposition, "content-disposition", "", "", "Content-Disposition "+
+		"header to set on the target objects")
+	cmd.Flags().StringVarP(&opts.ContentEncoding, "content-encoding", "", "", "Content-Encoding header to "+
+		"set on the target objects")
+	cmd.Flags().StringVarP(&opts.ContentLanguage, "content-language", "", "", "Content-Language header to "+
+		"set on the target objects")
+}
+
+// GetObjectOptions returns the pointer of ObjectOptions
+func GetObjectOptions() *ObjectOptions {
+	return objectOpts
+}
+
+func (opts *ObjectOptions) SetZeroValues() {
+	opts.VersionID = ""
+	opts.Regex = ""
+	opts.Metadata = map[string]string{}
+	opts.RemoveMetadata = []string{}
+	opts.ContentType = ""
+	opts.CacheControl = ""
+	opts.ContentDisposition = ""
+	opts.ContentEncoding = ""
+	opts.ContentLanguage = ""
+}
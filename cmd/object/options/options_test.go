@@ -0,0 +1,31 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetObjectOptions(t *testing.T) {
+	opts := GetObjectOptions()
+	assert.NotNil(t, opts)
+}
+
+func TestObjectOptions_SetZeroValues(t *testing.T) {
+	opts := GetObjectOptions()
+	assert.NotNil(t, opts)
+
+	opts.VersionID = "v1"
+	opts.Regex = "^logs/"
+	opts.Metadata = map[string]string{"foo": "bar"}
+	opts.RemoveMetadata = []string{"baz"}
+	opts.ContentType = "text/plain"
+	opts.SetZeroValues()
+	assert.Empty(t, opts.VersionID)
+	assert.Empty(t, opts.Regex)
+	assert.Empty(t, opts.Metadata)
+	assert.Empty(t, opts.RemoveMetadata)
+	assert.Empty(t, opts.ContentType)
+}
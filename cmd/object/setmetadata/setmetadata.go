@@ -0,0 +1,130 @@
+package setmetadata
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/object/options"
+	objectutils "github.com/bilalcaliskan/s3-manager/cmd/object/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/diff"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/objectinfo"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	objectOpts = options.GetObjectOptions()
+	objectOpts.InitSetMetadataFlags(SetMetadataCmd)
+}
+
+var (
+	svc            internalawstypes.S3ClientAPI
+	logger         zerolog.Logger
+	confirmRunner  prompt.PromptRunner
+	objectOpts     *options.ObjectOptions
+	SetMetadataCmd = &cobra.Command{
+		Use:   "set-metadata",
+		Short: "edits the user metadata and headers of one or many objects of the target bucket",
+		Long: `edits the user metadata and headers of an object, or the objects that match '--regex', of the target
+bucket. S3 does not allow editing the metadata of an object, so the objects are copied onto themselves with the
+replaced metadata. Their storage class, server-side encryption and tags are preserved, their ACLs are reset to the
+default of the bucket and a new version is created on versioned buckets. The objects larger than 5 GB are skipped`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# set the Cache-Control header and the owner user metadata of an object
+s3-manager object set-metadata assets/app.js --cache-control "max-age=3600" --metadata owner=web
+
+# fix the Content-Type of all the objects under 'reports/' and remove their legacy user metadata
+s3-manager object set-metadata --regex "^reports/.*\.csv$" --content-type text/csv --remove-metadata legacy
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			objectOpts.RootOptions = rootOpts
+
+			// the target objects are either specified with a single key or with the '--regex' flag
+			allowed := 1
+			if objectOpts.Regex != "" {
+				allowed = 0
+			}
+
+			if err := utils.CheckArgs(args, allowed); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if err := objectutils.ValidateSetMetadata(objectOpts); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			var keys []string
+			if objectOpts.Regex != "" {
+				var objects []types.Object
+				if objects, err = aws.GetDesiredObjects(svc, objectOpts.BucketName, objectOpts.Regex); err != nil {
+					logger.Error().Msg(err.Error())
+					return err
+				}
+
+				keys = utils.GetKeysOnly(objects)
+			} else {
+				keys = []string{args[0]}
+			}
+
+			if len(keys) == 0 {
+				logger.Warn().Msg(objectutils.WarnNoObjects)
+				return nil
+			}
+
+			change := objectutils.ToChange(objectOpts)
+			var updates []objectinfo.Update
+			for _, key := range keys {
+				head, err := aws.HeadObject(svc, objectOpts, key)
+				if err != nil {
+					logger.Error().Msg(err.Error())
+					return err
+				}
+
+				current := objectinfo.Metadata(head)
+				desired := change.Apply(current)
+				if diff.Map(current, desired) == "" {
+					continue
+				}
+
+				if head.ContentLength != nil && *head.ContentLength > objectinfo.MaxCopySize {
+					logger.Warn().Str("key", key).Msg(objectutils.WarnTooLarge)
+					continue
+				}
+
+				updates = append(updates, objectinfo.Update{Key: key, Head: head, Desired: desired})
+			}
+
+			if len(updates) == 0 {
+				logger.Warn().Msg(objectutils.WarnAlreadyDesired)
+				return nil
+			}
+
+			logger.Info().Msg(objectutils.InfWillSetMetadata)
+			for _, update := range updates {
+				fmt.Println(update.Key)
+				fmt.Println(diff.Map(objectinfo.Metadata(update.Head), update.Desired))
+			}
+
+			if err := aws.SetObjectMetadata(svc, objectOpts, updates, confirmRunner, logger); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if !objectOpts.DryRun {
+				logger.Info().Msgf(objectutils.InfMetadataSet, len(updates))
+			}
+
+			return nil
+		},
+	}
+)
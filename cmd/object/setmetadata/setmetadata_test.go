@@ -0,0 +1,233 @@
+//go:build e2e
+
+package setmetadata
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func listObjectsFunc(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+	return &s3.ListObjectsOutput{Contents: []types.Object{
+		{Key: aws.String("reports/a.csv")},
+		{Key: aws.String("reports/b.csv")},
+		{Key: aws.String("reports/huge.csv")},
+		{Key: aws.String("images/c.png")},
+	}}, nil
+}
+
+func headObjectFunc(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+	switch aws.ToString(params.Key) {
+	case "reports/b.csv":
+		return &s3.HeadObjectOutput{ContentType: aws.String("text/csv"), ContentLength: aws.Int64(10)}, nil
+	case "reports/huge.csv":
+		return &s3.HeadObjectOutput{ContentType: aws.String("binary/octet-stream"), ContentLength: aws.Int64(6 * 1024 * 1024 * 1024)}, nil
+	default:
+		return &s3.HeadObjectOutput{
+			ContentType:   aws.String("binary/octet-stream"),
+			ContentLength: aws.Int64(10),
+			Metadata:      map[string]string{"legacy": "true"},
+		}, nil
+	}
+}
+
+func TestExecuteSetMetadataCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	SetMetadataCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName        string
+		args            []string
+		shouldPass      bool
+		listObjectsFunc func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error)
+		headObjectFunc  func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+		copyObjectFunc  func(ctx context.Context, params *s3.CopyObjectInput, optFns ...func(*s3.Options)) (*s3.CopyObjectOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success with single object",
+			[]string{"assets/app.js", "--cache-control", "max-age=3600", "--metadata", "owner=web"},
+			true,
+			nil,
+			headObjectFunc,
+			func(ctx context.Context, params *s3.CopyObjectInput, optFns ...func(*s3.Options)) (*s3.CopyObjectOutput, error) {
+				if aws.ToString(params.CacheControl) != "max-age=3600" || params.Metadata["owner"] != "web" ||
+					params.Metadata["legacy"] != "true" {
+					return nil, constants.ErrInjected
+				}
+
+				return &s3.CopyObjectOutput{}, nil
+			},
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success with regex",
+			[]string{"--regex", "^reports/", "--content-type", "text/csv", "--remove-metadata", "legacy"},
+			true,
+			listObjectsFunc,
+			headObjectFunc,
+			func(ctx context.Context, params *s3.CopyObjectInput, optFns ...func(*s3.Options)) (*s3.CopyObjectOutput, error) {
+				if aws.ToString(params.Key) != "reports/a.csv" || len(params.Metadata) != 0 {
+					return nil, constants.ErrInjected
+				}
+
+				return &s3.CopyObjectOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with dry run",
+			[]string{"assets/app.js", "--content-type", "text/javascript"},
+			true,
+			nil,
+			headObjectFunc,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Success when already at desired state",
+			[]string{"reports/b.csv", "--content-type", "text/csv"},
+			true,
+			nil,
+			headObjectFunc,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Success when no objects match",
+			[]string{"--regex", "^videos/", "--content-type", "video/mp4"},
+			true,
+			listObjectsFunc,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by copy error",
+			[]string{"assets/app.js", "--content-type", "text/javascript"},
+			false,
+			nil,
+			headObjectFunc,
+			func(ctx context.Context, params *s3.CopyObjectInput, optFns ...func(*s3.Options)) (*s3.CopyObjectOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated the process",
+			[]string{"assets/app.js", "--content-type", "text/javascript"},
+			false,
+			nil,
+			headObjectFunc,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by head error",
+			[]string{"assets/app.js", "--content-type", "text/javascript"},
+			false,
+			nil,
+			func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by list error",
+			[]string{"--regex", "^reports/", "--content-type", "text/csv"},
+			false,
+			func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by no change",
+			[]string{"assets/app.js"},
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by both key and regex",
+			[]string{"assets/app.js", "--regex", "^assets/", "--content-type", "text/javascript"},
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListObjectsAPI = tc.listObjectsFunc
+		mockS3.HeadObjectAPI = tc.headObjectFunc
+		mockS3.CopyObjectAPI = tc.copyObjectFunc
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		SetMetadataCmd.SetContext(context.WithValue(SetMetadataCmd.Context(), options.S3ClientKey{}, mockS3))
+		SetMetadataCmd.SetContext(context.WithValue(SetMetadataCmd.Context(), options.OptsKey{}, rootOpts))
+		SetMetadataCmd.SetContext(context.WithValue(SetMetadataCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		SetMetadataCmd.SetArgs(tc.args)
+
+		err := SetMetadataCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		objectOpts.SetZeroValues()
+	}
+}
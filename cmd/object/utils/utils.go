@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/object/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/objectinfo"
+	"github.com/pkg/errors"
+)
+
+const (
+	ErrNoChange        = "at least one of the metadata or header flags must be specified"
+	ErrEmptyMetadata   = "user metadata keys can not be empty"
+	ErrConflictingKeys = "user metadata %q can not be both set and removed"
+
+	WarnTooLarge       = "object is larger than 5 GB, it can not be copied in place, skipping"
+	WarnNoObjects      = "no objects found matching the given regex, skipping operation"
+	WarnAlreadyDesired = "metadata of the target objects are already at desired state, nothing to change"
+
+	InfWillSetMetadata = "will attempt to replace the metadata of below objects by copying them in place, + add, - remove, ~ change"
+	InfMetadataSet     = "successfully set metadata of %d objects"
+)
+
+// ValidateSetMetadata validates the flags of the set-metadata command of ObjectOptions.
+func ValidateSetMetadata(opts *options.ObjectOptions) error {
+	if ToChange(opts).IsEmpty() {
+		return errors.New(ErrNoChange)
+	}
+
+	for _, key := range opts.RemoveMetadata {
+		if strings.TrimSpace(key) == "" {
+			return errors.New(ErrEmptyMetadata)
+		}
+
+		for setKey := range opts.Metadata {
+			if strings.EqualFold(setKey, key) {
+				return fmt.Errorf(ErrConflictingKeys, key)
+			}
+		}
+	}
+
+	for key := range opts.Metadata {
+		if strings.TrimSpace(key) == "" {
+			return errors.New(ErrEmptyMetadata)
+		}
+	}
+
+	_, err := regexp.Compile(opts.Regex)
+
+	return err
+}
+
+// ToChange converts the metadata and header flags of ObjectOptions into a Change, the headers that are not
+// specified are left out.
+func ToChange(opts *options.ObjectOptions) objectinfo.Change {
+	change := objectinfo.Change{
+		Headers:  make(map[string]string),
+		Metadata: opts.Metadata,
+		Remove:   opts.RemoveMetadata,
+	}
+
+	for header, value := range map[string]string{
+		objectinfo.HeaderContentType:        opts.ContentType,
+		objectinfo.HeaderCacheControl:       opts.CacheControl,
+		objectinfo.HeaderContentDisposition: opts.ContentDisposition,
+		objectinfo.HeaderContentEncoding:    opts.ContentEncoding,
+		objectinfo.HeaderContentLanguage:    opts.ContentLanguage,
+	} {
+		if value != "" {
+			change.Headers[header] = value
+		}
+	}
+
+	return change
+}
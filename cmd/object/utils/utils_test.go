@@ -0,0 +1,54 @@
+//go:build unit
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/object/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/objectinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSetMetadata(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		opts       *options.ObjectOptions
+		shouldPass bool
+	}{
+		{"Success with metadata", &options.ObjectOptions{Metadata: map[string]string{"owner": "data"}}, true},
+		{"Success with header and regex", &options.ObjectOptions{CacheControl: "max-age=60", Regex: "^assets/"}, true},
+		{"Success with removal", &options.ObjectOptions{RemoveMetadata: []string{"owner"}}, true},
+		{"Failure caused by no change", &options.ObjectOptions{}, false},
+		{"Failure caused by empty key", &options.ObjectOptions{Metadata: map[string]string{" ": "data"}}, false},
+		{"Failure caused by empty removed key", &options.ObjectOptions{RemoveMetadata: []string{""}}, false},
+		{"Failure caused by conflicting keys", &options.ObjectOptions{Metadata: map[string]string{"Owner": "data"},
+			RemoveMetadata: []string{"owner"}}, false},
+		{"Failure caused by invalid regex", &options.ObjectOptions{ContentType: "text/plain", Regex: "("}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		err := ValidateSetMetadata(tc.opts)
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestToChange(t *testing.T) {
+	change := ToChange(&options.ObjectOptions{
+		Metadata:       map[string]string{"owner": "data"},
+		RemoveMetadata: []string{"foo"},
+		ContentType:    "text/plain",
+		CacheControl:   "no-cache",
+	})
+
+	assert.Equal(t, map[string]string{objectinfo.HeaderContentType: "text/plain", objectinfo.HeaderCacheControl: "no-cache"}, change.Headers)
+	assert.Equal(t, map[string]string{"owner": "data"}, change.Metadata)
+	assert.Equal(t, []string{"foo"}, change.Remove)
+	assert.True(t, ToChange(&options.ObjectOptions{}).IsEmpty())
+}
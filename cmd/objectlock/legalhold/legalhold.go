@@ -0,0 +1,83 @@
+package legalhold
+
+import (
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/objectlock/options"
+	objectlockutils "github.com/bilalcaliskan/s3-manager/cmd/objectlock/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	objectLockOpts = options.GetObjectLockOptions()
+	objectLockOpts.InitLegalHoldFlags(LegalHoldCmd)
+}
+
+var (
+	svc            internalawstypes.S3ClientAPI
+	logger         zerolog.Logger
+	confirmRunner  prompt.PromptRunner
+	objectLockOpts *options.ObjectLockOptions
+	LegalHoldCmd   = &cobra.Command{
+		Use:   "legal-hold",
+		Short: "places or removes a legal hold on the objects of the target bucket that match a regex",
+		Long: `places or removes a legal hold on the objects of the target bucket that match a regex, the objects under
+legal hold can not be deleted until the legal hold is removed, regardless of their retention period`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# place a legal hold on the objects under 'case-1234/'
+s3-manager objectlock legal-hold --regex "^case-1234/"
+
+# remove the legal hold from the objects under 'case-1234/'
+s3-manager objectlock legal-hold --regex "^case-1234/" --status OFF
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			objectLockOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if err := objectlockutils.ValidateLegalHold(objectLockOpts); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			objects, err := aws.GetDesiredObjects(svc, objectLockOpts.BucketName, objectLockOpts.Regex)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if len(objects) == 0 {
+				logger.Warn().Msg(objectlockutils.WarnNoObjects)
+				return nil
+			}
+
+			logger.Info().Msgf(objectlockutils.InfWillSetLegalHold, objectLockOpts.LegalHoldStatus)
+			for _, key := range utils.GetKeysOnly(objects) {
+				fmt.Println(key)
+			}
+
+			if err := aws.SetObjectLegalHold(svc, objectLockOpts, objects, confirmRunner, logger); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if !objectLockOpts.DryRun {
+				logger.Info().Msgf(objectlockutils.InfLegalHoldSet, len(objects))
+			}
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,165 @@
+//go:build e2e
+
+package legalhold
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func listObjectsFunc(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+	return &s3.ListObjectsOutput{
+		Contents: []types.Object{
+			{Key: aws.String("invoices/1.pdf")},
+			{Key: aws.String("invoices/2.pdf")},
+			{Key: aws.String("reports/1.pdf")},
+		},
+	}, nil
+}
+
+func TestExecuteLegalHoldCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	LegalHoldCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName               string
+		args                   []string
+		shouldPass             bool
+		listObjectsFunc        func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error)
+		putObjectLegalHoldFunc func(ctx context.Context, params *s3.PutObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.PutObjectLegalHoldOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			[]string{"--regex", "^invoices/"},
+			true,
+			listObjectsFunc,
+			func(ctx context.Context, params *s3.PutObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.PutObjectLegalHoldOutput, error) {
+				return &s3.PutObjectLegalHoldOutput{}, nil
+			},
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			[]string{"--regex", "^invoices/"},
+			true,
+			listObjectsFunc,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Success with no matching objects",
+			[]string{"--regex", "^invoices/"},
+			true,
+			func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+				return &s3.ListObjectsOutput{}, nil
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by invalid flags",
+			[]string{"--status", "on"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by list objects error",
+			[]string{"--regex", "^invoices/"},
+			false,
+			func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by put error",
+			[]string{"--regex", "^invoices/"},
+			false,
+			listObjectsFunc,
+			func(ctx context.Context, params *s3.PutObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.PutObjectLegalHoldOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated process",
+			[]string{"--regex", "^invoices/"},
+			false,
+			listObjectsFunc,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListObjectsAPI = tc.listObjectsFunc
+		mockS3.PutObjectLegalHoldAPI = tc.putObjectLegalHoldFunc
+
+		LegalHoldCmd.SetContext(context.WithValue(LegalHoldCmd.Context(), options.S3ClientKey{}, mockS3))
+		LegalHoldCmd.SetContext(context.WithValue(LegalHoldCmd.Context(), options.OptsKey{}, rootOpts))
+		LegalHoldCmd.SetContext(context.WithValue(LegalHoldCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		LegalHoldCmd.SetArgs(tc.args)
+
+		err := LegalHoldCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		objectLockOpts.SetZeroValues()
+	}
+}
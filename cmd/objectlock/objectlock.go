@@ -0,0 +1,25 @@
+package objectlock
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/objectlock/legalhold"
+	"github.com/bilalcaliskan/s3-manager/cmd/objectlock/retention"
+	"github.com/bilalcaliskan/s3-manager/cmd/objectlock/setdefault"
+	"github.com/bilalcaliskan/s3-manager/cmd/objectlock/show"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	ObjectLockCmd.AddCommand(show.ShowCmd)
+	ObjectLockCmd.AddCommand(setdefault.SetDefaultCmd)
+	ObjectLockCmd.AddCommand(retention.RetentionCmd)
+	ObjectLockCmd.AddCommand(legalhold.LegalHoldCmd)
+}
+
+var (
+	ObjectLockCmd = &cobra.Command{
+		Use:           "objectlock",
+		Short:         "shows/sets the Object Lock configuration of the target bucket and the retention/legal hold of its objects",
+		SilenceUsage:  false,
+		SilenceErrors: false,
+	}
+)
@@ -0,0 +1,13 @@
+//go:build unit
+
+package objectlock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectLockCmd(t *testing.T) {
+	assert.NotNil(t, ObjectLockCmd)
+}
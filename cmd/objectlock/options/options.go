@@ -0,0 +1,66 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/spf13/cobra"
+)
+
+type ObjectLockOptsKey struct{}
+
+var objectLockOpts = &ObjectLockOptions{LegalHoldStatus: "ON"}
+
+// ObjectLockOptions contains frequent command line and application options.
+type ObjectLockOptions struct {
+	// Mode is the retention mode, valid values are "GOVERNANCE" and "COMPLIANCE"
+	Mode string
+	// Days is the retention period in days
+	Days int
+	// Years is the retention period in years, only used for the default retention of the bucket
+	Years int
+	// Regex is the regex of the target objects to set retention or legal hold on
+	Regex string
+	// LegalHoldStatus is the desired legal hold status of the target objects, valid values are "ON" and "OFF"
+	LegalHoldStatus string
+	*options.RootOptions
+}
+
+// InitSetDefaultFlags initializes the flags of the default retention settings of the bucket.
+func (opts *ObjectLockOptions) InitSetDefaultFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.Mode, "mode", "", "", "default retention mode of the new objects, "+
+		"valid values are GOVERNANCE and COMPLIANCE")
+	cmd.Flags().IntVarP(&opts.Days, "days", "", 0, "default retention period in days, can not be combined "+
+		"with '--years' flag")
+	cmd.Flags().IntVarP(&opts.Years, "years", "", 0, "default retention period in years, can not be combined "+
+		"with '--days' flag")
+}
+
+// InitRetentionFlags initializes the flags of the object level retention settings.
+func (opts *ObjectLockOptions) InitRetentionFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.Regex, "regex", "", "", "regex of the target objects, empty string means "+
+		"all the objects")
+	cmd.Flags().StringVarP(&opts.Mode, "mode", "", "", "retention mode of the target objects, valid values are "+
+		"GOVERNANCE and COMPLIANCE")
+	cmd.Flags().IntVarP(&opts.Days, "days", "", 0, "number of days from now that the target objects are "+
+		"retained until")
+}
+
+// InitLegalHoldFlags initializes the flags of the object level legal hold settings.
+func (opts *ObjectLockOptions) InitLegalHoldFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.Regex, "regex", "", "", "regex of the target objects, empty string means "+
+		"all the objects")
+	cmd.Flags().StringVarP(&opts.LegalHoldStatus, "status", "", "ON", "legal hold status of the target "+
+		"objects, valid values are ON and OFF")
+}
+
+// GetObjectLockOptions returns the pointer of ObjectLockOptions
+func GetObjectLockOptions() *ObjectLockOptions {
+	return objectLockOpts
+}
+
+func (opts *ObjectLockOptions) SetZeroValues() {
+	opts.Mode = ""
+	opts.Days = 0
+	opts.Years = 0
+	opts.Regex = ""
+	opts.LegalHoldStatus = "ON"
+}
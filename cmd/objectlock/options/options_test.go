@@ -0,0 +1,65 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetObjectLockOptions(t *testing.T) {
+	opts := GetObjectLockOptions()
+	assert.NotNil(t, opts)
+}
+
+func TestObjectLockOptions_InitSetDefaultFlags(t *testing.T) {
+	cmd := cobra.Command{}
+	opts := GetObjectLockOptions()
+	opts.InitSetDefaultFlags(&cmd)
+
+	assert.NotNil(t, cmd.Flags().Lookup("mode"))
+	assert.NotNil(t, cmd.Flags().Lookup("days"))
+	assert.NotNil(t, cmd.Flags().Lookup("years"))
+	assert.Nil(t, cmd.Flags().Lookup("regex"))
+}
+
+func TestObjectLockOptions_InitRetentionFlags(t *testing.T) {
+	cmd := cobra.Command{}
+	opts := GetObjectLockOptions()
+	opts.InitRetentionFlags(&cmd)
+
+	assert.NotNil(t, cmd.Flags().Lookup("regex"))
+	assert.NotNil(t, cmd.Flags().Lookup("mode"))
+	assert.NotNil(t, cmd.Flags().Lookup("days"))
+	assert.Nil(t, cmd.Flags().Lookup("years"))
+}
+
+func TestObjectLockOptions_InitLegalHoldFlags(t *testing.T) {
+	cmd := cobra.Command{}
+	opts := GetObjectLockOptions()
+	opts.InitLegalHoldFlags(&cmd)
+
+	assert.NotNil(t, cmd.Flags().Lookup("regex"))
+	assert.NotNil(t, cmd.Flags().Lookup("status"))
+	assert.Nil(t, cmd.Flags().Lookup("mode"))
+}
+
+func TestObjectLockOptions_SetZeroValues(t *testing.T) {
+	opts := GetObjectLockOptions()
+	assert.NotNil(t, opts)
+
+	opts.Mode = "COMPLIANCE"
+	opts.Days = 10
+	opts.Years = 1
+	opts.Regex = "^foo"
+	opts.LegalHoldStatus = "OFF"
+	opts.SetZeroValues()
+
+	assert.Empty(t, opts.Mode)
+	assert.Equal(t, 0, opts.Days)
+	assert.Equal(t, 0, opts.Years)
+	assert.Empty(t, opts.Regex)
+	assert.Equal(t, "ON", opts.LegalHoldStatus)
+}
@@ -0,0 +1,82 @@
+package retention
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/objectlock/options"
+	objectlockutils "github.com/bilalcaliskan/s3-manager/cmd/objectlock/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	objectLockOpts = options.GetObjectLockOptions()
+	objectLockOpts.InitRetentionFlags(RetentionCmd)
+}
+
+var (
+	svc            internalawstypes.S3ClientAPI
+	logger         zerolog.Logger
+	confirmRunner  prompt.PromptRunner
+	objectLockOpts *options.ObjectLockOptions
+	RetentionCmd   = &cobra.Command{
+		Use:   "retention",
+		Short: "sets the retention of the objects of the target bucket that match a regex",
+		Long: `sets the retention of the objects of the target bucket that match a regex, the objects can not be deleted
+until the end of the retention period, compliance mode retention can not be shortened by any user`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# retain the objects under 'invoices/' for 365 days in compliance mode
+s3-manager objectlock retention --regex "^invoices/" --mode COMPLIANCE --days 365
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			objectLockOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if err := objectlockutils.ValidateRetention(objectLockOpts); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			objects, err := aws.GetDesiredObjects(svc, objectLockOpts.BucketName, objectLockOpts.Regex)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if len(objects) == 0 {
+				logger.Warn().Msg(objectlockutils.WarnNoObjects)
+				return nil
+			}
+
+			logger.Info().Msgf(objectlockutils.InfWillSetRetention, objectLockOpts.Mode,
+				time.Now().AddDate(0, 0, objectLockOpts.Days).Format(time.RFC3339))
+			for _, key := range utils.GetKeysOnly(objects) {
+				fmt.Println(key)
+			}
+
+			if err := aws.SetObjectRetention(svc, objectLockOpts, objects, confirmRunner, logger); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if !objectLockOpts.DryRun {
+				logger.Info().Msgf(objectlockutils.InfRetentionSet, len(objects))
+			}
+
+			return nil
+		},
+	}
+)
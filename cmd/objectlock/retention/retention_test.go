@@ -0,0 +1,165 @@
+//go:build e2e
+
+package retention
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func listObjectsFunc(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+	return &s3.ListObjectsOutput{
+		Contents: []types.Object{
+			{Key: aws.String("invoices/1.pdf")},
+			{Key: aws.String("invoices/2.pdf")},
+			{Key: aws.String("reports/1.pdf")},
+		},
+	}, nil
+}
+
+func TestExecuteRetentionCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	RetentionCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName               string
+		args                   []string
+		shouldPass             bool
+		listObjectsFunc        func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error)
+		putObjectRetentionFunc func(ctx context.Context, params *s3.PutObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.PutObjectRetentionOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			[]string{"--regex", "^invoices/", "--mode", "COMPLIANCE", "--days", "365"},
+			true,
+			listObjectsFunc,
+			func(ctx context.Context, params *s3.PutObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.PutObjectRetentionOutput, error) {
+				return &s3.PutObjectRetentionOutput{}, nil
+			},
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			[]string{"--regex", "^invoices/", "--mode", "COMPLIANCE", "--days", "365"},
+			true,
+			listObjectsFunc,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Success with no matching objects",
+			[]string{"--regex", "^invoices/", "--mode", "COMPLIANCE", "--days", "365"},
+			true,
+			func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+				return &s3.ListObjectsOutput{}, nil
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by invalid flags",
+			[]string{"--mode", "COMPLIANCE"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by list objects error",
+			[]string{"--regex", "^invoices/", "--mode", "COMPLIANCE", "--days", "365"},
+			false,
+			func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by put error",
+			[]string{"--regex", "^invoices/", "--mode", "COMPLIANCE", "--days", "365"},
+			false,
+			listObjectsFunc,
+			func(ctx context.Context, params *s3.PutObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.PutObjectRetentionOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated process",
+			[]string{"--regex", "^invoices/", "--mode", "COMPLIANCE", "--days", "365"},
+			false,
+			listObjectsFunc,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListObjectsAPI = tc.listObjectsFunc
+		mockS3.PutObjectRetentionAPI = tc.putObjectRetentionFunc
+
+		RetentionCmd.SetContext(context.WithValue(RetentionCmd.Context(), options.S3ClientKey{}, mockS3))
+		RetentionCmd.SetContext(context.WithValue(RetentionCmd.Context(), options.OptsKey{}, rootOpts))
+		RetentionCmd.SetContext(context.WithValue(RetentionCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		RetentionCmd.SetArgs(tc.args)
+
+		err := RetentionCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		objectLockOpts.SetZeroValues()
+	}
+}
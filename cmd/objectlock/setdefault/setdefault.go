@@ -0,0 +1,75 @@
+package setdefault
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/objectlock/options"
+	objectlockutils "github.com/bilalcaliskan/s3-manager/cmd/objectlock/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	objectLockOpts = options.GetObjectLockOptions()
+	objectLockOpts.InitSetDefaultFlags(SetDefaultCmd)
+}
+
+var (
+	svc            internalawstypes.S3ClientAPI
+	logger         zerolog.Logger
+	confirmRunner  prompt.PromptRunner
+	objectLockOpts *options.ObjectLockOptions
+	SetDefaultCmd  = &cobra.Command{
+		Use:   "set-default",
+		Short: "sets the default retention of the new objects of the target bucket",
+		Long: `sets the default retention of the new objects of the target bucket, Object Lock is enabled on the bucket if
+it is not already, which can not be reverted and requires versioning to be enabled`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# retain the new objects of the target bucket for 30 days in governance mode
+s3-manager objectlock set-default --mode GOVERNANCE --days 30
+
+# retain the new objects of the target bucket for 7 years in compliance mode
+s3-manager objectlock set-default --mode COMPLIANCE --years 7
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			objectLockOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if err := objectlockutils.ValidateDefaultRetention(objectLockOpts); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			cfg, err := aws.GetObjectLockConfiguration(svc, objectLockOpts.RootOptions)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			desired := objectlockutils.ToConfiguration(objectLockOpts)
+			logger.Info().Msgf(objectlockutils.InfCurrentState, objectlockutils.FormatConfiguration(cfg))
+			logger.Info().Msgf(objectlockutils.InfSettingRetention, objectlockutils.FormatConfiguration(desired))
+
+			if _, err := aws.SetDefaultRetention(svc, objectLockOpts, confirmRunner, logger); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if !objectLockOpts.DryRun {
+				logger.Info().Msgf(objectlockutils.InfSuccess, objectlockutils.FormatConfiguration(desired))
+			}
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,158 @@
+//go:build e2e
+
+package setdefault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func getObjectLockConfigurationFunc(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "ObjectLockConfigurationNotFoundError"}
+}
+
+func TestExecuteSetDefaultCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	SetDefaultCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName                       string
+		args                           []string
+		shouldPass                     bool
+		getObjectLockConfigurationFunc func(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error)
+		putObjectLockConfigurationFunc func(ctx context.Context, params *s3.PutObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success with days",
+			[]string{"--mode", "GOVERNANCE", "--days", "30"},
+			true,
+			getObjectLockConfigurationFunc,
+			func(ctx context.Context, params *s3.PutObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
+				return &s3.PutObjectLockConfigurationOutput{}, nil
+			},
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success with years and auto approve",
+			[]string{"--mode", "COMPLIANCE", "--years", "7"},
+			true,
+			getObjectLockConfigurationFunc,
+			func(ctx context.Context, params *s3.PutObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
+				return &s3.PutObjectLockConfigurationOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with dry run",
+			[]string{"--mode", "GOVERNANCE", "--days", "30"},
+			true,
+			getObjectLockConfigurationFunc,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by invalid flags",
+			[]string{"--mode", "GOVERNANCE", "--days", "30", "--years", "1"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by get error",
+			[]string{"--mode", "GOVERNANCE", "--days", "30"},
+			false,
+			func(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by put error",
+			[]string{"--mode", "GOVERNANCE", "--days", "30"},
+			false,
+			getObjectLockConfigurationFunc,
+			func(ctx context.Context, params *s3.PutObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated process",
+			[]string{"--mode", "GOVERNANCE", "--days", "30"},
+			false,
+			getObjectLockConfigurationFunc,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetObjectLockConfigurationAPI = tc.getObjectLockConfigurationFunc
+		mockS3.PutObjectLockConfigurationAPI = tc.putObjectLockConfigurationFunc
+
+		SetDefaultCmd.SetContext(context.WithValue(SetDefaultCmd.Context(), options.S3ClientKey{}, mockS3))
+		SetDefaultCmd.SetContext(context.WithValue(SetDefaultCmd.Context(), options.OptsKey{}, rootOpts))
+		SetDefaultCmd.SetContext(context.WithValue(SetDefaultCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		SetDefaultCmd.SetArgs(tc.args)
+
+		err := SetDefaultCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		objectLockOpts.SetZeroValues()
+	}
+}
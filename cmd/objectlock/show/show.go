@@ -0,0 +1,51 @@
+package show
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/objectlock/options"
+	objectlockutils "github.com/bilalcaliskan/s3-manager/cmd/objectlock/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	objectLockOpts = options.GetObjectLockOptions()
+}
+
+var (
+	svc            internalawstypes.S3ClientAPI
+	logger         zerolog.Logger
+	objectLockOpts *options.ObjectLockOptions
+	ShowCmd        = &cobra.Command{
+		Use:           "show",
+		Short:         "shows the Object Lock configuration and the default retention of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# show the Object Lock configuration of the target bucket
+s3-manager objectlock show
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			objectLockOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			cfg, err := aws.GetObjectLockConfiguration(svc, objectLockOpts.RootOptions)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Msgf(objectlockutils.InfCurrentState, objectlockutils.FormatConfiguration(cfg))
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,120 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/objectlock/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	ErrInvalidMode            = "no such retention mode called %q, valid modes are %v"
+	ErrInvalidPeriod          = "exactly one of '--days' and '--years' flags must be positive"
+	ErrInvalidDays            = "'--days' flag must be positive"
+	ErrInvalidLegalHoldStatus = "no such legal hold status called %q, valid statuses are %v"
+
+	WarnNoObjects = "no objects found matching the given regex, skipping operation"
+
+	InfCurrentState      = "current Object Lock configuration is %s"
+	InfNotConfigured     = "not enabled"
+	InfSuccess           = "successfully configured default retention as %s"
+	InfWillSetRetention  = "will attempt to set retention as %s until %s on below objects"
+	InfRetentionSet      = "successfully set retention on %d objects"
+	InfWillSetLegalHold  = "will attempt to set legal hold status as %s on below objects"
+	InfLegalHoldSet      = "successfully set legal hold status on %d objects"
+	InfSettingRetention  = "setting default retention as %s"
+	InfDefaultNotPresent = "enabled without default retention"
+)
+
+var (
+	ValidModes             = []string{string(types.ObjectLockRetentionModeGovernance), string(types.ObjectLockRetentionModeCompliance)}
+	ValidLegalHoldStatuses = []string{string(types.ObjectLockLegalHoldStatusOn), string(types.ObjectLockLegalHoldStatusOff)}
+)
+
+// ValidateDefaultRetention validates the flags of the default retention settings of ObjectLockOptions.
+func ValidateDefaultRetention(opts *options.ObjectLockOptions) error {
+	if err := validateMode(opts.Mode); err != nil {
+		return err
+	}
+
+	if opts.Days < 0 || opts.Years < 0 || (opts.Days > 0) == (opts.Years > 0) {
+		return errors.New(ErrInvalidPeriod)
+	}
+
+	return nil
+}
+
+// ValidateRetention validates the flags of the object level retention settings of ObjectLockOptions.
+func ValidateRetention(opts *options.ObjectLockOptions) error {
+	if err := validateMode(opts.Mode); err != nil {
+		return err
+	}
+
+	if opts.Days <= 0 {
+		return errors.New(ErrInvalidDays)
+	}
+
+	_, err := regexp.Compile(opts.Regex)
+
+	return err
+}
+
+// ValidateLegalHold validates the flags of the object level legal hold settings of ObjectLockOptions.
+func ValidateLegalHold(opts *options.ObjectLockOptions) error {
+	if !utils.Contains(ValidLegalHoldStatuses, opts.LegalHoldStatus) {
+		return fmt.Errorf(ErrInvalidLegalHoldStatus, opts.LegalHoldStatus, ValidLegalHoldStatuses)
+	}
+
+	_, err := regexp.Compile(opts.Regex)
+
+	return err
+}
+
+func validateMode(mode string) error {
+	if !utils.Contains(ValidModes, mode) {
+		return fmt.Errorf(ErrInvalidMode, mode, ValidModes)
+	}
+
+	return nil
+}
+
+// ToConfiguration converts the default retention settings of ObjectLockOptions into an ObjectLockConfiguration.
+func ToConfiguration(opts *options.ObjectLockOptions) *types.ObjectLockConfiguration {
+	retention := &types.DefaultRetention{Mode: types.ObjectLockRetentionMode(opts.Mode)}
+	if opts.Days > 0 {
+		retention.Days = aws.Int32(int32(opts.Days))
+	} else {
+		retention.Years = aws.Int32(int32(opts.Years))
+	}
+
+	return &types.ObjectLockConfiguration{
+		ObjectLockEnabled: types.ObjectLockEnabledEnabled,
+		Rule:              &types.ObjectLockRule{DefaultRetention: retention},
+	}
+}
+
+// FormatConfiguration returns the human-readable representation of an Object Lock configuration.
+func FormatConfiguration(cfg *types.ObjectLockConfiguration) string {
+	if cfg == nil || cfg.ObjectLockEnabled != types.ObjectLockEnabledEnabled {
+		return InfNotConfigured
+	}
+
+	if cfg.Rule == nil || cfg.Rule.DefaultRetention == nil {
+		return InfDefaultNotPresent
+	}
+
+	return FormatRetention(cfg.Rule.DefaultRetention)
+}
+
+// FormatRetention returns the human-readable representation of a default retention setting.
+func FormatRetention(retention *types.DefaultRetention) string {
+	if retention.Years != nil {
+		return fmt.Sprintf("%s for %d years", retention.Mode, aws.ToInt32(retention.Years))
+	}
+
+	return fmt.Sprintf("%s for %d days", retention.Mode, aws.ToInt32(retention.Days))
+}
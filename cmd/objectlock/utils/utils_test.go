@@ -0,0 +1,117 @@
+//go:build unit
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/objectlock/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDefaultRetention(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		opts       *options.ObjectLockOptions
+		shouldPass bool
+	}{
+		{"Success with days", &options.ObjectLockOptions{Mode: "GOVERNANCE", Days: 30}, true},
+		{"Success with years", &options.ObjectLockOptions{Mode: "COMPLIANCE", Years: 7}, true},
+		{"Failure caused by invalid mode", &options.ObjectLockOptions{Mode: "governance", Days: 30}, false},
+		{"Failure caused by missing period", &options.ObjectLockOptions{Mode: "GOVERNANCE"}, false},
+		{"Failure caused by both days and years", &options.ObjectLockOptions{Mode: "GOVERNANCE", Days: 1, Years: 1}, false},
+		{"Failure caused by negative days", &options.ObjectLockOptions{Mode: "GOVERNANCE", Days: -1, Years: 1}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		err := ValidateDefaultRetention(tc.opts)
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestValidateRetention(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		opts       *options.ObjectLockOptions
+		shouldPass bool
+	}{
+		{"Success", &options.ObjectLockOptions{Mode: "COMPLIANCE", Days: 365, Regex: "^invoices/"}, true},
+		{"Failure caused by invalid mode", &options.ObjectLockOptions{Mode: "", Days: 365}, false},
+		{"Failure caused by missing days", &options.ObjectLockOptions{Mode: "COMPLIANCE"}, false},
+		{"Failure caused by invalid regex", &options.ObjectLockOptions{Mode: "COMPLIANCE", Days: 1, Regex: "[a-"}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		err := ValidateRetention(tc.opts)
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestValidateLegalHold(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		opts       *options.ObjectLockOptions
+		shouldPass bool
+	}{
+		{"Success with ON", &options.ObjectLockOptions{LegalHoldStatus: "ON"}, true},
+		{"Success with OFF", &options.ObjectLockOptions{LegalHoldStatus: "OFF", Regex: "^case/"}, true},
+		{"Failure caused by invalid status", &options.ObjectLockOptions{LegalHoldStatus: "on"}, false},
+		{"Failure caused by invalid regex", &options.ObjectLockOptions{LegalHoldStatus: "ON", Regex: "[a-"}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		err := ValidateLegalHold(tc.opts)
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestToConfiguration(t *testing.T) {
+	cfg := ToConfiguration(&options.ObjectLockOptions{Mode: "GOVERNANCE", Days: 30})
+	assert.Equal(t, types.ObjectLockEnabledEnabled, cfg.ObjectLockEnabled)
+	assert.Equal(t, int32(30), aws.ToInt32(cfg.Rule.DefaultRetention.Days))
+	assert.Nil(t, cfg.Rule.DefaultRetention.Years)
+
+	cfg = ToConfiguration(&options.ObjectLockOptions{Mode: "COMPLIANCE", Years: 7})
+	assert.Equal(t, types.ObjectLockRetentionModeCompliance, cfg.Rule.DefaultRetention.Mode)
+	assert.Equal(t, int32(7), aws.ToInt32(cfg.Rule.DefaultRetention.Years))
+	assert.Nil(t, cfg.Rule.DefaultRetention.Days)
+}
+
+func TestFormatConfiguration(t *testing.T) {
+	cases := []struct {
+		caseName string
+		cfg      *types.ObjectLockConfiguration
+		expected string
+	}{
+		{"Not enabled", &types.ObjectLockConfiguration{}, InfNotConfigured},
+		{"Nil configuration", nil, InfNotConfigured},
+		{"Enabled without default retention", &types.ObjectLockConfiguration{ObjectLockEnabled: types.ObjectLockEnabledEnabled}, InfDefaultNotPresent},
+		{"Enabled with days", ToConfiguration(&options.ObjectLockOptions{Mode: "GOVERNANCE", Days: 30}), "GOVERNANCE for 30 days"},
+		{"Enabled with years", ToConfiguration(&options.ObjectLockOptions{Mode: "COMPLIANCE", Years: 7}), "COMPLIANCE for 7 years"},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+		assert.Equal(t, tc.expected, FormatConfiguration(tc.cfg))
+	}
+}
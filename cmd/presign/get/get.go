@@ -0,0 +1,114 @@
+package get
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/presign/options"
+	presignutils "github.com/bilalcaliskan/s3-manager/cmd/presign/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/presign"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	presignOpts = options.GetPresignOptions()
+	presignOpts.InitGetFlags(GetCmd)
+}
+
+var (
+	svc         internalawstypes.S3ClientAPI
+	logger      zerolog.Logger
+	presignOpts *options.PresignOptions
+	GetCmd      = &cobra.Command{
+		Use:   "get",
+		Short: "generates presigned URLs to download one or many objects of the target bucket",
+		Long: `generates a presigned URL to download an object, or the objects that match '--regex', of the target
+bucket without AWS credentials. The URLs are signed locally with the credentials of s3-manager and are valid for
+'--expiry-minutes' minutes. The Content-Disposition and Content-Type headers of the responses can be overridden,
+e.g. to make the browsers save the objects with another file name. With '--regex' flag the URLs are printed as a CSV
+with 'key' and 'url' columns. The logs are written to the standard error`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Annotations:   map[string]string{rootopts.AnnotationStdoutData: "true"},
+		Example: `# generate a URL that is valid for an hour and makes the browsers save the object as report.csv
+s3-manager presign get reports/2023/05.csv --expiry-minutes 60 --response-content-disposition 'attachment; filename="report.csv"'
+
+# generate URLs for all the objects under 'reports/' and save them as a CSV
+s3-manager presign get --regex "^reports/" > urls.csv
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			presignOpts.RootOptions = rootOpts
+
+			// the target objects are either specified with a single key or with the '--regex' flag
+			allowed := 1
+			if presignOpts.Regex != "" {
+				allowed = 0
+			}
+
+			if err := utils.CheckArgs(args, allowed); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if err := presignutils.ValidateFlags(presignOpts); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			presigner, err := aws.NewPresignClient(svc)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			var keys []string
+			if presignOpts.Regex != "" {
+				var objects []types.Object
+				if objects, err = aws.GetDesiredObjects(svc, presignOpts.BucketName, presignOpts.Regex); err != nil {
+					logger.Error().Msg(err.Error())
+					return err
+				}
+
+				keys = utils.GetKeysOnly(objects)
+			} else {
+				keys = []string{args[0]}
+			}
+
+			if len(keys) == 0 {
+				logger.Warn().Msg(presignutils.WarnNoObjects)
+				return nil
+			}
+
+			expiresAt := time.Now().Add(time.Duration(presignOpts.ExpiryMinutes) * time.Minute)
+			urls, err := aws.PresignGetObjects(presigner, presignOpts, keys)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Msgf(presignutils.InfPresigned, len(urls), expiresAt.UTC().Format(time.RFC3339))
+			if presignOpts.Regex == "" {
+				fmt.Println(urls[0].URL)
+				return nil
+			}
+
+			out, err := presign.MarshalCSV(urls)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			fmt.Println(out)
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,97 @@
+//go:build e2e
+
+package get
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func listObjectsFunc(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+	return &s3.ListObjectsOutput{Contents: []types.Object{
+		{Key: aws.String("reports/a.csv")},
+		{Key: aws.String("reports/b.csv")},
+		{Key: aws.String("images/c.png")},
+	}}, nil
+}
+
+func presignGetObjectFunc(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+	if aws.ToString(params.Key) == "images/c.png" {
+		return nil, constants.ErrInjected
+	}
+
+	return &v4.PresignedHTTPRequest{
+		URL:    "https://thisisbucketname.s3.amazonaws.com/" + aws.ToString(params.Key) + "?X-Amz-Signature=signature",
+		Method: http.MethodGet,
+	}, nil
+}
+
+func TestExecuteGetCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	GetCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName             string
+		args                 []string
+		shouldPass           bool
+		listObjectsFunc      func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error)
+		presignGetObjectFunc func(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.PresignOptions)) (*v4.PresignedHTTPRequest, error)
+	}{
+		{
+			"Success with single object",
+			[]string{"reports/a.csv", "--expiry-minutes", "60", "--response-content-disposition", "attachment"},
+			true,
+			nil,
+			func(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+				if aws.ToString(params.ResponseContentDisposition) != "attachment" {
+					return nil, constants.ErrInjected
+				}
+
+				return presignGetObjectFunc(ctx, params, optFns...)
+			},
+		},
+		{"Success with regex", []string{"--regex", "^reports/"}, true, listObjectsFunc, presignGetObjectFunc},
+		{"Success when no objects match", []string{"--regex", "^videos/"}, true, listObjectsFunc, nil},
+		{"Failure caused by presign error", []string{"images/c.png"}, false, nil, presignGetObjectFunc},
+		{"Failure caused by list error", []string{"--regex", "^reports/"}, false,
+			func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+				return nil, constants.ErrInjected
+			}, nil,
+		},
+		{"Failure caused by invalid expiry", []string{"reports/a.csv", "--expiry-minutes", "20000"}, false, nil, nil},
+		{"Failure caused by both key and regex", []string{"reports/a.csv", "--regex", "^reports/"}, false, nil, nil},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListObjectsAPI = tc.listObjectsFunc
+		mockS3.PresignGetObjectAPI = tc.presignGetObjectFunc
+
+		GetCmd.SetContext(context.WithValue(GetCmd.Context(), options.S3ClientKey{}, mockS3))
+		GetCmd.SetContext(context.WithValue(GetCmd.Context(), options.OptsKey{}, rootOpts))
+		GetCmd.SetArgs(tc.args)
+
+		err := GetCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		presignOpts.SetZeroValues()
+	}
+}
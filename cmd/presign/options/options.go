@@ -0,0 +1,66 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/spf13/cobra"
+)
+
+type PresignOptsKey struct{}
+
+var presignOpts = &PresignOptions{}
+
+// PresignOptions contains frequent command line and application options.
+type PresignOptions struct {
+	// Regex is the regex of the target objects, used instead of a single object key to presign in batch
+	Regex string
+	// ExpiryMinutes is the number of minutes that the presigned URLs are valid for
+	ExpiryMinutes int
+	// ResponseContentDisposition overrides the Content-Disposition header of the responses of the presigned GET URLs
+	ResponseContentDisposition string
+	// ResponseContentType overrides the Content-Type header of the responses of the presigned GET URLs
+	ResponseContentType string
+	// ContentType is the Content-Type header that the uploads with the presigned PUT URLs must be sent with
+	ContentType string
+	// ChecksumSHA256 is the base64 encoded SHA-256 checksum that the uploads with the presigned PUT URL must match
+	ChecksumSHA256 string
+	*options.RootOptions
+}
+
+// InitGetFlags initializes the flags of the get command.
+func (opts *PresignOptions) InitGetFlags(cmd *cobra.Command) {
+	opts.initCommonFlags(cmd)
+	cmd.Flags().StringVarP(&opts.ResponseContentDisposition, "response-content-disposition", "", "",
+		"overrides the Content-Disposition header of the responses, e.g. 'attachment; filename=\"report.csv\"'")
+	cmd.Flags().StringVarP(&opts.ResponseContentType, "response-content-type", "", "", "overrides the "+
+		"Content-Type header of the responses")
+}
+
+// InitPutFlags initializes the flags of the put command.
+func (opts *PresignOptions) InitPutFlags(cmd *cobra.Command) {
+	opts.initCommonFlags(cmd)
+	cmd.Flags().StringVarP(&opts.ContentType, "content-type", "", "", "Content-Type header that the "+
+		"uploads must be sent with, empty string means any content type")
+	cmd.Flags().StringVarP(&opts.ChecksumSHA256, "checksum-sha256", "", "", "base64 encoded SHA-256 "+
+		"checksum that the uploaded content must match, empty string means no checksum constraint")
+}
+
+func (opts *PresignOptions) initCommonFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.Regex, "regex", "", "", "regex of the target objects, used instead of "+
+		"a single object key to print the presigned URLs of all the matching objects as CSV")
+	cmd.Flags().IntVarP(&opts.ExpiryMinutes, "expiry-minutes", "", 15, "number of minutes that the "+
+		"presigned URLs are valid for, at most 10080 minutes (7 days)")
+}
+
+// GetPresignOptions returns the pointer of PresignOptions
+func GetPresignOptions() *PresignOptions {
+	return presignOpts
+}
+
+func (opts *PresignOptions) SetZeroValues() {
+	opts.Regex = ""
+	opts.ExpiryMinutes = 15
+	opts.ResponseContentDisposition = ""
+	opts.ResponseContentType = ""
+	opts.ContentType = ""
+	opts.ChecksumSHA256 = ""
+}
@@ -0,0 +1,31 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPresignOptions(t *testing.T) {
+	opts := GetPresignOptions()
+	assert.NotNil(t, opts)
+}
+
+func TestPresignOptions_SetZeroValues(t *testing.T) {
+	opts := GetPresignOptions()
+	assert.NotNil(t, opts)
+
+	opts.Regex = "^reports/"
+	opts.ExpiryMinutes = 60
+	opts.ResponseContentDisposition = "attachment"
+	opts.ContentType = "text/csv"
+	opts.ChecksumSHA256 = "checksum"
+	opts.SetZeroValues()
+	assert.Empty(t, opts.Regex)
+	assert.Equal(t, 15, opts.ExpiryMinutes)
+	assert.Empty(t, opts.ResponseContentDisposition)
+	assert.Empty(t, opts.ContentType)
+	assert.Empty(t, opts.ChecksumSHA256)
+}
@@ -0,0 +1,21 @@
+package presign
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/presign/get"
+	"github.com/bilalcaliskan/s3-manager/cmd/presign/put"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	PresignCmd.AddCommand(get.GetCmd)
+	PresignCmd.AddCommand(put.PutCmd)
+}
+
+var (
+	PresignCmd = &cobra.Command{
+		Use:           "presign",
+		Short:         "generates presigned URLs to download/upload the objects of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: false,
+	}
+)
@@ -0,0 +1,13 @@
+//go:build unit
+
+package presign
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresignCmd(t *testing.T) {
+	assert.NotNil(t, PresignCmd)
+}
@@ -0,0 +1,122 @@
+package put
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/presign/options"
+	presignutils "github.com/bilalcaliskan/s3-manager/cmd/presign/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/presign"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	presignOpts = options.GetPresignOptions()
+	presignOpts.InitPutFlags(PutCmd)
+}
+
+var (
+	svc         internalawstypes.S3ClientAPI
+	logger      zerolog.Logger
+	presignOpts *options.PresignOptions
+	PutCmd      = &cobra.Command{
+		Use:   "put",
+		Short: "generates presigned URLs to upload one or many objects to the target bucket",
+		Long: `generates a presigned URL to upload an object, or to overwrite the objects that match '--regex', of the
+target bucket without AWS credentials. The URLs are signed locally with the credentials of s3-manager and are valid
+for '--expiry-minutes' minutes. '--content-type' flag makes the uploads to be sent with the given Content-Type
+header and '--checksum-sha256' flag makes S3 reject the uploads whose content does not match the checksum. With
+'--regex' flag the URLs are printed as a CSV with 'key' and 'url' columns, and a 'headers' column with the headers
+that the uploads must be sent with if any of those flags is set. The logs are written to the standard error`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Annotations:   map[string]string{rootopts.AnnotationStdoutData: "true"},
+		Example: `# generate a URL that is valid for 30 minutes to upload a csv report
+s3-manager presign put reports/2023/05.csv --expiry-minutes 30 --content-type text/csv
+
+# generate a URL that accepts only the content with the given checksum, e.g. from 'openssl dgst -sha256 -binary report.csv | base64'
+s3-manager presign put reports/2023/05.csv --checksum-sha256 "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="
+
+# generate URLs to overwrite the csv reports, with the Content-Type header to send in the 'headers' column
+s3-manager presign put --regex "^reports/.*\.csv$" --content-type text/csv > urls.csv
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			presignOpts.RootOptions = rootOpts
+
+			// the target objects are either specified with a single key or with the '--regex' flag
+			allowed := 1
+			if presignOpts.Regex != "" {
+				allowed = 0
+			}
+
+			if err := utils.CheckArgs(args, allowed); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if err := presignutils.ValidateFlags(presignOpts); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			presigner, err := aws.NewPresignClient(svc)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			var keys []string
+			if presignOpts.Regex != "" {
+				var objects []types.Object
+				if objects, err = aws.GetDesiredObjects(svc, presignOpts.BucketName, presignOpts.Regex); err != nil {
+					logger.Error().Msg(err.Error())
+					return err
+				}
+
+				keys = utils.GetKeysOnly(objects)
+			} else {
+				keys = []string{args[0]}
+			}
+
+			if len(keys) == 0 {
+				logger.Warn().Msg(presignutils.WarnNoObjects)
+				return nil
+			}
+
+			expiresAt := time.Now().Add(time.Duration(presignOpts.ExpiryMinutes) * time.Minute)
+			urls, err := aws.PresignPutObjects(presigner, presignOpts, keys)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Msgf(presignutils.InfPresigned, len(urls), expiresAt.UTC().Format(time.RFC3339))
+			if headers := urls[0].HeaderLines(); len(headers) > 0 {
+				logger.Info().Strs("headers", headers).Msg(presignutils.InfRequiredHeaders)
+			}
+
+			if presignOpts.Regex == "" {
+				fmt.Println(urls[0].URL)
+				return nil
+			}
+
+			out, err := presign.MarshalCSV(urls)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			fmt.Println(out)
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,94 @@
+//go:build e2e
+
+package put
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func listObjectsFunc(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+	return &s3.ListObjectsOutput{Contents: []types.Object{
+		{Key: aws.String("reports/a.csv")},
+		{Key: aws.String("reports/b.csv")},
+	}}, nil
+}
+
+func presignPutObjectFunc(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+	return &v4.PresignedHTTPRequest{
+		URL:          "https://thisisbucketname.s3.amazonaws.com/" + aws.ToString(params.Key) + "?X-Amz-Signature=signature",
+		Method:       http.MethodPut,
+		SignedHeader: http.Header{"Content-Type": []string{"text/csv"}},
+	}, nil
+}
+
+func TestExecutePutCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	PutCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName             string
+		args                 []string
+		shouldPass           bool
+		listObjectsFunc      func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error)
+		presignPutObjectFunc func(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.PresignOptions)) (*v4.PresignedHTTPRequest, error)
+	}{
+		{
+			"Success with single object",
+			[]string{"reports/a.csv", "--content-type", "text/csv", "--checksum-sha256", "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="},
+			true,
+			nil,
+			func(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+				if aws.ToString(params.ChecksumSHA256) != "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=" || len(optFns) != 2 {
+					return nil, constants.ErrInjected
+				}
+
+				return presignPutObjectFunc(ctx, params, optFns...)
+			},
+		},
+		{"Success with regex", []string{"--regex", "^reports/", "--content-type", "text/csv"}, true, listObjectsFunc, presignPutObjectFunc},
+		{"Success when no objects match", []string{"--regex", "^videos/"}, true, listObjectsFunc, nil},
+		{"Failure caused by presign error", []string{"reports/a.csv"}, false, nil,
+			func(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+		{"Failure caused by checksum with regex", []string{"--regex", "^reports/", "--checksum-sha256",
+			"47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="}, false, nil, nil},
+		{"Failure caused by invalid checksum", []string{"reports/a.csv", "--checksum-sha256", "invalid"}, false, nil, nil},
+		{"Failure caused by missing key", []string{}, false, nil, nil},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListObjectsAPI = tc.listObjectsFunc
+		mockS3.PresignPutObjectAPI = tc.presignPutObjectFunc
+
+		PutCmd.SetContext(context.WithValue(PutCmd.Context(), options.S3ClientKey{}, mockS3))
+		PutCmd.SetContext(context.WithValue(PutCmd.Context(), options.OptsKey{}, rootOpts))
+		PutCmd.SetArgs(tc.args)
+
+		err := PutCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		presignOpts.SetZeroValues()
+	}
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"regexp"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/presign/options"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxExpiryMinutes is the longest validity of a presigned URL that is signed with Signature Version 4
+	MaxExpiryMinutes = 7 * 24 * 60
+
+	ErrInvalidExpiry       = "'--expiry-minutes' flag must be between 1 and 10080"
+	ErrInvalidChecksum     = "'--checksum-sha256' flag must be a base64 encoded SHA-256 checksum"
+	ErrChecksumWithRegex   = "'--checksum-sha256' flag can not be used with '--regex' flag, the objects have different contents"
+	ErrPresignNotSupported = "the S3 client does not support presigning"
+
+	WarnNoObjects = "no objects found matching the given regex, skipping operation"
+
+	InfPresigned       = "presigned %d URLs that are valid until %s, or until the credentials they are signed with expire"
+	InfRequiredHeaders = "the uploads must be sent with the signed headers"
+)
+
+// ValidateFlags validates the flags of PresignOptions.
+func ValidateFlags(opts *options.PresignOptions) error {
+	if opts.ExpiryMinutes < 1 || opts.ExpiryMinutes > MaxExpiryMinutes {
+		return errors.New(ErrInvalidExpiry)
+	}
+
+	if opts.ChecksumSHA256 != "" {
+		if opts.Regex != "" {
+			return errors.New(ErrChecksumWithRegex)
+		}
+
+		if checksum, err := base64.StdEncoding.DecodeString(opts.ChecksumSHA256); err != nil || len(checksum) != sha256.Size {
+			return errors.New(ErrInvalidChecksum)
+		}
+	}
+
+	_, err := regexp.Compile(opts.Regex)
+
+	return err
+}
@@ -0,0 +1,39 @@
+//go:build unit
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/presign/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateFlags(t *testing.T) {
+	checksum := "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="
+	cases := []struct {
+		caseName   string
+		opts       *options.PresignOptions
+		shouldPass bool
+	}{
+		{"Success", &options.PresignOptions{ExpiryMinutes: 15}, true},
+		{"Success with maximum expiry", &options.PresignOptions{ExpiryMinutes: MaxExpiryMinutes, Regex: "^reports/"}, true},
+		{"Success with checksum", &options.PresignOptions{ExpiryMinutes: 15, ChecksumSHA256: checksum}, true},
+		{"Failure caused by zero expiry", &options.PresignOptions{}, false},
+		{"Failure caused by too long expiry", &options.PresignOptions{ExpiryMinutes: MaxExpiryMinutes + 1}, false},
+		{"Failure caused by invalid checksum", &options.PresignOptions{ExpiryMinutes: 15, ChecksumSHA256: "c2hhMjU2"}, false},
+		{"Failure caused by checksum with regex", &options.PresignOptions{ExpiryMinutes: 15, ChecksumSHA256: checksum, Regex: "^reports/"}, false},
+		{"Failure caused by invalid regex", &options.PresignOptions{ExpiryMinutes: 15, Regex: "("}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		err := ValidateFlags(tc.opts)
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package check
+
+import (
+	"fmt"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/publicaccess/options"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	publicAccessOpts = options.GetPublicAccessOptions()
+}
+
+var (
+	svc              internalawstypes.S3ClientAPI
+	logger           zerolog.Logger
+	publicAccessOpts *options.PublicAccessOptions
+	CheckCmd         = &cobra.Command{
+		Use:   "check",
+		Short: "checks if the target bucket is public by evaluating its policy, ACL and public access block configuration",
+		Long: `checks if the target bucket is public by evaluating its policy statements, ACL grants and public access block
+configuration together, exits with a non-zero exit code if the bucket is public so that it can be used in CI checks`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# check if the target bucket is public
+s3-manager publicaccess check
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			publicAccessOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			verdict, err := aws.GetPublicAccessVerdict(svc, publicAccessOpts)
+			if err != nil {
+				logger.Error().
+					Str("error", err.Error()).
+					Msg("an error occurred while evaluating public access")
+				return err
+			}
+
+			for _, reason := range verdict.Reasons {
+				fmt.Println(reason)
+			}
+
+			if verdict.Public {
+				logger.Error().Msg(constants.ErrBucketPublic.Error())
+				return constants.ErrBucketPublic
+			}
+
+			logger.Info().Msg("bucket is not publicly accessible")
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,157 @@
+//go:build e2e
+
+package check
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+var publicPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "PublicRead",
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::thevpnbeast-releases-1/*"
+    }
+  ]
+}`
+
+func TestExecuteCheckCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	CheckCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName                 string
+		args                     []string
+		shouldPass               bool
+		getBucketPolicyFunc      func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error)
+		getBucketAclFunc         func(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options)) (*s3.GetBucketAclOutput, error)
+		getPublicAccessBlockFunc func(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error)
+	}{
+		{
+			"Too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"Success not public",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "NoSuchBucketPolicy"}
+			},
+			func(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+				return &s3.GetBucketAclOutput{}, nil
+			},
+			func(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "NoSuchPublicAccessBlockConfiguration"}
+			},
+		},
+		{
+			"Success public policy restricted by public access block",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return &s3.GetBucketPolicyOutput{Policy: aws.String(publicPolicy)}, nil
+			},
+			func(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+				return &s3.GetBucketAclOutput{}, nil
+			},
+			func(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+				return &s3.GetPublicAccessBlockOutput{
+					PublicAccessBlockConfiguration: &types.PublicAccessBlockConfiguration{
+						RestrictPublicBuckets: aws.Bool(true),
+					},
+				}, nil
+			},
+		},
+		{
+			"Failure caused by public policy",
+			[]string{},
+			false,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return &s3.GetBucketPolicyOutput{Policy: aws.String(publicPolicy)}, nil
+			},
+			func(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+				return &s3.GetBucketAclOutput{}, nil
+			},
+			func(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+				return &s3.GetPublicAccessBlockOutput{}, nil
+			},
+		},
+		{
+			"Failure caused by public acl",
+			[]string{},
+			false,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "NoSuchBucketPolicy"}
+			},
+			func(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+				return &s3.GetBucketAclOutput{
+					Grants: []types.Grant{
+						{
+							Grantee: &types.Grantee{
+								Type: types.TypeGroup,
+								URI:  aws.String("http://acs.amazonaws.com/groups/global/AllUsers"),
+							},
+							Permission: types.PermissionRead,
+						},
+					},
+				}, nil
+			},
+			func(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+				return &s3.GetPublicAccessBlockOutput{}, nil
+			},
+		},
+		{
+			"Failure caused by get bucket policy error",
+			[]string{},
+			false,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketPolicyAPI = tc.getBucketPolicyFunc
+		mockS3.GetBucketAclAPI = tc.getBucketAclFunc
+		mockS3.GetPublicAccessBlockAPI = tc.getPublicAccessBlockFunc
+
+		CheckCmd.SetContext(context.WithValue(CheckCmd.Context(), options.S3ClientKey{}, mockS3))
+		CheckCmd.SetContext(context.WithValue(CheckCmd.Context(), options.OptsKey{}, rootOpts))
+		CheckCmd.SetArgs(tc.args)
+
+		err := CheckCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		publicAccessOpts.SetZeroValues()
+	}
+}
@@ -0,0 +1,55 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/spf13/cobra"
+)
+
+type PublicAccessOptsKey struct{}
+
+var publicAccessOpts = &PublicAccessOptions{}
+
+// State is the public access block configuration of a bucket
+type State struct {
+	// BlockPublicAcls rejects the requests that add public ACLs to the bucket and its objects
+	BlockPublicAcls bool
+	// IgnorePublicAcls ignores the public ACLs of the bucket and its objects
+	IgnorePublicAcls bool
+	// BlockPublicPolicy rejects the bucket policies that grant public access
+	BlockPublicPolicy bool
+	// RestrictPublicBuckets restricts the access of a bucket with a public policy to AWS services and authorized users
+	RestrictPublicBuckets bool
+}
+
+// PublicAccessOptions contains frequent command line and application options.
+type PublicAccessOptions struct {
+	ActualState  State
+	DesiredState State
+	*options.RootOptions
+}
+
+func (opts *PublicAccessOptions) InitFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&opts.DesiredState.BlockPublicAcls, "block-public-acls", "", true,
+		"rejects the requests that add public ACLs to the bucket and its objects")
+	cmd.Flags().BoolVarP(&opts.DesiredState.IgnorePublicAcls, "ignore-public-acls", "", true,
+		"ignores the public ACLs of the bucket and its objects")
+	cmd.Flags().BoolVarP(&opts.DesiredState.BlockPublicPolicy, "block-public-policy", "", true,
+		"rejects the bucket policies that grant public access")
+	cmd.Flags().BoolVarP(&opts.DesiredState.RestrictPublicBuckets, "restrict-public-buckets", "", true,
+		"restricts the access of a bucket with a public policy to AWS services and authorized users")
+}
+
+// GetPublicAccessOptions returns the pointer of PublicAccessOptions
+func GetPublicAccessOptions() *PublicAccessOptions {
+	return publicAccessOpts
+}
+
+func (opts *PublicAccessOptions) SetZeroValues() {
+	opts.ActualState = State{}
+	opts.DesiredState = State{
+		BlockPublicAcls:       true,
+		IgnorePublicAcls:      true,
+		BlockPublicPolicy:     true,
+		RestrictPublicBuckets: true,
+	}
+}
@@ -0,0 +1,37 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPublicAccessOptions(t *testing.T) {
+	opts := GetPublicAccessOptions()
+	assert.NotNil(t, opts)
+}
+
+func TestPublicAccessOptions_InitFlags(t *testing.T) {
+	cmd := cobra.Command{}
+	opts := GetPublicAccessOptions()
+	opts.InitFlags(&cmd)
+
+	assert.NotNil(t, cmd.Flags().Lookup("block-public-acls"))
+	assert.NotNil(t, cmd.Flags().Lookup("ignore-public-acls"))
+	assert.NotNil(t, cmd.Flags().Lookup("block-public-policy"))
+	assert.NotNil(t, cmd.Flags().Lookup("restrict-public-buckets"))
+}
+
+func TestPublicAccessOptions_SetZeroValues(t *testing.T) {
+	opts := GetPublicAccessOptions()
+	assert.NotNil(t, opts)
+
+	opts.ActualState.BlockPublicAcls = true
+	opts.DesiredState.IgnorePublicAcls = false
+	opts.SetZeroValues()
+	assert.Equal(t, State{}, opts.ActualState)
+	assert.Equal(t, State{true, true, true, true}, opts.DesiredState)
+}
@@ -0,0 +1,23 @@
+package publicaccess
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/publicaccess/check"
+	"github.com/bilalcaliskan/s3-manager/cmd/publicaccess/set"
+	"github.com/bilalcaliskan/s3-manager/cmd/publicaccess/show"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	PublicAccessCmd.AddCommand(show.ShowCmd)
+	PublicAccessCmd.AddCommand(set.SetCmd)
+	PublicAccessCmd.AddCommand(check.CheckCmd)
+}
+
+var (
+	PublicAccessCmd = &cobra.Command{
+		Use:           "publicaccess",
+		Short:         "shows/sets the public access block configuration of the target bucket and checks if it is public",
+		SilenceUsage:  false,
+		SilenceErrors: false,
+	}
+)
@@ -0,0 +1,13 @@
+//go:build unit
+
+package publicaccess
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicAccessCmd(t *testing.T) {
+	assert.NotNil(t, PublicAccessCmd)
+}
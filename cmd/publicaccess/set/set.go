@@ -0,0 +1,48 @@
+package set
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/publicaccess/options"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	publicAccessOpts = options.GetPublicAccessOptions()
+	publicAccessOpts.InitFlags(SetCmd)
+}
+
+var (
+	svc              internalawstypes.S3ClientAPI
+	logger           zerolog.Logger
+	confirmRunner    prompt.PromptRunner
+	publicAccessOpts *options.PublicAccessOptions
+	SetCmd           = &cobra.Command{
+		Use:           "set",
+		Short:         "sets the public access block configuration for the target bucket, all settings are enabled by default",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# block all public access to the target bucket
+s3-manager publicaccess set
+
+# block public policies but allow public ACLs on the target bucket
+s3-manager publicaccess set --block-public-acls=false --ignore-public-acls=false
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			publicAccessOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			return aws.SetPublicAccessBlock(svc, publicAccessOpts, confirmRunner, logger)
+		},
+	}
+)
@@ -0,0 +1,172 @@
+//go:build e2e
+
+package set
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+var blockedOutput = &s3.GetPublicAccessBlockOutput{
+	PublicAccessBlockConfiguration: &types.PublicAccessBlockConfiguration{
+		BlockPublicAcls:       aws.Bool(true),
+		IgnorePublicAcls:      aws.Bool(true),
+		BlockPublicPolicy:     aws.Bool(true),
+		RestrictPublicBuckets: aws.Bool(true),
+	},
+}
+
+func TestExecuteSetCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	SetCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName                 string
+		args                     []string
+		shouldPass               bool
+		getPublicAccessBlockFunc func(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error)
+		putPublicAccessBlockFunc func(ctx context.Context, params *s3.PutPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Success",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+				return &s3.GetPublicAccessBlockOutput{}, nil
+			},
+			func(ctx context.Context, params *s3.PutPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error) {
+				return &s3.PutPublicAccessBlockOutput{}, nil
+			},
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success with flags",
+			[]string{"--block-public-acls=false", "--ignore-public-acls=false"},
+			true,
+			func(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+				return blockedOutput, nil
+			},
+			func(ctx context.Context, params *s3.PutPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error) {
+				return &s3.PutPublicAccessBlockOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success already at desired state",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+				return blockedOutput, nil
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with dry run",
+			[]string{},
+			true,
+			nil,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by put error",
+			[]string{},
+			false,
+			func(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+				return &s3.GetPublicAccessBlockOutput{}, nil
+			},
+			func(ctx context.Context, params *s3.PutPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by get error",
+			[]string{},
+			false,
+			func(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated process",
+			[]string{},
+			false,
+			nil,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetPublicAccessBlockAPI = tc.getPublicAccessBlockFunc
+		mockS3.PutPublicAccessBlockAPI = tc.putPublicAccessBlockFunc
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		SetCmd.SetContext(context.WithValue(SetCmd.Context(), options.S3ClientKey{}, mockS3))
+		SetCmd.SetContext(context.WithValue(SetCmd.Context(), options.OptsKey{}, rootOpts))
+		SetCmd.SetContext(context.WithValue(SetCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		SetCmd.SetArgs(tc.args)
+
+		err := SetCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		publicAccessOpts.SetZeroValues()
+	}
+}
@@ -0,0 +1,52 @@
+package show
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/publicaccess/options"
+	publicaccessutils "github.com/bilalcaliskan/s3-manager/cmd/publicaccess/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	publicAccessOpts = options.GetPublicAccessOptions()
+}
+
+var (
+	svc              internalawstypes.S3ClientAPI
+	logger           zerolog.Logger
+	publicAccessOpts *options.PublicAccessOptions
+	ShowCmd          = &cobra.Command{
+		Use:           "show",
+		Short:         "shows the public access block configuration for the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# show the current public access block configuration for bucket
+s3-manager publicaccess show
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			publicAccessOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			res, err := aws.GetPublicAccessBlock(svc, publicAccessOpts)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			publicaccessutils.DecideActualState(res, publicAccessOpts)
+			logger.Info().Msgf(publicaccessutils.InfCurrentState, publicaccessutils.FormatState(publicAccessOpts.ActualState))
+
+			return nil
+		},
+	}
+)
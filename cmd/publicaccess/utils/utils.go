@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/publicaccess/options"
+)
+
+const (
+	WarnDesiredState = "public access block is already at the desired state, skipping configuration"
+
+	InfSuccess             = "successfully configured public access block as %s"
+	InfCurrentState        = "current public access block configuration is %s"
+	InfSettingPublicAccess = "setting public access block as %s"
+)
+
+// DecideActualState fills the ActualState of PublicAccessOptions with the configuration returned from the
+// GetPublicAccessBlock call. A bucket without any public access block configuration has all settings disabled.
+func DecideActualState(res *s3.GetPublicAccessBlockOutput, opts *options.PublicAccessOptions) {
+	opts.ActualState = options.State{}
+	if res == nil || res.PublicAccessBlockConfiguration == nil {
+		return
+	}
+
+	cfg := res.PublicAccessBlockConfiguration
+	opts.ActualState.BlockPublicAcls = aws.ToBool(cfg.BlockPublicAcls)
+	opts.ActualState.IgnorePublicAcls = aws.ToBool(cfg.IgnorePublicAcls)
+	opts.ActualState.BlockPublicPolicy = aws.ToBool(cfg.BlockPublicPolicy)
+	opts.ActualState.RestrictPublicBuckets = aws.ToBool(cfg.RestrictPublicBuckets)
+}
+
+// ToConfiguration converts the State into the public access block configuration of AWS SDK.
+func ToConfiguration(state options.State) *types.PublicAccessBlockConfiguration {
+	return &types.PublicAccessBlockConfiguration{
+		BlockPublicAcls:       aws.Bool(state.BlockPublicAcls),
+		IgnorePublicAcls:      aws.Bool(state.IgnorePublicAcls),
+		BlockPublicPolicy:     aws.Bool(state.BlockPublicPolicy),
+		RestrictPublicBuckets: aws.Bool(state.RestrictPublicBuckets),
+	}
+}
+
+// FormatState returns the human-readable representation of a public access block configuration.
+func FormatState(state options.State) string {
+	return fmt.Sprintf("blockPublicAcls=%t, ignorePublicAcls=%t, blockPublicPolicy=%t, restrictPublicBuckets=%t",
+		state.BlockPublicAcls, state.IgnorePublicAcls, state.BlockPublicPolicy, state.RestrictPublicBuckets)
+}
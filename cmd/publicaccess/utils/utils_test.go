@@ -0,0 +1,74 @@
+//go:build unit
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/publicaccess/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecideActualState(t *testing.T) {
+	cases := []struct {
+		caseName string
+		res      *s3.GetPublicAccessBlockOutput
+		expected options.State
+	}{
+		{
+			"Success all enabled",
+			&s3.GetPublicAccessBlockOutput{
+				PublicAccessBlockConfiguration: &types.PublicAccessBlockConfiguration{
+					BlockPublicAcls:       aws.Bool(true),
+					IgnorePublicAcls:      aws.Bool(true),
+					BlockPublicPolicy:     aws.Bool(true),
+					RestrictPublicBuckets: aws.Bool(true),
+				},
+			},
+			options.State{BlockPublicAcls: true, IgnorePublicAcls: true, BlockPublicPolicy: true, RestrictPublicBuckets: true},
+		},
+		{
+			"Success partially enabled",
+			&s3.GetPublicAccessBlockOutput{
+				PublicAccessBlockConfiguration: &types.PublicAccessBlockConfiguration{
+					BlockPublicPolicy: aws.Bool(true),
+				},
+			},
+			options.State{BlockPublicPolicy: true},
+		},
+		{
+			"Success not configured",
+			&s3.GetPublicAccessBlockOutput{},
+			options.State{},
+		},
+		{
+			"Success nil output",
+			nil,
+			options.State{},
+		},
+	}
+
+	opts := options.GetPublicAccessOptions()
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		DecideActualState(tc.res, opts)
+		assert.Equal(t, tc.expected, opts.ActualState)
+	}
+}
+
+func TestToConfiguration(t *testing.T) {
+	cfg := ToConfiguration(options.State{BlockPublicAcls: true, RestrictPublicBuckets: true})
+	assert.True(t, *cfg.BlockPublicAcls)
+	assert.False(t, *cfg.IgnorePublicAcls)
+	assert.False(t, *cfg.BlockPublicPolicy)
+	assert.True(t, *cfg.RestrictPublicBuckets)
+}
+
+func TestFormatState(t *testing.T) {
+	assert.Equal(t, "blockPublicAcls=true, ignorePublicAcls=false, blockPublicPolicy=false, restrictPublicBuckets=false",
+		FormatState(options.State{BlockPublicAcls: true}))
+}
@@ -0,0 +1,86 @@
+package apply
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/replication/options"
+	replicationutils "github.com/bilalcaliskan/s3-manager/cmd/replication/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	replicationOpts = options.GetReplicationOptions()
+}
+
+var (
+	svc             internalawstypes.S3ClientAPI
+	logger          zerolog.Logger
+	confirmRunner   prompt.PromptRunner
+	replicationOpts *options.ReplicationOptions
+	ApplyCmd        = &cobra.Command{
+		Use:           "apply",
+		Short:         "replaces the replication configuration of the target bucket with the one in a JSON or YAML file",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# replace the replication configuration of target bucket with the one in a JSON file
+s3-manager replication apply replication.json
+
+# replace the replication configuration of target bucket with the one in a YAML file
+s3-manager replication apply replication.yaml
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			replicationOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 1); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while checking arguments")
+				return err
+			}
+
+			logger = logger.With().Str("replicationFilePath", args[0]).Logger()
+
+			if replicationOpts.Configuration, err = replicationutils.ReadConfiguration(args[0]); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while reading replication configuration")
+				return err
+			}
+
+			versioning, err := aws.GetBucketVersioning(svc, rootOpts)
+			if err != nil {
+				logger.Error().Err(err).Msg("an error occurred while getting versioning configuration")
+				return err
+			}
+
+			if versioning.Status != types.BucketVersioningStatusEnabled {
+				err := fmt.Errorf(replicationutils.ErrVersioningNotEnabled, versioning.Status)
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			content, err := replicationOpts.Configuration.String()
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Msg(replicationutils.InfWillApply)
+			fmt.Println(content)
+
+			if _, err := aws.SetBucketReplication(svc, replicationOpts, confirmRunner, logger); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while applying replication configuration")
+				return err
+			}
+
+			logger.Info().Msg(replicationutils.InfSuccess)
+
+			return nil
+		},
+	}
+)
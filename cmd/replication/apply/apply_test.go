@@ -0,0 +1,180 @@
+//go:build e2e
+
+package apply
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func getBucketVersioningFunc(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
+	return &s3.GetBucketVersioningOutput{Status: types.BucketVersioningStatusEnabled}, nil
+}
+
+func TestExecuteApplyCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	ApplyCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName                 string
+		args                     []string
+		shouldPass               bool
+		getBucketVersioningFunc  func(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error)
+		putBucketReplicationFunc func(ctx context.Context, params *s3.PutBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.PutBucketReplicationOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success json",
+			[]string{"../../../testdata/replication.json"},
+			true,
+			getBucketVersioningFunc,
+			func(ctx context.Context, params *s3.PutBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.PutBucketReplicationOutput, error) {
+				return &s3.PutBucketReplicationOutput{}, nil
+			},
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success yaml with auto approve",
+			[]string{"../../../testdata/replication.yaml"},
+			true,
+			getBucketVersioningFunc,
+			func(ctx context.Context, params *s3.PutBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.PutBucketReplicationOutput, error) {
+				return &s3.PutBucketReplicationOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with dry run",
+			[]string{"../../../testdata/replication.json"},
+			true,
+			getBucketVersioningFunc,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by versioning not enabled",
+			[]string{"../../../testdata/replication.json"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
+				return &s3.GetBucketVersioningOutput{Status: types.BucketVersioningStatusSuspended}, nil
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by get versioning error",
+			[]string{"../../../testdata/replication.json"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by invalid configuration",
+			[]string{"../../../testdata/replication_invalid.json"},
+			false,
+			getBucketVersioningFunc,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by put error",
+			[]string{"../../../testdata/replication.json"},
+			false,
+			getBucketVersioningFunc,
+			func(ctx context.Context, params *s3.PutBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.PutBucketReplicationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated process",
+			[]string{"../../../testdata/replication.json"},
+			false,
+			getBucketVersioningFunc,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by target file not found",
+			[]string{"../../../testdata/replication.jsonnnn"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by no arguments provided error",
+			[]string{},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketVersioningAPI = tc.getBucketVersioningFunc
+		mockS3.PutBucketReplicationAPI = tc.putBucketReplicationFunc
+
+		ApplyCmd.SetContext(context.WithValue(ApplyCmd.Context(), options.S3ClientKey{}, mockS3))
+		ApplyCmd.SetContext(context.WithValue(ApplyCmd.Context(), options.OptsKey{}, rootOpts))
+		ApplyCmd.SetContext(context.WithValue(ApplyCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		ApplyCmd.SetArgs(tc.args)
+
+		err := ApplyCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		replicationOpts.SetZeroValues()
+	}
+}
@@ -0,0 +1,51 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/replication"
+	"github.com/spf13/cobra"
+)
+
+type ReplicationOptsKey struct{}
+
+var replicationOpts = &ReplicationOptions{
+	Depth:       1,
+	Concurrency: 10,
+}
+
+// ReplicationOptions contains frequent command line and application options.
+type ReplicationOptions struct {
+	// Configuration is the desired replication configuration of the target bucket
+	Configuration *replication.Configuration
+	// Prefix limits the objects whose replication status is reported
+	Prefix string
+	// Sample is the maximum number of objects whose replication status is reported, 0 means all the objects
+	Sample int
+	// Depth is the number of key components that the objects are grouped by
+	Depth int
+	// Concurrency is the number of HeadObject calls made in parallel
+	Concurrency int
+	*options.RootOptions
+}
+
+func (opts *ReplicationOptions) InitFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.Prefix, "prefix", "", "", "only report the objects under the given prefix")
+	cmd.Flags().IntVarP(&opts.Sample, "sample", "", 0, "maximum number of objects to report, "+
+		"0 scans all the objects")
+	cmd.Flags().IntVarP(&opts.Depth, "depth", "", 1, "number of key components that the objects are grouped by, "+
+		"e.g. 'foo/bar/baz.txt' is reported under 'foo/' with depth 1 and 'foo/bar/' with depth 2")
+	cmd.Flags().IntVarP(&opts.Concurrency, "concurrency", "", 10, "number of HeadObject calls made in parallel")
+}
+
+// GetReplicationOptions returns the pointer of ReplicationOptions
+func GetReplicationOptions() *ReplicationOptions {
+	return replicationOpts
+}
+
+func (opts *ReplicationOptions) SetZeroValues() {
+	opts.Configuration = nil
+	opts.Prefix = ""
+	opts.Sample = 0
+	opts.Depth = 1
+	opts.Concurrency = 10
+}
@@ -0,0 +1,45 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/replication"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetReplicationOptions(t *testing.T) {
+	opts := GetReplicationOptions()
+	assert.NotNil(t, opts)
+}
+
+func TestReplicationOptions_InitFlags(t *testing.T) {
+	cmd := cobra.Command{}
+	opts := GetReplicationOptions()
+	opts.InitFlags(&cmd)
+
+	assert.NotNil(t, cmd.Flags().Lookup("prefix"))
+	assert.NotNil(t, cmd.Flags().Lookup("sample"))
+	assert.NotNil(t, cmd.Flags().Lookup("depth"))
+	assert.NotNil(t, cmd.Flags().Lookup("concurrency"))
+}
+
+func TestReplicationOptions_SetZeroValues(t *testing.T) {
+	opts := GetReplicationOptions()
+	assert.NotNil(t, opts)
+
+	opts.Configuration = &replication.Configuration{}
+	opts.Prefix = "foo/"
+	opts.Sample = 10
+	opts.Depth = 3
+	opts.Concurrency = 1
+	opts.SetZeroValues()
+
+	assert.Nil(t, opts.Configuration)
+	assert.Empty(t, opts.Prefix)
+	assert.Equal(t, 0, opts.Sample)
+	assert.Equal(t, 1, opts.Depth)
+	assert.Equal(t, 10, opts.Concurrency)
+}
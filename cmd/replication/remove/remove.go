@@ -0,0 +1,63 @@
+package remove
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/replication/options"
+	replicationutils "github.com/bilalcaliskan/s3-manager/cmd/replication/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	replicationOpts = options.GetReplicationOptions()
+}
+
+var (
+	svc             internalawstypes.S3ClientAPI
+	logger          zerolog.Logger
+	confirmRunner   prompt.PromptRunner
+	replicationOpts *options.ReplicationOptions
+	RemoveCmd       = &cobra.Command{
+		Use:           "remove",
+		Short:         "removes the replication configuration of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# remove the replication configuration of target bucket
+s3-manager replication remove
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			replicationOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			cfg, err := aws.GetReplicationConfiguration(svc, replicationOpts)
+			if err != nil {
+				logger.Error().Err(err).Msg("an error occurred while getting replication configuration")
+				return err
+			}
+
+			if len(cfg.Rules) == 0 {
+				logger.Warn().Msg(replicationutils.InfNotConfigured)
+				return nil
+			}
+
+			if _, err := aws.DeleteBucketReplication(svc, replicationOpts, confirmRunner, logger); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while removing replication configuration")
+				return err
+			}
+
+			logger.Info().Msg(replicationutils.InfRemoved)
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,25 @@
+package replication
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/replication/apply"
+	"github.com/bilalcaliskan/s3-manager/cmd/replication/remove"
+	"github.com/bilalcaliskan/s3-manager/cmd/replication/show"
+	"github.com/bilalcaliskan/s3-manager/cmd/replication/status"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	ReplicationCmd.AddCommand(show.ShowCmd)
+	ReplicationCmd.AddCommand(apply.ApplyCmd)
+	ReplicationCmd.AddCommand(remove.RemoveCmd)
+	ReplicationCmd.AddCommand(status.StatusCmd)
+}
+
+var (
+	ReplicationCmd = &cobra.Command{
+		Use:           "replication",
+		Short:         "shows/sets the replication configuration of the target bucket and reports the replication status of its objects",
+		SilenceUsage:  false,
+		SilenceErrors: false,
+	}
+)
@@ -0,0 +1,13 @@
+//go:build unit
+
+package replication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplicationCmd(t *testing.T) {
+	assert.NotNil(t, ReplicationCmd)
+}
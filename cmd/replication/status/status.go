@@ -0,0 +1,84 @@
+package status
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/replication/options"
+	replicationutils "github.com/bilalcaliskan/s3-manager/cmd/replication/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/replication"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	replicationOpts = options.GetReplicationOptions()
+	replicationOpts.InitFlags(StatusCmd)
+}
+
+var (
+	svc             internalawstypes.S3ClientAPI
+	logger          zerolog.Logger
+	replicationOpts *options.ReplicationOptions
+	StatusCmd       = &cobra.Command{
+		Use:   "status",
+		Short: "summarises the replication status of the objects of the target bucket per prefix",
+		Long: `summarises the replication status of the objects of the target bucket per prefix by calling HeadObject for each
+object, objects without any replication status are reported as none`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# report the replication status of all the objects of target bucket grouped by their top level folder
+s3-manager replication status
+
+# report the replication status of the first 1000 objects under 'backups/' grouped by their second level folder
+s3-manager replication status --prefix backups/ --sample 1000 --depth 2
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			replicationOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if replicationOpts.Depth <= 0 || replicationOpts.Concurrency <= 0 || replicationOpts.Sample < 0 {
+				err := errors.New(replicationutils.ErrInvalidStatusFlags)
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			summary, errs := aws.GetReplicationSummary(svc, replicationOpts)
+			if len(errs) != 0 {
+				for _, err := range errs {
+					logger.Error().Msg(err.Error())
+				}
+
+				return errs[0]
+			}
+
+			for _, row := range summary.Rows() {
+				fmt.Println(replicationutils.FormatRow(row))
+			}
+
+			if failed := summary.Count(string(types.ReplicationStatusFailed)); failed > 0 {
+				logger.Warn().Msgf(replicationutils.WarnFailed, failed)
+			}
+
+			if pending := summary.Count(string(types.ReplicationStatusPending)); pending > 0 {
+				logger.Info().Int("pending", pending).Msg("some objects are still waiting to be replicated")
+			}
+
+			logger.Info().Int("withoutStatus", summary.Count(replication.StatusNone)).
+				Msg("finished reporting replication status")
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,124 @@
+//go:build e2e
+
+package status
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func listObjectsV2Func(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+	return &s3.ListObjectsV2Output{
+		Contents: []types.Object{
+			{Key: aws.String("backups/1.tar.gz")},
+			{Key: aws.String("backups/2.tar.gz")},
+			{Key: aws.String("logs/app.log")},
+			{Key: aws.String("README.md")},
+		},
+		IsTruncated: aws.Bool(false),
+	}, nil
+}
+
+func headObjectFunc(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+	switch *params.Key {
+	case "backups/1.tar.gz":
+		return &s3.HeadObjectOutput{ReplicationStatus: types.ReplicationStatusCompleted}, nil
+	case "backups/2.tar.gz":
+		return &s3.HeadObjectOutput{ReplicationStatus: types.ReplicationStatusFailed}, nil
+	case "logs/app.log":
+		return &s3.HeadObjectOutput{ReplicationStatus: types.ReplicationStatusPending}, nil
+	default:
+		return &s3.HeadObjectOutput{}, nil
+	}
+}
+
+func TestExecuteStatusCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	StatusCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName          string
+		args              []string
+		shouldPass        bool
+		listObjectsV2Func func(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error)
+		headObjectFunc    func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	}{
+		{
+			"Success",
+			[]string{},
+			true,
+			listObjectsV2Func,
+			headObjectFunc,
+		},
+		{
+			"Success with flags",
+			[]string{"--prefix", "backups/", "--sample", "2", "--depth", "2", "--concurrency", "1"},
+			true,
+			listObjectsV2Func,
+			headObjectFunc,
+		},
+		{
+			"Failure caused by head object error",
+			[]string{},
+			false,
+			listObjectsV2Func,
+			func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+		{
+			"Failure caused by list objects error",
+			[]string{},
+			false,
+			func(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+		},
+		{
+			"Failure caused by invalid flags",
+			[]string{"--depth", "0"},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"Failure caused by too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListObjectsV2API = tc.listObjectsV2Func
+		mockS3.HeadObjectAPI = tc.headObjectFunc
+
+		StatusCmd.SetContext(context.WithValue(StatusCmd.Context(), options.S3ClientKey{}, mockS3))
+		StatusCmd.SetContext(context.WithValue(StatusCmd.Context(), options.OptsKey{}, rootOpts))
+		StatusCmd.SetArgs(tc.args)
+
+		err := StatusCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		replicationOpts.SetZeroValues()
+	}
+}
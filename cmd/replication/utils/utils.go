@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/replication"
+	"github.com/pkg/errors"
+)
+
+const (
+	ErrVersioningNotEnabled = "versioning must be enabled on target bucket before configuring replication, current state is %q"
+	ErrInvalidStatusFlags   = "'--depth' and '--concurrency' flags must be positive and '--sample' flag can not be negative"
+
+	InfNotConfigured = "target bucket does not have any replication configuration"
+	InfSuccess       = "successfully applied replication configuration on target bucket"
+	InfRemoved       = "successfully removed replication configuration from target bucket"
+	InfWillApply     = "will attempt to apply below replication configuration"
+	WarnFailed       = "replication failed for %d objects"
+)
+
+// ReadConfiguration reads the JSON or YAML replication configuration file at the given path and parses it.
+func ReadConfiguration(path string) (*replication.Configuration, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "an error occurred while reading replication configuration file")
+	}
+
+	return replication.Parse(content)
+}
+
+// FormatRow returns the human-readable representation of the replication status counts of a prefix.
+func FormatRow(row replication.Row) string {
+	parts := []string{fmt.Sprintf("prefix=%s", row.Prefix)}
+	for _, status := range replication.Statuses {
+		parts = append(parts, fmt.Sprintf("%s=%d", strings.ToLower(status), row.Counts[status]))
+	}
+
+	return strings.Join(append(parts, fmt.Sprintf("total=%d", row.Total)), ", ")
+}
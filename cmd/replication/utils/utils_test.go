@@ -0,0 +1,40 @@
+//go:build unit
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/replication"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadConfiguration(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		path       string
+		shouldPass bool
+	}{
+		{"Success json", "../../../testdata/replication.json", true},
+		{"Success yaml", "../../../testdata/replication.yaml", true},
+		{"Failure file not found", "../../../testdata/replication.jsonnnn", false},
+		{"Failure invalid content", "../../../testdata/file1.txt", false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		cfg, err := ReadConfiguration(tc.path)
+		if tc.shouldPass {
+			assert.Nil(t, err)
+			assert.Len(t, cfg.Rules, 1)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestFormatRow(t *testing.T) {
+	row := replication.Row{Prefix: "foo/", Counts: map[string]int{"COMPLETED": 2, "FAILED": 1}, Total: 3}
+	assert.Equal(t, "prefix=foo/, completed=2, pending=0, failed=1, replica=0, none=0, total=3", FormatRow(row))
+}
@@ -0,0 +1,61 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/spf13/cobra"
+)
+
+type RestoreOptsKey struct{}
+
+var restoreOpts = &RestoreOptions{}
+
+// RestoreOptions contains frequent command line and application options.
+type RestoreOptions struct {
+	// Regex is the regex of the target objects, used instead of a single object key
+	Regex string
+	// Tier is the retrieval tier of the restore requests
+	Tier string
+	// Days is the number of days that the restored copies of the target objects are kept
+	Days int32
+	// Wait polls the restore status of the target objects until none of them is in progress
+	Wait bool
+	// IntervalSeconds is the interval between the polls in seconds
+	IntervalSeconds int
+	*options.RootOptions
+}
+
+// InitRequestFlags initializes the flags of the request command.
+func (opts *RestoreOptions) InitRequestFlags(cmd *cobra.Command) {
+	opts.initRegexFlag(cmd)
+	cmd.Flags().StringVarP(&opts.Tier, "tier", "", "Standard", "retrieval tier of the restore requests, "+
+		"valid values are Standard, Bulk and Expedited")
+	cmd.Flags().Int32VarP(&opts.Days, "days", "", 7, "number of days that the restored copies of the "+
+		"target objects are kept")
+}
+
+// InitStatusFlags initializes the flags of the status command.
+func (opts *RestoreOptions) InitStatusFlags(cmd *cobra.Command) {
+	opts.initRegexFlag(cmd)
+	cmd.Flags().BoolVarP(&opts.Wait, "wait", "", false, "poll the restore status of the target objects "+
+		"until none of them is in progress")
+	cmd.Flags().IntVarP(&opts.IntervalSeconds, "interval-seconds", "", 60, "interval between the polls "+
+		"in seconds, used with '--wait' flag")
+}
+
+func (opts *RestoreOptions) initRegexFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.Regex, "regex", "", "", "regex of the target objects, used instead of "+
+		"a single object key")
+}
+
+// GetRestoreOptions returns the pointer of RestoreOptions
+func GetRestoreOptions() *RestoreOptions {
+	return restoreOpts
+}
+
+func (opts *RestoreOptions) SetZeroValues() {
+	opts.Regex = ""
+	opts.Tier = "Standard"
+	opts.Days = 7
+	opts.Wait = false
+	opts.IntervalSeconds = 60
+}
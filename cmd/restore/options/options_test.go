@@ -0,0 +1,31 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRestoreOptions(t *testing.T) {
+	opts := GetRestoreOptions()
+	assert.NotNil(t, opts)
+}
+
+func TestRestoreOptions_SetZeroValues(t *testing.T) {
+	opts := GetRestoreOptions()
+	assert.NotNil(t, opts)
+
+	opts.Regex = "^logs/"
+	opts.Tier = "Bulk"
+	opts.Days = 30
+	opts.Wait = true
+	opts.IntervalSeconds = 5
+	opts.SetZeroValues()
+	assert.Empty(t, opts.Regex)
+	assert.Equal(t, "Standard", opts.Tier)
+	assert.Equal(t, int32(7), opts.Days)
+	assert.False(t, opts.Wait)
+	assert.Equal(t, 60, opts.IntervalSeconds)
+}
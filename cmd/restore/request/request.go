@@ -0,0 +1,124 @@
+package request
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/restore/options"
+	restoreutils "github.com/bilalcaliskan/s3-manager/cmd/restore/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/restore"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	restoreOpts = options.GetRestoreOptions()
+	restoreOpts.InitRequestFlags(RequestCmd)
+}
+
+var (
+	svc           internalawstypes.S3ClientAPI
+	logger        zerolog.Logger
+	confirmRunner prompt.PromptRunner
+	restoreOpts   *options.RestoreOptions
+	RequestCmd    = &cobra.Command{
+		Use:   "request",
+		Short: "requests the restore of one or many archived objects of the target bucket",
+		Long: `requests the restore of an object, or the objects that match '--regex', of the target bucket. The objects
+in GLACIER and DEEP_ARCHIVE storage classes and in the archive access tiers of INTELLIGENT_TIERING can not be read
+until they are restored. The restored copies are kept for '--days' days, requesting the restore of an already
+restored object extends the expiry of its copy. The objects that are not archived or whose restores are already in
+progress are skipped. Restores take from minutes to hours depending on '--tier', 'restore status' command can be
+used to follow them`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# request the restore of an object for 7 days with the Standard tier
+s3-manager restore request logs/2020/app.log
+
+# request the restore of all the objects under 'logs/2020/' for 30 days with the Bulk tier
+s3-manager restore request --regex "^logs/2020/" --tier Bulk --days 30
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			restoreOpts.RootOptions = rootOpts
+
+			// the target objects are either specified with a single key or with the '--regex' flag
+			allowed := 1
+			if restoreOpts.Regex != "" {
+				allowed = 0
+			}
+
+			if err := utils.CheckArgs(args, allowed); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			tier, err := restoreutils.ValidateRequest(restoreOpts)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			var keys []string
+			if restoreOpts.Regex != "" {
+				var objects []types.Object
+				if objects, err = aws.GetDesiredObjects(svc, restoreOpts.BucketName, restoreOpts.Regex); err != nil {
+					logger.Error().Msg(err.Error())
+					return err
+				}
+
+				keys = utils.GetKeysOnly(objects)
+			} else {
+				keys = []string{args[0]}
+			}
+
+			if len(keys) == 0 {
+				logger.Warn().Msg(restoreutils.WarnNoObjects)
+				return nil
+			}
+
+			statuses, err := aws.GetRestoreStatuses(svc, restoreOpts, keys)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			requests, skips := restore.Plan(statuses, tier)
+			for _, skip := range skips {
+				logger.Warn().Str("key", skip.Key).Msg(skip.Reason)
+			}
+
+			if len(requests) == 0 {
+				logger.Warn().Msg(restoreutils.WarnNothingToRestore)
+				return nil
+			}
+
+			logger.Info().Msgf(restoreutils.InfWillRestore, tier, restoreOpts.Days)
+			for _, request := range requests {
+				fmt.Println(request.String())
+			}
+
+			results, err := aws.RequestRestore(svc, restoreOpts, requests, confirmRunner, logger)
+			for _, result := range results {
+				fmt.Println(result.String())
+			}
+
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if !restoreOpts.DryRun {
+				logger.Info().Msgf(restoreutils.InfRestoreRequested, len(results))
+			}
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,231 @@
+//go:build e2e
+
+package request
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func listObjectsFunc(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+	return &s3.ListObjectsOutput{Contents: []types.Object{
+		{Key: aws.String("logs/a.log")},
+		{Key: aws.String("logs/b.log")},
+		{Key: aws.String("logs/c.log")},
+		{Key: aws.String("images/d.png")},
+	}}, nil
+}
+
+func headObjectFunc(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+	switch aws.ToString(params.Key) {
+	case "logs/a.log":
+		return &s3.HeadObjectOutput{StorageClass: types.StorageClassGlacier}, nil
+	case "logs/b.log":
+		return &s3.HeadObjectOutput{StorageClass: types.StorageClassDeepArchive}, nil
+	case "logs/c.log":
+		return &s3.HeadObjectOutput{StorageClass: types.StorageClassGlacier, Restore: aws.String(`ongoing-request="true"`)}, nil
+	default:
+		return &s3.HeadObjectOutput{}, nil
+	}
+}
+
+func TestExecuteRequestCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	RequestCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName          string
+		args              []string
+		shouldPass        bool
+		listObjectsFunc   func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error)
+		headObjectFunc    func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+		restoreObjectFunc func(ctx context.Context, params *s3.RestoreObjectInput, optFns ...func(*s3.Options)) (*s3.RestoreObjectOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success with single object",
+			[]string{"logs/a.log", "--days", "3"},
+			true,
+			nil,
+			headObjectFunc,
+			func(ctx context.Context, params *s3.RestoreObjectInput, optFns ...func(*s3.Options)) (*s3.RestoreObjectOutput, error) {
+				if aws.ToInt32(params.RestoreRequest.Days) != 3 ||
+					params.RestoreRequest.GlacierJobParameters.Tier != types.TierStandard {
+					return nil, constants.ErrInjected
+				}
+
+				return &s3.RestoreObjectOutput{}, nil
+			},
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success with regex",
+			[]string{"--regex", "^logs/", "--tier", "Expedited"},
+			true,
+			listObjectsFunc,
+			headObjectFunc,
+			func(ctx context.Context, params *s3.RestoreObjectInput, optFns ...func(*s3.Options)) (*s3.RestoreObjectOutput, error) {
+				if aws.ToString(params.Key) != "logs/a.log" {
+					return nil, constants.ErrInjected
+				}
+
+				return &s3.RestoreObjectOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with dry run",
+			[]string{"--regex", "^logs/", "--tier", "Bulk"},
+			true,
+			listObjectsFunc,
+			headObjectFunc,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Success when nothing can be restored",
+			[]string{"--regex", "^(images/|logs/c)"},
+			true,
+			listObjectsFunc,
+			headObjectFunc,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Success when no objects match",
+			[]string{"--regex", "^videos/"},
+			true,
+			listObjectsFunc,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by restore error",
+			[]string{"logs/a.log"},
+			false,
+			nil,
+			headObjectFunc,
+			func(ctx context.Context, params *s3.RestoreObjectInput, optFns ...func(*s3.Options)) (*s3.RestoreObjectOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated the process",
+			[]string{"logs/a.log"},
+			false,
+			nil,
+			headObjectFunc,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by head error",
+			[]string{"logs/a.log"},
+			false,
+			nil,
+			func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by list error",
+			[]string{"--regex", "^logs/"},
+			false,
+			func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by invalid tier",
+			[]string{"logs/a.log", "--tier", "Fast"},
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by both key and regex",
+			[]string{"logs/a.log", "--regex", "^logs/"},
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListObjectsAPI = tc.listObjectsFunc
+		mockS3.HeadObjectAPI = tc.headObjectFunc
+		mockS3.RestoreObjectAPI = tc.restoreObjectFunc
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		RequestCmd.SetContext(context.WithValue(RequestCmd.Context(), options.S3ClientKey{}, mockS3))
+		RequestCmd.SetContext(context.WithValue(RequestCmd.Context(), options.OptsKey{}, rootOpts))
+		RequestCmd.SetContext(context.WithValue(RequestCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		RequestCmd.SetArgs(tc.args)
+
+		err := RequestCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		restoreOpts.SetZeroValues()
+	}
+}
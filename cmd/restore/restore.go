@@ -0,0 +1,21 @@
+package restore
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/restore/request"
+	"github.com/bilalcaliskan/s3-manager/cmd/restore/status"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	RestoreCmd.AddCommand(request.RequestCmd)
+	RestoreCmd.AddCommand(status.StatusCmd)
+}
+
+var (
+	RestoreCmd = &cobra.Command{
+		Use:           "restore",
+		Short:         "requests/shows the restores of the archived objects of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: false,
+	}
+)
@@ -0,0 +1,13 @@
+//go:build unit
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestoreCmd(t *testing.T) {
+	assert.NotNil(t, RestoreCmd)
+}
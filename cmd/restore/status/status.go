@@ -0,0 +1,106 @@
+package status
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/restore/options"
+	restoreutils "github.com/bilalcaliskan/s3-manager/cmd/restore/utils"
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/restore"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	restoreOpts = options.GetRestoreOptions()
+	restoreOpts.InitStatusFlags(StatusCmd)
+}
+
+var (
+	svc         internalawstypes.S3ClientAPI
+	logger      zerolog.Logger
+	restoreOpts *options.RestoreOptions
+	StatusCmd   = &cobra.Command{
+		Use:   "status",
+		Short: "shows the restore status of one or many objects of the target bucket",
+		Long: `shows the restore status of an object, or the objects that match '--regex', of the target bucket from
+the restore headers of HeadObject. An object is either not archived, not restored, in progress or restored until the
+expiry of its restored copy. With '--wait' flag the status is polled every '--interval-seconds' seconds until none of
+the restores is in progress`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# show the restore status of an object
+s3-manager restore status logs/2020/app.log
+
+# wait for the restores of all the objects under 'logs/2020/' to finish, checking every 10 minutes
+s3-manager restore status --regex "^logs/2020/" --wait --interval-seconds 600
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			restoreOpts.RootOptions = rootOpts
+
+			// the target objects are either specified with a single key or with the '--regex' flag
+			allowed := 1
+			if restoreOpts.Regex != "" {
+				allowed = 0
+			}
+
+			if err := utils.CheckArgs(args, allowed); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if err := restoreutils.ValidateStatus(restoreOpts); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			var keys []string
+			if restoreOpts.Regex != "" {
+				var objects []types.Object
+				if objects, err = aws.GetDesiredObjects(svc, restoreOpts.BucketName, restoreOpts.Regex); err != nil {
+					logger.Error().Msg(err.Error())
+					return err
+				}
+
+				keys = utils.GetKeysOnly(objects)
+			} else {
+				keys = []string{args[0]}
+			}
+
+			if len(keys) == 0 {
+				logger.Warn().Msg(restoreutils.WarnNoObjects)
+				return nil
+			}
+
+			var statuses []restore.Status
+			for {
+				if statuses, err = aws.GetRestoreStatuses(svc, restoreOpts, keys); err != nil {
+					logger.Error().Msg(err.Error())
+					return err
+				}
+
+				inProgress := restore.InProgress(statuses)
+				if !restoreOpts.Wait || inProgress == 0 {
+					break
+				}
+
+				logger.Info().Msgf(restoreutils.InfWaiting, inProgress, restoreOpts.IntervalSeconds)
+				time.Sleep(time.Duration(restoreOpts.IntervalSeconds) * time.Second)
+			}
+
+			logger.Info().Msg(restoreutils.InfFetchedStatus)
+			for _, status := range statuses {
+				fmt.Println(status.String())
+			}
+
+			return nil
+		},
+	}
+)
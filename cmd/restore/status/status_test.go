@@ -0,0 +1,100 @@
+//go:build e2e
+
+package status
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func listObjectsFunc(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+	return &s3.ListObjectsOutput{Contents: []types.Object{
+		{Key: aws.String("logs/a.log")},
+		{Key: aws.String("logs/b.log")},
+	}}, nil
+}
+
+func headObjectFunc(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+	if aws.ToString(params.Key) == "logs/a.log" {
+		return &s3.HeadObjectOutput{
+			StorageClass: types.StorageClassGlacier,
+			Restore:      aws.String(`ongoing-request="false", expiry-date="Fri, 21 Dec 2012 00:00:00 GMT"`),
+		}, nil
+	}
+
+	return &s3.HeadObjectOutput{StorageClass: types.StorageClassDeepArchive}, nil
+}
+
+func TestExecuteStatusCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	StatusCmd.SetContext(ctx)
+
+	// the restore is reported in progress on the first poll and finished on the second one
+	var polls int
+	pollingHeadObjectFunc := func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+		polls++
+		if polls == 1 {
+			return &s3.HeadObjectOutput{StorageClass: types.StorageClassGlacier, Restore: aws.String(`ongoing-request="true"`)}, nil
+		}
+
+		return headObjectFunc(ctx, params, optFns...)
+	}
+
+	cases := []struct {
+		caseName        string
+		args            []string
+		shouldPass      bool
+		listObjectsFunc func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error)
+		headObjectFunc  func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	}{
+		{"Success with single object", []string{"logs/a.log"}, true, nil, headObjectFunc},
+		{"Success with regex", []string{"--regex", "^logs/"}, true, listObjectsFunc, headObjectFunc},
+		{"Success with wait", []string{"logs/a.log", "--wait", "--interval-seconds", "1"}, true, nil, pollingHeadObjectFunc},
+		{"Success when no objects match", []string{"--regex", "^videos/"}, true, listObjectsFunc, nil},
+		{"Failure caused by head error", []string{"logs/a.log"}, false, nil,
+			func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+		{"Failure caused by list error", []string{"--regex", "^logs/"}, false,
+			func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+				return nil, constants.ErrInjected
+			}, nil,
+		},
+		{"Failure caused by invalid interval", []string{"logs/a.log", "--wait", "--interval-seconds", "0"}, false, nil, nil},
+		{"Failure caused by missing key", []string{}, false, nil, nil},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListObjectsAPI = tc.listObjectsFunc
+		mockS3.HeadObjectAPI = tc.headObjectFunc
+
+		StatusCmd.SetContext(context.WithValue(StatusCmd.Context(), options.S3ClientKey{}, mockS3))
+		StatusCmd.SetContext(context.WithValue(StatusCmd.Context(), options.OptsKey{}, rootOpts))
+		StatusCmd.SetArgs(tc.args)
+
+		err := StatusCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		restoreOpts.SetZeroValues()
+	}
+
+	assert.Equal(t, 2, polls)
+}
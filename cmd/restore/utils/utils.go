@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/restore/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/restore"
+	"github.com/pkg/errors"
+)
+
+const (
+	ErrInvalidTier        = "no such retrieval tier called %q, valid tiers are %v"
+	ErrInvalidDays        = "'--days' flag must be greater than 0"
+	ErrInvalidInterval    = "'--interval-seconds' flag must be greater than 0"
+	ErrObjectsNotRestored = "restore of %d objects could not be requested"
+
+	WarnNoObjects        = "no objects found matching the given regex, skipping operation"
+	WarnNothingToRestore = "none of the matching objects can be restored, skipping operation"
+
+	InfWillRestore      = "will attempt to request restore of below objects with %s tier for %d days"
+	InfRestoreRequested = "requested restore of %d objects, 'restore status' command can be used to follow them"
+	InfFetchedStatus    = "fetched restore status of below objects"
+	InfWaiting          = "restore of %d objects is still in progress, checking again in %d seconds"
+)
+
+// ValidateRequest validates the flags of the request command of RestoreOptions and returns the retrieval tier.
+func ValidateRequest(opts *options.RestoreOptions) (types.Tier, error) {
+	tier, ok := restore.ParseTier(opts.Tier)
+	if !ok {
+		return tier, fmt.Errorf(ErrInvalidTier, opts.Tier, restore.Tiers())
+	}
+
+	if opts.Days < 1 {
+		return tier, errors.New(ErrInvalidDays)
+	}
+
+	_, err := regexp.Compile(opts.Regex)
+
+	return tier, err
+}
+
+// ValidateStatus validates the flags of the status command of RestoreOptions.
+func ValidateStatus(opts *options.RestoreOptions) error {
+	if opts.Wait && opts.IntervalSeconds < 1 {
+		return errors.New(ErrInvalidInterval)
+	}
+
+	_, err := regexp.Compile(opts.Regex)
+
+	return err
+}
@@ -0,0 +1,46 @@
+//go:build unit
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/restore/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRequest(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		opts       *options.RestoreOptions
+		shouldPass bool
+		tier       types.Tier
+	}{
+		{"Success", &options.RestoreOptions{Tier: "Standard", Days: 7}, true, types.TierStandard},
+		{"Success with lowercase tier", &options.RestoreOptions{Tier: "bulk", Days: 1, Regex: "^logs/"}, true, types.TierBulk},
+		{"Failure caused by invalid tier", &options.RestoreOptions{Tier: "Fast", Days: 7}, false, ""},
+		{"Failure caused by invalid days", &options.RestoreOptions{Tier: "Standard"}, false, types.TierStandard},
+		{"Failure caused by invalid regex", &options.RestoreOptions{Tier: "Standard", Days: 7, Regex: "("}, false, types.TierStandard},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		tier, err := ValidateRequest(tc.opts)
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		assert.Equal(t, tc.tier, tier)
+	}
+}
+
+func TestValidateStatus(t *testing.T) {
+	assert.Nil(t, ValidateStatus(&options.RestoreOptions{}))
+	assert.Nil(t, ValidateStatus(&options.RestoreOptions{Wait: true, IntervalSeconds: 10}))
+	assert.NotNil(t, ValidateStatus(&options.RestoreOptions{Wait: true}))
+	assert.NotNil(t, ValidateStatus(&options.RestoreOptions{Regex: "("}))
+}
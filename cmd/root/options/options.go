@@ -7,6 +7,14 @@ import (
 	"github.com/spf13/viper"
 )
 
+// AnnotationBucketNameOptional is the annotation of the commands that do not operate on a single target bucket,
+// the '--bucket-name' flag is not required for them
+const AnnotationBucketNameOptional = "bucketNameOptional"
+
+// AnnotationStdoutData is the annotation of the commands that print data to be piped into other commands, the
+// banner and the logs are written to the standard error for them so that the standard output only has the data
+const AnnotationStdoutData = "stdoutData"
+
 var rootOptions = &RootOptions{}
 
 type (
@@ -14,6 +22,8 @@ type (
 	LoggerKey        struct{}
 	S3ClientKey      struct{}
 	ConfirmRunnerKey struct{}
+	// BucketNameRunnerKey holds the prompt that guards the destructive bucket operations by asking for the bucket name
+	BucketNameRunnerKey struct{}
 )
 
 // RootOptions contains frequent command line and application options.
@@ -67,7 +77,7 @@ func (opts *RootOptions) SetAccessFlagsRequired(cmd *cobra.Command) {
 		_ = cmd.MarkPersistentFlagRequired("secret-key")
 	}
 
-	if opts.BucketName == "" {
+	if opts.BucketName == "" && cmd.Annotations[AnnotationBucketNameOptional] != "true" {
 		_ = cmd.MarkPersistentFlagRequired("bucket-name")
 	}
 
@@ -40,6 +40,17 @@ func TestRootOptions_SetAccessFlagsRequired(t *testing.T) {
 	opts.SetAccessFlagsRequired(cmd)
 }
 
+func TestRootOptions_SetAccessFlagsRequired_BucketNameOptional(t *testing.T) {
+	cmd := &cobra.Command{Annotations: map[string]string{AnnotationBucketNameOptional: "true"}}
+	opts := GetRootOptions()
+	opts.SetZeroValues()
+	opts.InitFlags(cmd)
+
+	opts.SetAccessFlagsRequired(cmd)
+	assert.Empty(t, cmd.PersistentFlags().Lookup("bucket-name").Annotations[cobra.BashCompOneRequiredFlag])
+	assert.NotEmpty(t, cmd.PersistentFlags().Lookup("region").Annotations[cobra.BashCompOneRequiredFlag])
+}
+
 func TestRootOptions_SetAccessCredentialsFromEnv_Filled(t *testing.T) {
 	opts := GetRootOptions()
 
@@ -2,6 +2,7 @@ package root
 
 import (
 	"context"
+	"fmt"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/logging"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
@@ -11,9 +12,28 @@ import (
 
 	"github.com/bilalcaliskan/s3-manager/cmd/transferacceleration"
 
+	"github.com/bilalcaliskan/s3-manager/cmd/accesslogging"
+	"github.com/bilalcaliskan/s3-manager/cmd/acl"
+	"github.com/bilalcaliskan/s3-manager/cmd/apply"
+	"github.com/bilalcaliskan/s3-manager/cmd/bucket"
 	"github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy"
+	"github.com/bilalcaliskan/s3-manager/cmd/buckets"
+	"github.com/bilalcaliskan/s3-manager/cmd/cors"
+	"github.com/bilalcaliskan/s3-manager/cmd/drift"
+	"github.com/bilalcaliskan/s3-manager/cmd/encryption"
+	"github.com/bilalcaliskan/s3-manager/cmd/export"
+	"github.com/bilalcaliskan/s3-manager/cmd/inventory"
+	"github.com/bilalcaliskan/s3-manager/cmd/notifications"
+	"github.com/bilalcaliskan/s3-manager/cmd/object"
+	"github.com/bilalcaliskan/s3-manager/cmd/objectlock"
+	"github.com/bilalcaliskan/s3-manager/cmd/presign"
+	"github.com/bilalcaliskan/s3-manager/cmd/publicaccess"
+	"github.com/bilalcaliskan/s3-manager/cmd/replication"
+	"github.com/bilalcaliskan/s3-manager/cmd/restore"
+	"github.com/bilalcaliskan/s3-manager/cmd/website"
 
 	"github.com/bilalcaliskan/s3-manager/cmd/tags"
+	"github.com/bilalcaliskan/s3-manager/cmd/transition"
 
 	"github.com/bilalcaliskan/s3-manager/cmd/versioning"
 	"github.com/dimiro1/banner"
@@ -42,6 +62,25 @@ func init() {
 	rootCmd.AddCommand(bucketpolicy.BucketPolicyCmd)
 	rootCmd.AddCommand(transferacceleration.TransferAccelerationCmd)
 	rootCmd.AddCommand(list.ListCmd)
+	rootCmd.AddCommand(encryption.EncryptionCmd)
+	rootCmd.AddCommand(publicaccess.PublicAccessCmd)
+	rootCmd.AddCommand(acl.AclCmd)
+	rootCmd.AddCommand(cors.CorsCmd)
+	rootCmd.AddCommand(website.WebsiteCmd)
+	rootCmd.AddCommand(replication.ReplicationCmd)
+	rootCmd.AddCommand(objectlock.ObjectLockCmd)
+	rootCmd.AddCommand(accesslogging.AccessLoggingCmd)
+	rootCmd.AddCommand(inventory.InventoryCmd)
+	rootCmd.AddCommand(notifications.NotificationsCmd)
+	rootCmd.AddCommand(bucket.BucketCmd)
+	rootCmd.AddCommand(buckets.BucketsCmd)
+	rootCmd.AddCommand(apply.ApplyCmd)
+	rootCmd.AddCommand(export.ExportCmd)
+	rootCmd.AddCommand(drift.DriftCmd)
+	rootCmd.AddCommand(object.ObjectCmd)
+	rootCmd.AddCommand(transition.TransitionCmd)
+	rootCmd.AddCommand(restore.RestoreCmd)
+	rootCmd.AddCommand(presign.PresignCmd)
 }
 
 var (
@@ -66,9 +105,15 @@ var (
 				return err
 			}
 
+			out := os.Stdout
+			if cmd.Annotations[options.AnnotationStdoutData] == "true" {
+				out = os.Stderr
+				logging.SetOutput(out)
+			}
+
 			if _, err := os.Stat(opts.BannerFilePath); err == nil {
 				bannerBytes, _ := os.ReadFile(opts.BannerFilePath)
-				banner.Init(os.Stdout, true, false, strings.NewReader(string(bannerBytes)))
+				banner.Init(out, true, false, strings.NewReader(string(bannerBytes)))
 			}
 
 			if opts.VerboseLog {
@@ -84,6 +129,8 @@ var (
 			cmd.SetContext(context.WithValue(cmd.Context(), options.OptsKey{}, opts))
 			cmd.SetContext(context.WithValue(cmd.Context(), options.S3ClientKey{}, client))
 			cmd.SetContext(context.WithValue(cmd.Context(), options.ConfirmRunnerKey{}, prompt.GetConfirmRunner()))
+			cmd.SetContext(context.WithValue(cmd.Context(), options.BucketNameRunnerKey{},
+				prompt.GetExactMatchRunner(fmt.Sprintf("Type the bucket name %q to confirm", opts.BucketName), opts.BucketName)))
 
 			return nil
 		},
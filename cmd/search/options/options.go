@@ -15,6 +15,8 @@ type SearchOptions struct {
 	Text string
 	// FileName is the regex or exact name of the target file to search for specific Text
 	FileName string
+	// SkipArchived skips the archived objects that are not restored instead of failing the search
+	SkipArchived bool
 
 	*options.RootOptions
 }
@@ -23,12 +25,15 @@ func (opts *SearchOptions) InitFlags(cmd *cobra.Command) {
 	if cmd.Name() == "text" {
 		cmd.Flags().StringVarP(&opts.FileName, "file-name", "", "", "file-name is the regex "+
 			"or exact name of the target file to search for specific text")
+		cmd.Flags().BoolVarP(&opts.SkipArchived, "skip-archived", "", false, "skip the archived objects "+
+			"that are not restored instead of failing the search")
 	}
 }
 
 func (opts *SearchOptions) SetZeroValues() {
 	opts.Text = ""
 	opts.FileName = ""
+	opts.SkipArchived = false
 }
 
 // GetSearchOptions returns the pointer of FindOptions
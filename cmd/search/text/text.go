@@ -29,6 +29,9 @@ var (
 		SilenceErrors: true,
 		Example: `# search a text on target bucket by specifying regex for files
 s3-manager search text "catch me if you can" --file-name=".*.txt"
+
+# search a text on target bucket by skipping the archived objects that are not restored
+s3-manager search text "catch me if you can" --file-name=".*.txt" --skip-archived
 		`,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			var rootOpts *rootopts.RootOptions
@@ -49,10 +52,14 @@ s3-manager search text "catch me if you can" --file-name=".*.txt"
 				Str("fileName", searchOpts.FileName).
 				Msg("trying to search files on target bucket")
 
-			matchedFiles, errs := aws.SearchString(svc, searchOpts)
+			matchedFiles, skips, errs := aws.SearchString(svc, searchOpts)
+			for _, skip := range skips {
+				logger.Warn().Str("key", skip.Key).Msg(skip.Reason)
+			}
+
 			if len(errs) != 0 {
 				err := fmt.Errorf("multiple errors occurred while searching files, try to target individual files %s", errs)
-				logger.Error().Str("error", err.Error())
+				logger.Error().Msg(err.Error())
 				return err
 			}
 
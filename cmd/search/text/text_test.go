@@ -21,6 +21,23 @@ import (
 
 var mu sync.Mutex
 
+func archivedListObjectsFunc(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+	return &s3.ListObjectsOutput{
+		Contents: []types.Object{
+			{Key: aws.String("../../../testdata/file1.txt"), StorageClass: types.ObjectStorageClassStandard},
+			{Key: aws.String("../../../testdata/file2.txt"), StorageClass: types.ObjectStorageClassDeepArchive},
+		},
+	}, nil
+}
+
+func archivedGetObjectFunc(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	if *params.Key == "../../../testdata/file2.txt" {
+		return nil, &types.InvalidObjectState{StorageClass: types.StorageClassDeepArchive}
+	}
+
+	return &s3.GetObjectOutput{Body: getMockBody(*params.Key)}, nil
+}
+
 func TestExecuteTextCmd(t *testing.T) {
 	rootOpts := options.GetMockedRootOptions()
 	ctx := context.Background()
@@ -92,6 +109,20 @@ func TestExecuteTextCmd(t *testing.T) {
 				return &s3.GetObjectOutput{}, nil
 			},
 		},
+		{
+			"Success with skipping archived files",
+			[]string{"jPIrSIgOcZ", "--file-name=.*.txt", "--skip-archived"},
+			true,
+			archivedListObjectsFunc,
+			archivedGetObjectFunc,
+		},
+		{
+			"Failure caused by archived files",
+			[]string{"jPIrSIgOcZ", "--file-name=.*.txt"},
+			false,
+			archivedListObjectsFunc,
+			archivedGetObjectFunc,
+		},
 		{
 			"Failure caused by no arguments",
 			[]string{"--file-name=text2.txt"},
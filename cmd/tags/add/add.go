@@ -1,13 +1,13 @@
 package add
 
 import (
-	"errors"
 	"fmt"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
 	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/diff"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/tagging"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
-	"strings"
 
 	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
 	"github.com/bilalcaliskan/s3-manager/cmd/tags/options"
@@ -31,6 +31,9 @@ var (
 		SilenceErrors: true,
 		Example: `# add comma separated tagging configuration into bucket
 s3-manager tags add foo1=bar1,foo2=bar2
+
+# add a tag whose value contains '=', the pairs are split at the first '=' unless it is escaped with '\'
+s3-manager tags add "query=a=b,foo2=bar2"
 		`,
 		PreRunE: func(cmd *cobra.Command, args []string) (err error) {
 			var rootOpts *rootopts.RootOptions
@@ -56,30 +59,39 @@ s3-manager tags add foo1=bar1,foo2=bar2
 			logger.Info().Msg("fetched current bucket tags successfully")
 
 			for _, v := range tags.TagSet {
+				tagOpts.ActualTags[*v.Key] = *v.Value
 				tagOpts.TagsToAdd[*v.Key] = *v.Value
 			}
 
-			tagArr := strings.Split(args[0], ",")
-			for _, v := range tagArr {
-				tag := strings.Split(v, "=")
-				if len(tag) != 2 {
-					err = errors.New("each key value pair for a tag should be separated with '='")
-					logger.Error().
-						Msg(err.Error())
-					return err
-				}
-
-				tagOpts.TagsToAdd[tag[0]] = tag[1]
+			tagsToAdd, err := tagging.ParsePairs(args[0])
+			if err != nil {
+				logger.Error().
+					Msg(err.Error())
+				return err
+			}
+
+			for key, value := range tagsToAdd {
+				tagOpts.TagsToAdd[key] = value
+			}
+
+			if err := tagging.Validate(tagOpts.TagsToAdd); err != nil {
+				logger.Error().
+					Msg(err.Error())
+				return err
 			}
 
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			logger.Info().Msg("will try to set tags as below")
-			for i, v := range tagOpts.TagsToAdd {
-				fmt.Printf("%s=%s\n", i, v)
+			changes := diff.Map(tagOpts.ActualTags, tagOpts.TagsToAdd)
+			if changes == "" {
+				logger.Warn().Msg("specified tags are already attached to the bucket, nothing to change")
+				return nil
 			}
 
+			logger.Info().Msg("will try to apply below changes on the bucket tags, + add, ~ change")
+			fmt.Println(changes)
+
 			if err := aws.SetBucketTags(svc, tagOpts, confirmRunner, logger); err != nil {
 				logger.Error().
 					Str("error", err.Error()).
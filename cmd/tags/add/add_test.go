@@ -140,6 +140,25 @@ func TestExecuteAddCmd(t *testing.T) {
 			false,
 			false,
 		},
+		{
+			"Success when already at desired state",
+			[]string{"hasan1=huseyin1"},
+			true,
+			func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+				return &s3.GetBucketTaggingOutput{
+					TagSet: []types.Tag{
+						{
+							Key:   aws.String("hasan1"),
+							Value: aws.String("huseyin1"),
+						},
+					},
+				}, nil
+			},
+			nil,
+			nil,
+			false,
+			false,
+		},
 		{
 			"Failure caused by GetBucketTags error",
 			[]string{"foo=bar,foo2=bar2"},
@@ -182,8 +201,21 @@ func TestExecuteAddCmd(t *testing.T) {
 			false,
 		},
 		{
-			"Failure caused by wrong provided arg",
+			"Success with equal sign in value",
 			[]string{"foo=bar=barX,foo2=bar2"},
+			true,
+			internalawstypes.DefaultGetBucketTaggingFunc,
+			internalawstypes.DefaultPutBucketTaggingFunc,
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by wrong provided arg",
+			[]string{"foo,foo2=bar2"},
 			false,
 			internalawstypes.DefaultGetBucketTaggingFunc,
 			internalawstypes.DefaultPutBucketTaggingFunc,
@@ -194,6 +226,16 @@ func TestExecuteAddCmd(t *testing.T) {
 			false,
 			false,
 		},
+		{
+			"Failure caused by invalid tags",
+			[]string{"aws:foo=bar"},
+			false,
+			internalawstypes.DefaultGetBucketTaggingFunc,
+			nil,
+			nil,
+			false,
+			true,
+		},
 		{
 			"Failure caused by SetBucketTags error",
 			[]string{"foo=bar,foo2=bar2"},
@@ -0,0 +1,191 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/tags/options"
+	tagsutils "github.com/bilalcaliskan/s3-manager/cmd/tags/utils"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/diff"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/tagging"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	tagOpts = options.GetTagOptions()
+	tagOpts.InitAuditFlags(AuditCmd)
+}
+
+var (
+	svc           internalawstypes.S3ClientAPI
+	logger        zerolog.Logger
+	confirmRunner prompt.PromptRunner
+	tagOpts       *options.TagOptions
+	AuditCmd      = &cobra.Command{
+		Use:   "audit",
+		Short: "audits the tags of one or many buckets against a required tags policy",
+		Long: `audits the tags of the target bucket, the buckets specified with '--buckets' or all the buckets in the
+account against a JSON or YAML policy of required tag keys with allowed value patterns, '--objects' flag also audits
+the tags of their objects. The violations are reported as a table or JSON, '--fix' flag adds the default values of
+the missing required tags to the buckets. The process exits with 0 when the buckets comply with the policy, 2 when
+there are violations left and 1 when an error occurs, including when some of the buckets or objects could not be
+audited, so that it can be used in CI pipelines`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Annotations:   map[string]string{rootopts.AnnotationBucketNameOptional: "true"},
+		Example: `# audit the target bucket against required-tags.yaml
+s3-manager tags audit --policy required-tags.yaml --bucket-name foo
+
+# audit all the buckets in the account and their objects under the logs/ prefix, report the violations as JSON
+s3-manager tags audit --policy required-tags.yaml --all-buckets --objects --prefix logs/ --format json
+
+# add the default values of the missing required tags to the specified buckets
+s3-manager tags audit --policy required-tags.yaml --buckets foo,bar --fix
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			tagOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if tagOpts.PolicyFile == "" {
+				err := errors.New(tagsutils.ErrNoPolicy)
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if tagOpts.Format != tagging.FormatTable && tagOpts.Format != tagging.FormatJSON {
+				err := errors.New(tagsutils.ErrInvalidFormat)
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if tagOpts.AllBuckets && len(tagOpts.Buckets) > 0 {
+				err := errors.New(tagsutils.ErrConflictingTarget)
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger = logger.With().Str("policyFilePath", tagOpts.PolicyFile).Logger()
+
+			policy, err := tagging.ReadPolicyFile(tagOpts.PolicyFile)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			buckets := tagOpts.Buckets
+			switch {
+			case tagOpts.AllBuckets:
+				if buckets, err = aws.ListBucketNames(svc); err != nil {
+					logger.Error().Msg(err.Error())
+					return err
+				}
+			case len(buckets) == 0 && tagOpts.BucketName != "":
+				buckets = []string{tagOpts.BucketName}
+			case len(buckets) == 0:
+				err := errors.New(tagsutils.ErrNoTarget)
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if len(buckets) == 0 {
+				logger.Warn().Msg(tagsutils.WarnNoBuckets)
+				return nil
+			}
+
+			results, errs := aws.AuditTags(svc, tagOpts, policy, buckets)
+			for _, err := range errs {
+				logger.Error().Msg(err.Error())
+			}
+
+			if len(results) == 0 {
+				return errors.New(tagsutils.ErrAuditFailed)
+			}
+
+			var violations []tagging.Violation
+			for _, result := range results {
+				violations = append(violations, result.Violations...)
+			}
+
+			// an empty JSON report is still printed for the consumers that parse it
+			if len(violations) > 0 || tagOpts.Format == tagging.FormatJSON {
+				report, err := tagging.MarshalViolations(violations, tagOpts.Format)
+				if err != nil {
+					logger.Error().Msg(err.Error())
+					return err
+				}
+
+				fmt.Println(string(report))
+			}
+
+			remaining := len(violations)
+			if tagOpts.Fix {
+				for _, result := range results {
+					fixed := policy.Fix(result.Tags)
+					changes := diff.Map(result.Tags, fixed)
+					if changes == "" {
+						continue
+					}
+
+					bucketLogger := logger.With().Str("bucket", result.Bucket).Logger()
+					if err := tagging.Validate(fixed); err != nil {
+						bucketLogger.Error().Msg(err.Error())
+						return err
+					}
+
+					bucketLogger.Info().Msg(tagsutils.InfWillFix)
+					fmt.Println(changes)
+
+					// the fix is applied through a copy of the options that targets the audited bucket
+					fixRootOpts := *tagOpts.RootOptions
+					fixRootOpts.BucketName = result.Bucket
+					fixOpts := *tagOpts
+					fixOpts.RootOptions = &fixRootOpts
+					fixOpts.TagsToAdd = fixed
+
+					if err := aws.SetBucketTags(svc, &fixOpts, confirmRunner, bucketLogger, aws.InRegion(result.Region)); err != nil {
+						bucketLogger.Error().
+							Str("error", err.Error()).
+							Msg("an error occurred while setting tags")
+						return err
+					}
+
+					if !tagOpts.DryRun {
+						// only the missing required tags are fixed, each of them is a new key
+						remaining -= len(fixed) - len(result.Tags)
+						bucketLogger.Info().Msg(tagsutils.InfFixed)
+					}
+				}
+			}
+
+			// an incomplete audit can not prove the compliance, so it fails even if there are no violations
+			if len(errs) > 0 {
+				err := fmt.Errorf(tagsutils.ErrPartialAudit, len(errs))
+				logger.Error().Msg(err.Error())
+				cmd.SilenceUsage = true
+				return err
+			}
+
+			if remaining == 0 {
+				logger.Info().Msg(tagsutils.InfCompliant)
+				return nil
+			}
+
+			// violations are the expected outcome of the command, not a misuse of it
+			cmd.SilenceUsage = true
+
+			return &utils.ExitCodeError{Code: tagsutils.ExitCodeViolated, Err: fmt.Errorf(tagsutils.ErrViolated, remaining)}
+		},
+	}
+)
@@ -0,0 +1,298 @@
+//go:build e2e
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	tagsutils "github.com/bilalcaliskan/s3-manager/cmd/tags/utils"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func getBucketLocationFunc(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+	return &s3.GetBucketLocationOutput{}, nil
+}
+
+func getBucketTaggingFunc(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+	if aws.ToString(params.Bucket) == "compliant" {
+		return &s3.GetBucketTaggingOutput{TagSet: []types.Tag{
+			{Key: aws.String("cost-center"), Value: aws.String("1234")},
+			{Key: aws.String("owner"), Value: aws.String("jane@example.com")},
+			{Key: aws.String("env"), Value: aws.String("prod")},
+		}}, nil
+	}
+
+	return &s3.GetBucketTaggingOutput{TagSet: []types.Tag{
+		{Key: aws.String("owner"), Value: aws.String("jane@example.com")},
+	}}, nil
+}
+
+func TestExecuteAuditCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	AuditCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName              string
+		args                  []string
+		shouldPass            bool
+		exitCode              int
+		listBucketsFunc       func(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error)
+		getBucketLocationFunc func(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error)
+		putBucketTaggingFunc  func(ctx context.Context, params *s3.PutBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success compliant target bucket",
+			[]string{"--policy", "../../../testdata/required-tags.yaml", "--buckets", "compliant"},
+			true,
+			0,
+			nil,
+			getBucketLocationFunc,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Success with fix",
+			[]string{"--policy", "../../../testdata/required-tags.yaml", "--all-buckets", "--fix"},
+			true,
+			0,
+			func(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+				return &s3.ListBucketsOutput{Buckets: []types.Bucket{{Name: aws.String("compliant")}, {Name: aws.String("untagged")}}}, nil
+			},
+			getBucketLocationFunc,
+			func(ctx context.Context, params *s3.PutBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error) {
+				if aws.ToString(params.Bucket) != "untagged" || len(params.Tagging.TagSet) != 3 {
+					return nil, constants.ErrInjected
+				}
+
+				return &s3.PutBucketTaggingOutput{}, nil
+			},
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success with no buckets in the account",
+			[]string{"--policy", "../../../testdata/required-tags.yaml", "--all-buckets"},
+			true,
+			0,
+			func(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+				return &s3.ListBucketsOutput{}, nil
+			},
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by violations",
+			[]string{"--policy", "../../../testdata/required-tags.yaml", "--format", "json"},
+			false,
+			tagsutils.ExitCodeViolated,
+			nil,
+			getBucketLocationFunc,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by violations left after dry run fix",
+			[]string{"--policy", "../../../testdata/required-tags.yaml", "--fix"},
+			false,
+			tagsutils.ExitCodeViolated,
+			nil,
+			getBucketLocationFunc,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by fix error",
+			[]string{"--policy", "../../../testdata/required-tags.yaml", "--fix"},
+			false,
+			1,
+			nil,
+			getBucketLocationFunc,
+			func(ctx context.Context, params *s3.PutBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated the process",
+			[]string{"--policy", "../../../testdata/required-tags.yaml", "--fix"},
+			false,
+			1,
+			nil,
+			getBucketLocationFunc,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by audit error",
+			[]string{"--policy", "../../../testdata/required-tags.yaml"},
+			false,
+			1,
+			nil,
+			func(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by partial audit error",
+			[]string{"--policy", "../../../testdata/required-tags.yaml", "--buckets", "failing,compliant"},
+			false,
+			1,
+			nil,
+			func(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+				if aws.ToString(params.Bucket) == "failing" {
+					return nil, constants.ErrInjected
+				}
+
+				return &s3.GetBucketLocationOutput{}, nil
+			},
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by list buckets error",
+			[]string{"--policy", "../../../testdata/required-tags.yaml", "--all-buckets"},
+			false,
+			1,
+			func(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by conflicting targets",
+			[]string{"--policy", "../../../testdata/required-tags.yaml", "--all-buckets", "--buckets", "foo"},
+			false,
+			1,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by invalid format",
+			[]string{"--policy", "../../../testdata/required-tags.yaml", "--format", "xml"},
+			false,
+			1,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by policy file not found",
+			[]string{"--policy", "../../../testdata/required-tags.yamlllll"},
+			false,
+			1,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by missing policy",
+			[]string{},
+			false,
+			1,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by too many arguments",
+			[]string{"foo", "--policy", "../../../testdata/required-tags.yaml"},
+			false,
+			1,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListBucketsAPI = tc.listBucketsFunc
+		mockS3.GetBucketLocationAPI = tc.getBucketLocationFunc
+		mockS3.GetBucketTaggingAPI = getBucketTaggingFunc
+		mockS3.PutBucketTaggingAPI = tc.putBucketTaggingFunc
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		AuditCmd.SetContext(context.WithValue(AuditCmd.Context(), options.S3ClientKey{}, mockS3))
+		AuditCmd.SetContext(context.WithValue(AuditCmd.Context(), options.OptsKey{}, rootOpts))
+		AuditCmd.SetContext(context.WithValue(AuditCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		AuditCmd.SetArgs(tc.args)
+
+		err := AuditCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+			assert.Equal(t, tc.exitCode, utils.ExitCode(err))
+
+			var exitCodeErr *utils.ExitCodeError
+			assert.Equal(t, tc.exitCode != 1, errors.As(err, &exitCodeErr))
+		}
+
+		tagOpts.SetZeroValues()
+	}
+}
@@ -2,11 +2,15 @@ package options
 
 import (
 	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/tagging"
+	"github.com/spf13/cobra"
 )
 
 type TagOptsKey struct{}
 
-var tagOpts = &TagOptions{}
+var tagOpts = &TagOptions{
+	Format: tagging.FormatTable,
+}
 
 // TagOptions contains frequent command line and application options.
 type TagOptions struct {
@@ -16,9 +20,54 @@ type TagOptions struct {
 	TagsToAdd map[string]string
 	// TagsToRemove is state
 	TagsToRemove map[string]string
+	// FromFile is the JSON or YAML file that contains the tags to replace the current tags with
+	FromFile string
+	// KeyRegex is the regular expression that the keys of the tags to remove should match
+	KeyRegex string
+	// PolicyFile is the JSON or YAML file that contains the tags that the buckets are required to have
+	PolicyFile string
+	// Buckets are the buckets to audit instead of the target bucket
+	Buckets []string
+	// AllBuckets audits all the buckets in the account instead of the target bucket
+	AllBuckets bool
+	// Objects also audits the tags of the objects of the buckets
+	Objects bool
+	// Prefix is the prefix of the objects to audit
+	Prefix string
+	// Format is the format of the audit report, valid values are "table" and "json"
+	Format string
+	// Fix adds the default values of the missing required tags to the buckets
+	Fix bool
 	*options.RootOptions
 }
 
+func (opts *TagOptions) InitSetFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.FromFile, "from-file", "", "", "JSON or YAML file that contains the tags "+
+		"either as a key value object or as the TagSet of 'aws s3api get-bucket-tagging'")
+}
+
+func (opts *TagOptions) InitRemoveFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.KeyRegex, "key-regex", "", "", "regular expression that the keys of the "+
+		"tags to remove should match, instead of the exact key=value pairs")
+}
+
+func (opts *TagOptions) InitAuditFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.PolicyFile, "policy", "", "", "JSON or YAML file that contains the "+
+		"required tags with their allowed value patterns and default values")
+	cmd.Flags().StringSliceVarP(&opts.Buckets, "buckets", "", []string{}, "comma separated buckets to "+
+		"audit instead of the target bucket")
+	cmd.Flags().BoolVarP(&opts.AllBuckets, "all-buckets", "", false, "audit all the buckets in the account "+
+		"instead of the target bucket")
+	cmd.Flags().BoolVarP(&opts.Objects, "objects", "", false, "also audit the tags of the objects of the "+
+		"buckets")
+	cmd.Flags().StringVarP(&opts.Prefix, "prefix", "", "", "prefix of the objects to audit, only used "+
+		"with '--objects' flag")
+	cmd.Flags().StringVarP(&opts.Format, "format", "", tagging.FormatTable, "format of the audit report, "+
+		"valid values are table and json")
+	cmd.Flags().BoolVarP(&opts.Fix, "fix", "", false, "add the default values of the missing required tags "+
+		"to the buckets, the objects are not fixed")
+}
+
 // GetTagOptions returns the pointer of TagOptions
 func GetTagOptions() *TagOptions {
 	return tagOpts
@@ -28,4 +77,13 @@ func (opts *TagOptions) SetZeroValues() {
 	opts.ActualTags = make(map[string]string)
 	opts.TagsToAdd = make(map[string]string)
 	opts.TagsToRemove = make(map[string]string)
+	opts.FromFile = ""
+	opts.KeyRegex = ""
+	opts.PolicyFile = ""
+	opts.Buckets = []string{}
+	opts.AllBuckets = false
+	opts.Objects = false
+	opts.Prefix = ""
+	opts.Format = tagging.FormatTable
+	opts.Fix = false
 }
@@ -17,5 +17,17 @@ func TestTagOptions_SetZeroValues(t *testing.T) {
 	opts := GetTagOptions()
 	assert.NotNil(t, opts)
 
+	opts.FromFile = "tags.json"
+	opts.KeyRegex = "^team$"
+	opts.Buckets = []string{"foo", "bar"}
+	opts.AllBuckets = true
+	opts.Format = "json"
+	opts.Fix = true
 	opts.SetZeroValues()
+	assert.Empty(t, opts.FromFile)
+	assert.Empty(t, opts.KeyRegex)
+	assert.Empty(t, opts.Buckets)
+	assert.False(t, opts.AllBuckets)
+	assert.Equal(t, "table", opts.Format)
+	assert.False(t, opts.Fix)
 }
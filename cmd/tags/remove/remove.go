@@ -1,13 +1,14 @@
 package remove
 
 import (
-	"errors"
 	"fmt"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
 	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/diff"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/tagging"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
-	"strings"
+	"regexp"
 
 	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
 	"github.com/bilalcaliskan/s3-manager/cmd/tags/options"
@@ -19,6 +20,7 @@ const outputStr string = "%s=%s\n"
 
 func init() {
 	tagOpts = options.GetTagOptions()
+	tagOpts.InitRemoveFlags(RemoveCmd)
 }
 
 var (
@@ -33,13 +35,22 @@ var (
 		SilenceErrors: true,
 		Example: `# remove comma separated tagging configuration from bucket
 s3-manager tags remove foo1=bar1,foo2=bar2
+
+# remove all the tags whose keys start with "tmp-" regardless of their values
+s3-manager tags remove --key-regex '^tmp-'
 		`,
 		PreRunE: func(cmd *cobra.Command, args []string) (err error) {
 			var rootOpts *rootopts.RootOptions
 			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
 			tagOpts.RootOptions = rootOpts
 
-			if err := utils.CheckArgs(args, 1); err != nil {
+			// the tags to remove are either specified as key=value pairs or matched by the '--key-regex' flag
+			allowed := 1
+			if tagOpts.KeyRegex != "" {
+				allowed = 0
+			}
+
+			if err := utils.CheckArgs(args, allowed); err != nil {
 				logger.Error().Msg(err.Error())
 				return err
 			}
@@ -61,18 +72,33 @@ s3-manager tags remove foo1=bar1,foo2=bar2
 				tagOpts.ActualTags[*v.Key] = *v.Value
 			}
 
-			tagArr := strings.Split(args[0], ",")
-			for _, v := range tagArr {
-				tag := strings.Split(v, "=")
-				if len(tag) != 2 {
-					err = errors.New("each key value pair for a tag should be separated with '='")
+			if tagOpts.KeyRegex != "" {
+				keyRegex, err := regexp.Compile(tagOpts.KeyRegex)
+				if err != nil {
 					logger.Error().
 						Msg(err.Error())
 					return err
 				}
 
-				if utils.HasKeyValuePair(tagOpts.ActualTags, tag[0], tag[1]) {
-					tagOpts.TagsToRemove[tag[0]] = tag[1]
+				for key, value := range tagOpts.ActualTags {
+					if keyRegex.MatchString(key) {
+						tagOpts.TagsToRemove[key] = value
+					}
+				}
+
+				return nil
+			}
+
+			tagsToRemove, err := tagging.ParsePairs(args[0])
+			if err != nil {
+				logger.Error().
+					Msg(err.Error())
+				return err
+			}
+
+			for key, value := range tagsToRemove {
+				if utils.HasKeyValuePair(tagOpts.ActualTags, key, value) {
+					tagOpts.TagsToRemove[key] = value
 				}
 			}
 
@@ -84,18 +110,16 @@ s3-manager tags remove foo1=bar1,foo2=bar2
 				return nil
 			}
 
-			logger.Info().Msg("here is the list of current tags")
+			current := make(map[string]string, len(tagOpts.ActualTags))
 			for i, v := range tagOpts.ActualTags {
-				fmt.Printf(outputStr, i, v)
-			}
-
-			logger.Info().Msg("will try to remove below tags")
-			for i, v := range tagOpts.TagsToRemove {
-				fmt.Printf(outputStr, i, v)
+				current[i] = v
 			}
 
 			utils.RemoveMapElements(tagOpts.ActualTags, tagOpts.TagsToRemove)
 
+			logger.Info().Msg("will try to apply below changes on the bucket tags, - remove")
+			fmt.Println(diff.Map(current, tagOpts.ActualTags))
+
 			if _, err := aws.DeleteAllBucketTags(svc, tagOpts, confirmRunner, logger); err != nil {
 				logger.Error().
 					Str("error", err.Error()).
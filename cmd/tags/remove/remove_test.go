@@ -142,9 +142,65 @@ func TestExecuteRemoveCmd(t *testing.T) {
 			false,
 			false,
 		},
+		{
+			"Success with key regex",
+			[]string{"--key-regex", "^foo[0-9]$"},
+			true,
+			func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+				return &s3.GetBucketTaggingOutput{
+					TagSet: []types.Tag{
+						{
+							Key:   aws.String("foo"),
+							Value: aws.String("bar"),
+						},
+						{
+							Key:   aws.String("foo2"),
+							Value: aws.String("bar2"),
+						},
+						{
+							Key:   aws.String("foo3"),
+							Value: aws.String("bar3"),
+						},
+					},
+				}, nil
+			},
+			func(ctx context.Context, params *s3.PutBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error) {
+				if len(params.Tagging.TagSet) != 1 || *params.Tagging.TagSet[0].Key != "foo" {
+					return nil, constants.ErrInjected
+				}
+
+				return &s3.PutBucketTaggingOutput{}, nil
+			},
+			internalawstypes.DefaultDeleteBucketTaggingFunc,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by invalid key regex",
+			[]string{"--key-regex", "^foo[0-9$"},
+			false,
+			internalawstypes.DefaultGetBucketTaggingFunc,
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by both key regex and arguments",
+			[]string{"foo=bar", "--key-regex", "^foo"},
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
 		{
 			"Failure caused by wrong argument provided",
-			[]string{"foo=bar=bar3,foo2=bar2"},
+			[]string{"foo,foo2=bar2"},
 			false,
 			internalawstypes.DefaultGetBucketTaggingFunc,
 			internalawstypes.DefaultPutBucketTaggingFunc,
@@ -0,0 +1,125 @@
+package set
+
+import (
+	"fmt"
+
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/tags/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/diff"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/tagging"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	tagOpts = options.GetTagOptions()
+	tagOpts.InitSetFlags(SetCmd)
+}
+
+var (
+	svc           internalawstypes.S3ClientAPI
+	logger        zerolog.Logger
+	confirmRunner prompt.PromptRunner
+	tagOpts       *options.TagOptions
+	SetCmd        = &cobra.Command{
+		Use:   "set",
+		Short: "replaces the tagging configuration of the target bucket entirely",
+		Long: `replaces the tagging configuration of the target bucket entirely with the specified tags, the current tags
+that are not specified are removed. The tags are either specified as comma separated key=value pairs or with a JSON
+or YAML file, they are validated against the tagging limits of S3 before they are set`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# replace the tags of the bucket with comma separated key=value pairs
+s3-manager tags set foo1=bar1,foo2=bar2
+
+# replace the tags of the bucket with the ones in a JSON or YAML file
+s3-manager tags set --from-file tags.json
+		`,
+		PreRunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			tagOpts.RootOptions = rootOpts
+
+			// the tags are either specified as key=value pairs or with the '--from-file' flag
+			allowed := 1
+			if tagOpts.FromFile != "" {
+				allowed = 0
+			}
+
+			if err := utils.CheckArgs(args, allowed); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if tagOpts.FromFile != "" {
+				logger = logger.With().Str("tagsFilePath", tagOpts.FromFile).Logger()
+				tagOpts.TagsToAdd, err = tagging.ReadFile(tagOpts.FromFile)
+			} else {
+				tagOpts.TagsToAdd, err = tagging.ParsePairs(args[0])
+			}
+
+			if err != nil {
+				logger.Error().
+					Msg(err.Error())
+				return err
+			}
+
+			if err := tagging.Validate(tagOpts.TagsToAdd); err != nil {
+				logger.Error().
+					Msg(err.Error())
+				return err
+			}
+
+			tags, err := aws.GetBucketTags(svc, tagOpts)
+			if err != nil {
+				logger.Error().
+					Str("error", err.Error()).
+					Msg("an error occurred while fetching current tags")
+				return err
+			}
+
+			logger.Info().Msg("fetched current bucket tags successfully")
+			tagOpts.ActualTags = tagging.FromTagSet(tags.TagSet)
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			changes := diff.Map(tagOpts.ActualTags, tagOpts.TagsToAdd)
+			if changes == "" {
+				logger.Warn().Msg("bucket tags are already at desired state, nothing to change")
+				return nil
+			}
+
+			logger.Info().Msg("will try to apply below changes on the bucket tags, + add, - remove, ~ change")
+			fmt.Println(changes)
+
+			if len(tagOpts.TagsToAdd) == 0 {
+				if _, err := aws.DeleteAllBucketTags(svc, tagOpts, confirmRunner, logger); err != nil {
+					logger.Error().
+						Str("error", err.Error()).
+						Msg("an error occurred while deleting all the tags")
+					return err
+				}
+
+				logger.Info().Msg("removed all the bucket tags successfully")
+
+				return nil
+			}
+
+			if err := aws.SetBucketTags(svc, tagOpts, confirmRunner, logger); err != nil {
+				logger.Error().
+					Str("error", err.Error()).
+					Msg("an error occurred while setting tags")
+				return err
+			}
+
+			logger.Info().Msg("set bucket tags successfully")
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,242 @@
+//go:build e2e
+
+package set
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func getBucketTaggingFunc(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+	return &s3.GetBucketTaggingOutput{
+		TagSet: []types.Tag{
+			{
+				Key:   aws.String("team"),
+				Value: aws.String("data"),
+			},
+			{
+				Key:   aws.String("foo"),
+				Value: aws.String("bar"),
+			},
+		},
+	}, nil
+}
+
+func TestExecuteSetCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	SetCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName                string
+		args                    []string
+		shouldPass              bool
+		getBucketTaggingFunc    func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error)
+		putBucketTaggingFunc    func(ctx context.Context, params *s3.PutBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error)
+		deleteBucketTaggingFunc func(ctx context.Context, params *s3.DeleteBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketTaggingOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			[]string{"team=ml,query=a=b"},
+			true,
+			getBucketTaggingFunc,
+			func(ctx context.Context, params *s3.PutBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error) {
+				if len(params.Tagging.TagSet) != 2 {
+					return nil, constants.ErrInjected
+				}
+
+				return &s3.PutBucketTaggingOutput{}, nil
+			},
+			nil,
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success with json file on bucket without tags",
+			[]string{"--from-file", "../../../testdata/tags.json"},
+			true,
+			func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "NoSuchTagSet"}
+			},
+			internalawstypes.DefaultPutBucketTaggingFunc,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with yaml file and dry run",
+			[]string{"--from-file", "../../../testdata/tags.yaml"},
+			true,
+			getBucketTaggingFunc,
+			nil,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Success when already at desired state",
+			[]string{"foo=bar,team=data"},
+			true,
+			getBucketTaggingFunc,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Success with empty file removes all tags",
+			[]string{"--from-file", "../../../testdata/tags_empty.json"},
+			true,
+			getBucketTaggingFunc,
+			nil,
+			internalawstypes.DefaultDeleteBucketTaggingFunc,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by delete error",
+			[]string{"--from-file", "../../../testdata/tags_empty.json"},
+			false,
+			getBucketTaggingFunc,
+			nil,
+			func(ctx context.Context, params *s3.DeleteBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketTaggingOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by put error",
+			[]string{"team=ml"},
+			false,
+			getBucketTaggingFunc,
+			func(ctx context.Context, params *s3.PutBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated the process",
+			[]string{"team=ml"},
+			false,
+			getBucketTaggingFunc,
+			nil,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by get error",
+			[]string{"team=ml"},
+			false,
+			func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by invalid tags",
+			[]string{"aws:team=ml"},
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by wrong argument provided",
+			[]string{"team"},
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by file not found",
+			[]string{"--from-file", "../../../testdata/tags.jsonnnn"},
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by both file and arguments",
+			[]string{"team=ml", "--from-file", "../../../testdata/tags.json"},
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketTaggingAPI = tc.getBucketTaggingFunc
+		mockS3.PutBucketTaggingAPI = tc.putBucketTaggingFunc
+		mockS3.DeleteBucketTaggingAPI = tc.deleteBucketTaggingFunc
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		SetCmd.SetContext(context.WithValue(SetCmd.Context(), options.S3ClientKey{}, mockS3))
+		SetCmd.SetContext(context.WithValue(SetCmd.Context(), options.OptsKey{}, rootOpts))
+		SetCmd.SetContext(context.WithValue(SetCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		SetCmd.SetArgs(tc.args)
+
+		err := SetCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		tagOpts.SetZeroValues()
+	}
+}
@@ -2,7 +2,9 @@ package tags
 
 import (
 	"github.com/bilalcaliskan/s3-manager/cmd/tags/add"
+	"github.com/bilalcaliskan/s3-manager/cmd/tags/audit"
 	"github.com/bilalcaliskan/s3-manager/cmd/tags/remove"
+	"github.com/bilalcaliskan/s3-manager/cmd/tags/set"
 	"github.com/bilalcaliskan/s3-manager/cmd/tags/show"
 	"github.com/spf13/cobra"
 )
@@ -11,6 +13,8 @@ func init() {
 	TagsCmd.AddCommand(show.ShowCmd)
 	TagsCmd.AddCommand(add.AddCmd)
 	TagsCmd.AddCommand(remove.RemoveCmd)
+	TagsCmd.AddCommand(set.SetCmd)
+	TagsCmd.AddCommand(audit.AuditCmd)
 }
 
 var (
@@ -0,0 +1,20 @@
+package utils
+
+const (
+	// ExitCodeViolated is the exit code of the process when the audited buckets violate the tag policy, the exit
+	// code is 0 when they comply with it and 1 when an error occurs
+	ExitCodeViolated = 2
+
+	ErrNoPolicy          = "'--policy' flag must be specified"
+	ErrInvalidFormat     = "'--format' flag must be table or json"
+	ErrConflictingTarget = "'--buckets' and '--all-buckets' flags can not be used together"
+	ErrNoTarget          = "either '--bucket-name', '--buckets' or '--all-buckets' flag must be specified"
+	ErrAuditFailed       = "none of the buckets could be audited"
+	ErrViolated          = "%d tag policy violations found"
+	ErrPartialAudit      = "%d errors occurred while auditing, the report is incomplete"
+
+	InfCompliant  = "all the audited buckets comply with the tag policy"
+	InfWillFix    = "will try to add the default values of the missing required tags, + add"
+	InfFixed      = "added the default values of the missing required tags successfully"
+	WarnNoBuckets = "there are no buckets to audit"
+)
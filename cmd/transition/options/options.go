@@ -0,0 +1,56 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/spf13/cobra"
+)
+
+type TransitionOptsKey struct{}
+
+var transitionOpts = &TransitionOptions{}
+
+// TransitionOptions contains frequent command line and application options.
+type TransitionOptions struct {
+	// To is the storage class that the target objects are moved to
+	To string
+	// Regex is the regex of the target objects, empty string means all the objects
+	Regex string
+	// Prefix is the key prefix of the target objects, empty string means all the objects
+	Prefix string
+	// OlderThanDays is the minimum age of the target objects in days, 0 means no age limit
+	OlderThanDays int
+	// MinSizeKb is the minimum size of the target objects in KB, 0 means no lower limit
+	MinSizeKb int64
+	// MaxSizeKb is the maximum size of the target objects in KB, 0 means no upper limit
+	MaxSizeKb int64
+	*options.RootOptions
+}
+
+func (opts *TransitionOptions) InitFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.To, "to", "", "", "storage class that the target objects are moved to, "+
+		"valid values are DEEP_ARCHIVE, GLACIER, GLACIER_IR, INTELLIGENT_TIERING, ONEZONE_IA, STANDARD and STANDARD_IA")
+	cmd.Flags().StringVarP(&opts.Regex, "regex", "", "", "regex of the target objects, empty string means "+
+		"all the objects")
+	cmd.Flags().StringVarP(&opts.Prefix, "prefix", "", "", "key prefix of the target objects, empty string "+
+		"means all the objects")
+	cmd.Flags().IntVarP(&opts.OlderThanDays, "older-than-days", "", 0, "minimum age in days of the target "+
+		"objects, 0 means no age limit")
+	cmd.Flags().Int64VarP(&opts.MinSizeKb, "min-size-kb", "", 0, "minimum size in kb of the target objects, "+
+		"0 means no lower limit")
+	cmd.Flags().Int64VarP(&opts.MaxSizeKb, "max-size-kb", "", 0, "maximum size in kb of the target objects, "+
+		"0 means no upper limit")
+}
+
+// GetTransitionOptions returns the pointer of TransitionOptions
+func GetTransitionOptions() *TransitionOptions {
+	return transitionOpts
+}
+
+func (opts *TransitionOptions) SetZeroValues() {
+	opts.To = ""
+	opts.Regex = ""
+	opts.Prefix = ""
+	opts.OlderThanDays = 0
+	opts.MinSizeKb = 0
+	opts.MaxSizeKb = 0
+}
@@ -0,0 +1,29 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTransitionOptions(t *testing.T) {
+	opts := GetTransitionOptions()
+	assert.NotNil(t, opts)
+}
+
+func TestTransitionOptions_SetZeroValues(t *testing.T) {
+	opts := GetTransitionOptions()
+	assert.NotNil(t, opts)
+
+	opts.To = "GLACIER_IR"
+	opts.Prefix = "logs/"
+	opts.OlderThanDays = 30
+	opts.MinSizeKb = 128
+	opts.SetZeroValues()
+	assert.Empty(t, opts.To)
+	assert.Empty(t, opts.Prefix)
+	assert.Zero(t, opts.OlderThanDays)
+	assert.Zero(t, opts.MinSizeKb)
+}
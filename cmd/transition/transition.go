@@ -0,0 +1,123 @@
+package transition
+
+import (
+	"fmt"
+	"time"
+
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/transition/options"
+	transitionutils "github.com/bilalcaliskan/s3-manager/cmd/transition/utils"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/storageclass"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	transitionOpts = options.GetTransitionOptions()
+	transitionOpts.InitFlags(TransitionCmd)
+}
+
+var (
+	svc            internalawstypes.S3ClientAPI
+	logger         zerolog.Logger
+	confirmRunner  prompt.PromptRunner
+	transitionOpts *options.TransitionOptions
+	TransitionCmd  = &cobra.Command{
+		Use:   "transition",
+		Short: "moves the objects of the target bucket that match the given criteria to another storage class",
+		Long: `moves the objects of the target bucket that match the given regex, prefix, age and size criteria to another
+storage class by copying them onto themselves. The estimated monthly storage cost delta is printed before anything
+is changed, together with the minimum billable size and minimum storage duration warnings, so '--dry-run' flag can
+be used to see the impact first. The archived objects must be restored before they can be transitioned and the
+objects larger than 5 GB are skipped. On versioned buckets the previous versions stay in their storage classes until
+they expire`,
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# see the cost impact of moving the logs older than 90 days to Glacier Instant Retrieval
+s3-manager transition --to GLACIER_IR --prefix logs/ --older-than-days 90 --dry-run
+
+# move the csv reports larger than 1 MB to Standard-IA
+s3-manager transition --to STANDARD_IA --regex "^reports/.*\.csv$" --min-size-kb 1024
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			transitionOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			target, err := transitionutils.ValidateFlags(transitionOpts)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger = logger.With().Str("storageClass", target.Name).Logger()
+
+			objects, err := aws.ListObjectsWithPrefix(svc, transitionOpts.BucketName, transitionOpts.Prefix, 0)
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			now := time.Now()
+			objects = transitionutils.FilterObjects(objects, transitionOpts, now)
+			if len(objects) == 0 {
+				logger.Warn().Msg(transitionutils.WarnNoObjects)
+				return nil
+			}
+
+			transitions, skips := storageclass.Plan(objects, target, now)
+			for _, skip := range skips {
+				logger.Warn().Str("key", skip.Key).Msg(skip.Reason)
+			}
+
+			if len(transitions) == 0 {
+				logger.Warn().Msg(transitionutils.WarnNothingToMove)
+				return nil
+			}
+
+			logger.Info().Msgf(transitionutils.InfWillTransition, target.Name)
+			for _, transition := range transitions {
+				fmt.Printf("%s: %s -> %s\n", transition.Key, transition.From, target.Name)
+				for _, warning := range transition.Warnings {
+					logger.Warn().Str("key", transition.Key).Msg(warning)
+				}
+			}
+
+			if target.MinDuration > 0 {
+				logger.Warn().Msgf(transitionutils.WarnTargetDuration, target.Name, target.MinDuration)
+			}
+
+			if target.Archived {
+				logger.Warn().Msgf(transitionutils.WarnTargetArchived, target.Name)
+			}
+
+			logger.Info().Msg(transitionutils.InfEstimate)
+			fmt.Println(storageclass.EstimateCost(transitions, target).String())
+
+			results, err := aws.TransitionObjects(svc, transitionOpts, transitions, confirmRunner, logger)
+			for _, result := range results {
+				fmt.Println(result.String())
+			}
+
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if !transitionOpts.DryRun {
+				logger.Info().Msgf(transitionutils.InfTransitionFinished, len(results), target.Name)
+			}
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,196 @@
+//go:build e2e
+
+package transition
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func listObjectsV2Func(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+	old := time.Now().AddDate(0, 0, -120)
+	return &s3.ListObjectsV2Output{Contents: []types.Object{
+		{Key: aws.String("logs/a.log"), Size: aws.Int64(1024 * 1024), LastModified: aws.Time(old)},
+		{Key: aws.String("logs/b.log"), Size: aws.Int64(1024), LastModified: aws.Time(old),
+			StorageClass: types.ObjectStorageClassStandardIa},
+		{Key: aws.String("logs/c.log"), Size: aws.Int64(1024 * 1024), LastModified: aws.Time(old),
+			StorageClass: types.ObjectStorageClassGlacier},
+		{Key: aws.String("logs/new.log"), Size: aws.Int64(1024 * 1024), LastModified: aws.Time(time.Now())},
+	}}, nil
+}
+
+func headObjectFunc(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+	return &s3.HeadObjectOutput{}, nil
+}
+
+func TestExecuteTransitionCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	TransitionCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName          string
+		args              []string
+		shouldPass        bool
+		listObjectsV2Func func(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error)
+		headObjectFunc    func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+		copyObjectFunc    func(ctx context.Context, params *s3.CopyObjectInput, optFns ...func(*s3.Options)) (*s3.CopyObjectOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			[]string{"--to", "GLACIER_IR", "--prefix", "logs/", "--older-than-days", "30"},
+			true,
+			listObjectsV2Func,
+			headObjectFunc,
+			func(ctx context.Context, params *s3.CopyObjectInput, optFns ...func(*s3.Options)) (*s3.CopyObjectOutput, error) {
+				if params.StorageClass != types.StorageClassGlacierIr || aws.ToString(params.Key) == "logs/new.log" ||
+					aws.ToString(params.Key) == "logs/c.log" {
+					return nil, constants.ErrInjected
+				}
+
+				return &s3.CopyObjectOutput{}, nil
+			},
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			[]string{"--to", "DEEP_ARCHIVE", "--regex", `\.log$`},
+			true,
+			listObjectsV2Func,
+			nil,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Success when no objects match",
+			[]string{"--to", "STANDARD_IA", "--min-size-kb", "4096"},
+			true,
+			listObjectsV2Func,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Success when nothing can be moved",
+			[]string{"--to", "STANDARD_IA", "--regex", `^logs/(b|c)\.log$`},
+			true,
+			listObjectsV2Func,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by copy error",
+			[]string{"--to", "ONEZONE_IA"},
+			false,
+			listObjectsV2Func,
+			headObjectFunc,
+			func(ctx context.Context, params *s3.CopyObjectInput, optFns ...func(*s3.Options)) (*s3.CopyObjectOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated the process",
+			[]string{"--to", "GLACIER_IR"},
+			false,
+			listObjectsV2Func,
+			nil,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by list error",
+			[]string{"--to", "GLACIER_IR"},
+			false,
+			func(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by invalid storage class",
+			[]string{"--to", "COLD"},
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"Failure caused by too many arguments",
+			[]string{"foo", "--to", "GLACIER_IR"},
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListObjectsV2API = tc.listObjectsV2Func
+		mockS3.HeadObjectAPI = tc.headObjectFunc
+		mockS3.CopyObjectAPI = tc.copyObjectFunc
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		TransitionCmd.SetContext(context.WithValue(TransitionCmd.Context(), options.S3ClientKey{}, mockS3))
+		TransitionCmd.SetContext(context.WithValue(TransitionCmd.Context(), options.OptsKey{}, rootOpts))
+		TransitionCmd.SetContext(context.WithValue(TransitionCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		TransitionCmd.SetArgs(tc.args)
+
+		err := TransitionCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		transitionOpts.SetZeroValues()
+	}
+}
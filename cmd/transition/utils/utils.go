@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/transition/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/storageclass"
+	"github.com/pkg/errors"
+)
+
+const (
+	ErrInvalidStorageClass = "no such storage class called %q, valid storage classes are %v"
+	ErrNegativeFilters     = "'--older-than-days', '--min-size-kb' and '--max-size-kb' flags can not be negative"
+	ErrInvalidSizeRange    = "'--min-size-kb' flag must be equal or lower than '--max-size-kb'"
+	ErrObjectsNotMoved     = "%d objects could not be transitioned"
+
+	WarnNoObjects      = "no objects found matching the given criteria, skipping operation"
+	WarnNothingToMove  = "none of the matching objects can be transitioned, skipping operation"
+	WarnTargetDuration = "objects in %s are billed for at least %d days, moving or deleting them earlier incurs an early deletion fee"
+	WarnTargetArchived = "objects in %s are archived, they must be restored before they can be read"
+
+	InfEstimate           = "estimated monthly storage cost of the transition, request and retrieval fees are not included"
+	InfWillTransition     = "will attempt to transition below objects to %s by copying them in place"
+	InfTransitionFinished = "transitioned %d objects to %s"
+)
+
+// ValidateFlags validates the flags of TransitionOptions and returns the target storage class.
+func ValidateFlags(opts *options.TransitionOptions) (storageclass.Class, error) {
+	class, ok := storageclass.Lookup(opts.To)
+	if !ok {
+		return class, fmt.Errorf(ErrInvalidStorageClass, opts.To, storageclass.Names())
+	}
+
+	if opts.OlderThanDays < 0 || opts.MinSizeKb < 0 || opts.MaxSizeKb < 0 {
+		return class, errors.New(ErrNegativeFilters)
+	}
+
+	if opts.MaxSizeKb != 0 && opts.MinSizeKb > opts.MaxSizeKb {
+		return class, errors.New(ErrInvalidSizeRange)
+	}
+
+	_, err := regexp.Compile(opts.Regex)
+
+	return class, err
+}
+
+// FilterObjects returns the objects that match the regex, age and size criteria of TransitionOptions, the prefix is
+// expected to be applied while listing the objects. The age of the objects are calculated from now.
+func FilterObjects(objects []types.Object, opts *options.TransitionOptions, now time.Time) (res []types.Object) {
+	pattern := regexp.MustCompile(opts.Regex)
+	threshold := now.AddDate(0, 0, -opts.OlderThanDays)
+	for _, object := range objects {
+		size := aws.ToInt64(object.Size)
+		switch {
+		case !pattern.MatchString(aws.ToString(object.Key)):
+		case opts.OlderThanDays > 0 && aws.ToTime(object.LastModified).After(threshold):
+		case opts.MinSizeKb > 0 && size < opts.MinSizeKb*1024:
+		case opts.MaxSizeKb > 0 && size > opts.MaxSizeKb*1024:
+		default:
+			res = append(res, object)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,56 @@
+//go:build unit
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/transition/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateFlags(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		opts       *options.TransitionOptions
+		shouldPass bool
+	}{
+		{"Success", &options.TransitionOptions{To: "GLACIER_IR", MinSizeKb: 128, MaxSizeKb: 1024}, true},
+		{"Success without upper limit", &options.TransitionOptions{To: "STANDARD_IA", MinSizeKb: 128}, true},
+		{"Failure caused by invalid storage class", &options.TransitionOptions{To: "glacier"}, false},
+		{"Failure caused by negative age", &options.TransitionOptions{To: "GLACIER", OlderThanDays: -1}, false},
+		{"Failure caused by invalid size range", &options.TransitionOptions{To: "GLACIER", MinSizeKb: 10, MaxSizeKb: 1}, false},
+		{"Failure caused by invalid regex", &options.TransitionOptions{To: "GLACIER", Regex: "("}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		class, err := ValidateFlags(tc.opts)
+		if tc.shouldPass {
+			assert.Nil(t, err)
+			assert.Equal(t, tc.opts.To, class.Name)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestFilterObjects(t *testing.T) {
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	objects := []types.Object{
+		{Key: aws.String("logs/old.log"), Size: aws.Int64(200 * 1024), LastModified: aws.Time(now.AddDate(0, 0, -60))},
+		{Key: aws.String("logs/new.log"), Size: aws.Int64(200 * 1024), LastModified: aws.Time(now.AddDate(0, 0, -1))},
+		{Key: aws.String("logs/small.log"), Size: aws.Int64(1024), LastModified: aws.Time(now.AddDate(0, 0, -60))},
+		{Key: aws.String("logs/large.log"), Size: aws.Int64(2048 * 1024), LastModified: aws.Time(now.AddDate(0, 0, -60))},
+		{Key: aws.String("images/old.png"), Size: aws.Int64(200 * 1024), LastModified: aws.Time(now.AddDate(0, 0, -60))},
+	}
+
+	res := FilterObjects(objects, &options.TransitionOptions{Regex: `\.log$`, OlderThanDays: 30, MinSizeKb: 128, MaxSizeKb: 1024}, now)
+	assert.Len(t, res, 1)
+	assert.Equal(t, "logs/old.log", aws.ToString(res[0].Key))
+	assert.Len(t, FilterObjects(objects, &options.TransitionOptions{}, now), len(objects))
+}
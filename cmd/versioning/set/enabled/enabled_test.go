@@ -146,7 +146,7 @@ func TestExecuteEnabledCmd(t *testing.T) {
 			false,
 			func(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
 				return &s3.GetBucketVersioningOutput{
-					Status: types.BucketVersioningStatusEnabled,
+					Status: types.BucketVersioningStatusSuspended,
 				}, nil
 			},
 			internalawstypes.DefaultPutBucketVersioningFunc,
@@ -163,7 +163,7 @@ func TestExecuteEnabledCmd(t *testing.T) {
 			false,
 			func(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
 				return &s3.GetBucketVersioningOutput{
-					Status: types.BucketVersioningStatusEnabled,
+					Status: types.BucketVersioningStatusSuspended,
 				}, nil
 			},
 			internalawstypes.DefaultPutBucketVersioningFunc,
@@ -0,0 +1,63 @@
+package disable
+
+import (
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/website/options"
+	websiteutils "github.com/bilalcaliskan/s3-manager/cmd/website/utils"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	websiteOpts = options.GetWebsiteOptions()
+}
+
+var (
+	svc           internalawstypes.S3ClientAPI
+	logger        zerolog.Logger
+	confirmRunner prompt.PromptRunner
+	websiteOpts   *options.WebsiteOptions
+	DisableCmd    = &cobra.Command{
+		Use:           "disable",
+		Short:         "disables static website hosting on the target bucket by removing its website configuration",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# disable website hosting on target bucket
+s3-manager website disable
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			websiteOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			cfg, err := aws.GetWebsiteConfiguration(svc, websiteOpts)
+			if err != nil {
+				logger.Error().Err(err).Msg("an error occurred while getting website configuration")
+				return err
+			}
+
+			if cfg.IsEmpty() {
+				logger.Warn().Msg(websiteutils.InfDisabled)
+				return nil
+			}
+
+			if _, err := aws.DeleteBucketWebsite(svc, websiteOpts, confirmRunner, logger); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while disabling website hosting")
+				return err
+			}
+
+			logger.Info().Msg(websiteutils.InfRemoved)
+
+			return nil
+		},
+	}
+)
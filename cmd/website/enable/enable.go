@@ -0,0 +1,86 @@
+package enable
+
+import (
+	"fmt"
+
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/website/options"
+	websiteutils "github.com/bilalcaliskan/s3-manager/cmd/website/utils"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	websiteOpts = options.GetWebsiteOptions()
+	websiteOpts.InitFlags(EnableCmd)
+}
+
+var (
+	svc           internalawstypes.S3ClientAPI
+	logger        zerolog.Logger
+	confirmRunner prompt.PromptRunner
+	websiteOpts   *options.WebsiteOptions
+	EnableCmd     = &cobra.Command{
+		Use:           "enable",
+		Short:         "enables static website hosting on the target bucket, replacing the existing website configuration",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# enable website hosting with index.html as index document
+s3-manager website enable
+
+# enable website hosting with custom index and error documents and routing rules
+s3-manager website enable --index-document home.html --error-document errors/404.html --routing-rules rules.yaml
+
+# redirect all requests made to the website endpoint of the target bucket to another host
+s3-manager website enable --redirect-all-to www.example.com --redirect-protocol https
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, confirmRunner = utils.PrepareConstants(cmd)
+			websiteOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			if websiteOpts.Configuration, err = websiteutils.BuildConfiguration(websiteOpts); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			content, err := websiteOpts.Configuration.String()
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Msg(websiteutils.InfWillApply)
+			fmt.Println(content)
+
+			if websiteOpts.Configuration.RedirectAllRequestsTo == nil {
+				warnings, err := aws.GetWebsiteWarnings(svc, websiteOpts)
+				if err != nil {
+					logger.Warn().Err(err).Msg("an error occurred while checking if website can be served")
+				}
+
+				for _, warning := range warnings {
+					logger.Warn().Msg(warning)
+				}
+			}
+
+			if _, err := aws.SetBucketWebsite(svc, websiteOpts, confirmRunner, logger); err != nil {
+				logger.Error().Err(err).Msg("an error occurred while enabling website hosting")
+				return err
+			}
+
+			logger.Info().Msg(websiteutils.InfEnabled)
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,200 @@
+//go:build e2e
+
+package enable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+var publicReadPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::thevpnbeast-releases-1/*"
+    }
+  ]
+}`
+
+func getBucketPolicyFunc(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+	return &s3.GetBucketPolicyOutput{Policy: aws.String(publicReadPolicy)}, nil
+}
+
+func getPublicAccessBlockFunc(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "NoSuchPublicAccessBlockConfiguration"}
+}
+
+func TestExecuteEnableCmd(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+
+	ctx := context.Background()
+	EnableCmd.SetContext(ctx)
+
+	cases := []struct {
+		caseName             string
+		args                 []string
+		shouldPass           bool
+		getBucketPolicyFunc  func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error)
+		putBucketWebsiteFunc func(ctx context.Context, params *s3.PutBucketWebsiteInput, optFns ...func(*s3.Options)) (*s3.PutBucketWebsiteOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			[]string{},
+			true,
+			getBucketPolicyFunc,
+			func(ctx context.Context, params *s3.PutBucketWebsiteInput, optFns ...func(*s3.Options)) (*s3.PutBucketWebsiteOutput, error) {
+				return &s3.PutBucketWebsiteOutput{}, nil
+			},
+			prompt.PromptMock{
+				Msg: "y",
+				Err: nil,
+			},
+			false,
+			false,
+		},
+		{
+			"Success with routing rules",
+			[]string{"--error-document", "error.html", "--routing-rules", "../../../testdata/routing_rules.yaml"},
+			true,
+			getBucketPolicyFunc,
+			func(ctx context.Context, params *s3.PutBucketWebsiteInput, optFns ...func(*s3.Options)) (*s3.PutBucketWebsiteOutput, error) {
+				return &s3.PutBucketWebsiteOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with redirect all",
+			[]string{"--redirect-all-to", "example.com", "--redirect-protocol", "https"},
+			true,
+			nil,
+			func(ctx context.Context, params *s3.PutBucketWebsiteInput, optFns ...func(*s3.Options)) (*s3.PutBucketWebsiteOutput, error) {
+				return &s3.PutBucketWebsiteOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success when consistency check fails",
+			[]string{},
+			true,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			func(ctx context.Context, params *s3.PutBucketWebsiteInput, optFns ...func(*s3.Options)) (*s3.PutBucketWebsiteOutput, error) {
+				return &s3.PutBucketWebsiteOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with dry run",
+			[]string{},
+			true,
+			getBucketPolicyFunc,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by invalid flags",
+			[]string{"--redirect-protocol", "https"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by invalid configuration",
+			[]string{"--redirect-all-to", "example.com", "--redirect-protocol", "ftp"},
+			false,
+			nil,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by put error",
+			[]string{},
+			false,
+			getBucketPolicyFunc,
+			func(ctx context.Context, params *s3.PutBucketWebsiteInput, optFns ...func(*s3.Options)) (*s3.PutBucketWebsiteOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated process",
+			[]string{},
+			false,
+			getBucketPolicyFunc,
+			nil,
+			prompt.PromptMock{
+				Msg: "n",
+				Err: constants.ErrInjected,
+			},
+			false,
+			false,
+		},
+		{
+			"Failure caused by too many arguments",
+			[]string{"enabled", "foo"},
+			false,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketPolicyAPI = tc.getBucketPolicyFunc
+		mockS3.GetPublicAccessBlockAPI = getPublicAccessBlockFunc
+		mockS3.PutBucketWebsiteAPI = tc.putBucketWebsiteFunc
+
+		EnableCmd.SetContext(context.WithValue(EnableCmd.Context(), options.S3ClientKey{}, mockS3))
+		EnableCmd.SetContext(context.WithValue(EnableCmd.Context(), options.OptsKey{}, rootOpts))
+		EnableCmd.SetContext(context.WithValue(EnableCmd.Context(), options.ConfirmRunnerKey{}, tc.PromptRunner))
+		EnableCmd.SetArgs(tc.args)
+
+		err := EnableCmd.Execute()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		websiteOpts.SetZeroValues()
+	}
+}
@@ -0,0 +1,55 @@
+package options
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/website"
+	"github.com/spf13/cobra"
+)
+
+type WebsiteOptsKey struct{}
+
+var websiteOpts = &WebsiteOptions{}
+
+// WebsiteOptions contains frequent command line and application options.
+type WebsiteOptions struct {
+	// IndexDocument is the suffix of the document that is returned for the requests made to a folder
+	IndexDocument string
+	// ErrorDocument is the key of the document that is returned when a 4XX class error occurs
+	ErrorDocument string
+	// RedirectAllTo is the host name that all the requests are redirected to
+	RedirectAllTo string
+	// RedirectProtocol is the protocol that is used while redirecting all the requests
+	RedirectProtocol string
+	// RoutingRulesFile is the path of the JSON or YAML file that contains the routing rules
+	RoutingRulesFile string
+	// Configuration is the desired website configuration of the target bucket
+	Configuration *website.Configuration
+	*options.RootOptions
+}
+
+func (opts *WebsiteOptions) InitFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.IndexDocument, "index-document", "", "index.html",
+		"suffix of the document that is returned for the requests made to the root or to any subfolder")
+	cmd.Flags().StringVarP(&opts.ErrorDocument, "error-document", "", "",
+		"key of the document that is returned when a 4XX class error occurs")
+	cmd.Flags().StringVarP(&opts.RedirectAllTo, "redirect-all-to", "", "",
+		"host name that all the requests are redirected to, can not be combined with the other flags")
+	cmd.Flags().StringVarP(&opts.RedirectProtocol, "redirect-protocol", "", "",
+		"protocol to use while redirecting all the requests, valid values are http and https")
+	cmd.Flags().StringVarP(&opts.RoutingRulesFile, "routing-rules", "", "",
+		"path of the JSON or YAML file that contains the routing rules")
+}
+
+// GetWebsiteOptions returns the pointer of WebsiteOptions
+func GetWebsiteOptions() *WebsiteOptions {
+	return websiteOpts
+}
+
+func (opts *WebsiteOptions) SetZeroValues() {
+	opts.IndexDocument = "index.html"
+	opts.ErrorDocument = ""
+	opts.RedirectAllTo = ""
+	opts.RedirectProtocol = ""
+	opts.RoutingRulesFile = ""
+	opts.Configuration = nil
+}
@@ -0,0 +1,42 @@
+//go:build unit
+
+package options
+
+import (
+	"testing"
+
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/website"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWebsiteOptions(t *testing.T) {
+	opts := GetWebsiteOptions()
+	assert.NotNil(t, opts)
+}
+
+func TestWebsiteOptions_InitFlags(t *testing.T) {
+	cmd := cobra.Command{}
+	opts := GetWebsiteOptions()
+	opts.InitFlags(&cmd)
+
+	assert.NotNil(t, cmd.Flags().Lookup("index-document"))
+	assert.NotNil(t, cmd.Flags().Lookup("error-document"))
+	assert.NotNil(t, cmd.Flags().Lookup("redirect-all-to"))
+	assert.NotNil(t, cmd.Flags().Lookup("redirect-protocol"))
+	assert.NotNil(t, cmd.Flags().Lookup("routing-rules"))
+}
+
+func TestWebsiteOptions_SetZeroValues(t *testing.T) {
+	opts := GetWebsiteOptions()
+	assert.NotNil(t, opts)
+
+	opts.IndexDocument = "home.html"
+	opts.RedirectAllTo = "example.com"
+	opts.Configuration = &website.Configuration{}
+	opts.SetZeroValues()
+
+	assert.Equal(t, "index.html", opts.IndexDocument)
+	assert.Empty(t, opts.RedirectAllTo)
+	assert.Nil(t, opts.Configuration)
+}
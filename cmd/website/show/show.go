@@ -0,0 +1,81 @@
+package show
+
+import (
+	"fmt"
+
+	rootopts "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/website/options"
+	websiteutils "github.com/bilalcaliskan/s3-manager/cmd/website/utils"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/aws"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	websiteOpts = options.GetWebsiteOptions()
+}
+
+var (
+	svc         internalawstypes.S3ClientAPI
+	logger      zerolog.Logger
+	websiteOpts *options.WebsiteOptions
+	ShowCmd     = &cobra.Command{
+		Use:           "show",
+		Short:         "shows the static website hosting configuration of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: true,
+		Example: `# show the current website configuration for target bucket
+s3-manager website show
+		`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var rootOpts *rootopts.RootOptions
+			svc, rootOpts, logger, _ = utils.PrepareConstants(cmd)
+			websiteOpts.RootOptions = rootOpts
+
+			if err := utils.CheckArgs(args, 0); err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			cfg, err := aws.GetWebsiteConfiguration(svc, websiteOpts)
+			if err != nil {
+				logger.Error().
+					Str("error", err.Error()).
+					Msg("an error occurred while getting website configuration")
+				return err
+			}
+
+			if cfg.IsEmpty() {
+				logger.Info().Msg(websiteutils.InfDisabled)
+				return nil
+			}
+
+			content, err := cfg.String()
+			if err != nil {
+				logger.Error().Msg(err.Error())
+				return err
+			}
+
+			logger.Info().Msg("fetched website configuration successfully")
+			fmt.Println(content)
+
+			if cfg.RedirectAllRequestsTo != nil {
+				return nil
+			}
+
+			warnings, err := aws.GetWebsiteWarnings(svc, websiteOpts)
+			if err != nil {
+				logger.Warn().Err(err).Msg("an error occurred while checking if website can be served")
+				return nil
+			}
+
+			for _, warning := range warnings {
+				logger.Warn().Msg(warning)
+			}
+
+			return nil
+		},
+	}
+)
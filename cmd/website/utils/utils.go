@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"os"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/website/options"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/website"
+	"github.com/pkg/errors"
+)
+
+const (
+	InfDisabled  = "website hosting is disabled on target bucket"
+	InfEnabled   = "successfully enabled website hosting on target bucket"
+	InfRemoved   = "successfully disabled website hosting on target bucket"
+	InfWillApply = "will attempt to apply below website configuration"
+)
+
+// BuildConfiguration builds the desired website configuration from the flags of WebsiteOptions. When all the
+// requests are redirected to another host, the index document is not used at all.
+func BuildConfiguration(opts *options.WebsiteOptions) (*website.Configuration, error) {
+	if opts.RedirectAllTo != "" {
+		if opts.ErrorDocument != "" || opts.RoutingRulesFile != "" {
+			return nil, errors.New("'--redirect-all-to' flag can not be combined with '--error-document' or '--routing-rules' flags")
+		}
+
+		return &website.Configuration{
+			RedirectAllRequestsTo: &website.RedirectAllRequestsTo{
+				HostName: opts.RedirectAllTo,
+				Protocol: opts.RedirectProtocol,
+			},
+		}, nil
+	}
+
+	if opts.RedirectProtocol != "" {
+		return nil, errors.New("'--redirect-protocol' flag can only be used with '--redirect-all-to' flag")
+	}
+
+	cfg := &website.Configuration{
+		IndexDocument: &website.IndexDocument{Suffix: opts.IndexDocument},
+	}
+
+	if opts.ErrorDocument != "" {
+		cfg.ErrorDocument = &website.ErrorDocument{Key: opts.ErrorDocument}
+	}
+
+	if opts.RoutingRulesFile != "" {
+		content, err := os.ReadFile(opts.RoutingRulesFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "an error occurred while reading routing rules file")
+		}
+
+		if cfg.RoutingRules, err = website.ParseRoutingRules(content); err != nil {
+			return nil, err
+		}
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,38 @@
+//go:build unit
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/bilalcaliskan/s3-manager/cmd/website/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildConfiguration(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		opts       *options.WebsiteOptions
+		shouldPass bool
+	}{
+		{"Success index document", &options.WebsiteOptions{IndexDocument: "index.html", ErrorDocument: "error.html"}, true},
+		{"Success routing rules", &options.WebsiteOptions{IndexDocument: "index.html", RoutingRulesFile: "../../../testdata/routing_rules.yaml"}, true},
+		{"Success redirect all", &options.WebsiteOptions{IndexDocument: "index.html", RedirectAllTo: "example.com", RedirectProtocol: "https"}, true},
+		{"Failure redirect all combined", &options.WebsiteOptions{RedirectAllTo: "example.com", ErrorDocument: "error.html"}, false},
+		{"Failure redirect protocol without redirect all", &options.WebsiteOptions{IndexDocument: "index.html", RedirectProtocol: "https"}, false},
+		{"Failure routing rules file not found", &options.WebsiteOptions{IndexDocument: "index.html", RoutingRulesFile: "../../../testdata/routing_rules.yamlll"}, false},
+		{"Failure invalid routing rules file", &options.WebsiteOptions{IndexDocument: "index.html", RoutingRulesFile: "../../../testdata/file1.txt"}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		cfg, err := BuildConfiguration(tc.opts)
+		if tc.shouldPass {
+			assert.Nil(t, err)
+			assert.NotNil(t, cfg)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package website
+
+import (
+	"github.com/bilalcaliskan/s3-manager/cmd/website/disable"
+	"github.com/bilalcaliskan/s3-manager/cmd/website/enable"
+	"github.com/bilalcaliskan/s3-manager/cmd/website/show"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	WebsiteCmd.AddCommand(show.ShowCmd)
+	WebsiteCmd.AddCommand(enable.EnableCmd)
+	WebsiteCmd.AddCommand(disable.DisableCmd)
+}
+
+var (
+	WebsiteCmd = &cobra.Command{
+		Use:           "website",
+		Short:         "shows/sets the static website hosting configuration of the target bucket",
+		SilenceUsage:  false,
+		SilenceErrors: false,
+	}
+)
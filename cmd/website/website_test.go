@@ -0,0 +1,13 @@
+//go:build unit
+
+package website
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebsiteCmd(t *testing.T) {
+	assert.NotNil(t, WebsiteCmd)
+}
@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	accessloggingoptions "github.com/bilalcaliskan/s3-manager/cmd/accesslogging/options"
+	accessloggingutils "github.com/bilalcaliskan/s3-manager/cmd/accesslogging/utils"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// GetBucketLogging retrieves the server access logging configuration of an S3 bucket.
+//
+// It accepts an S3API interface and AccessLoggingOptions as arguments, and returns the LoggingEnabled and any error
+// encountered. A nil LoggingEnabled means the server access logging is disabled.
+func GetBucketLogging(svc internalawstypes.S3ClientAPI, opts *accessloggingoptions.AccessLoggingOptions) (*types.LoggingEnabled, error) {
+	res, err := svc.GetBucketLogging(context.Background(), &s3.GetBucketLoggingInput{
+		Bucket: aws.String(opts.BucketName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.LoggingEnabled, nil
+}
+
+// SetBucketLogging enables the server access logging of an S3 bucket, delivering the logs to the 'TargetBucket'
+// under the 'TargetPrefix' of AccessLoggingOptions.
+//
+// It accepts an S3API interface, AccessLoggingOptions, a PromptRunner, and a Logger as arguments.
+// The target bucket is validated before anything else, then the 'DryRun' and 'AutoApprove' options are handled
+// just like SetBucketPolicy before putting the configuration.
+func SetBucketLogging(svc internalawstypes.S3ClientAPI, opts *accessloggingoptions.AccessLoggingOptions, runner prompt.PromptRunner, logger zerolog.Logger) (res *s3.PutBucketLoggingOutput, err error) {
+	if opts.TargetBucket == "" {
+		return res, errors.New(accessloggingutils.ErrMissingTargetBucket)
+	}
+
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return res, nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return res, err
+		}
+	}
+
+	return svc.PutBucketLogging(context.Background(), &s3.PutBucketLoggingInput{
+		Bucket: aws.String(opts.BucketName),
+		BucketLoggingStatus: &types.BucketLoggingStatus{
+			LoggingEnabled: accessloggingutils.ToLoggingEnabled(opts),
+		},
+	})
+}
+
+// DisableBucketLogging disables the server access logging of an S3 bucket by putting an empty logging status.
+//
+// It accepts an S3API interface, AccessLoggingOptions, a PromptRunner, and a Logger as arguments, and follows the
+// same 'DryRun' and 'AutoApprove' semantics with SetBucketLogging.
+func DisableBucketLogging(svc internalawstypes.S3ClientAPI, opts *accessloggingoptions.AccessLoggingOptions, runner prompt.PromptRunner, logger zerolog.Logger) (res *s3.PutBucketLoggingOutput, err error) {
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return res, nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return res, err
+		}
+	}
+
+	return svc.PutBucketLogging(context.Background(), &s3.PutBucketLoggingInput{
+		Bucket:              aws.String(opts.BucketName),
+		BucketLoggingStatus: &types.BucketLoggingStatus{},
+	})
+}
@@ -0,0 +1,214 @@
+//go:build unit
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	accessloggingoptions "github.com/bilalcaliskan/s3-manager/cmd/accesslogging/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/logging"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBucketLogging(t *testing.T) {
+	cases := []struct {
+		caseName             string
+		expected             error
+		enabled              bool
+		getBucketLoggingFunc func(ctx context.Context, params *s3.GetBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error)
+	}{
+		{
+			"Success",
+			nil,
+			true,
+			func(ctx context.Context, params *s3.GetBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+				return &s3.GetBucketLoggingOutput{LoggingEnabled: &types.LoggingEnabled{TargetBucket: aws.String("thevpnbeast-logs")}}, nil
+			},
+		},
+		{
+			"Success when disabled",
+			nil,
+			false,
+			func(ctx context.Context, params *s3.GetBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+				return &s3.GetBucketLoggingOutput{}, nil
+			},
+		},
+		{
+			"Failure",
+			constants.ErrInjected,
+			false,
+			func(ctx context.Context, params *s3.GetBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketLoggingAPI = tc.getBucketLoggingFunc
+
+		res, err := GetBucketLogging(mockS3, &accessloggingoptions.AccessLoggingOptions{RootOptions: options.GetMockedRootOptions()})
+		assert.Equal(t, tc.expected, err)
+		assert.Equal(t, tc.enabled, res != nil)
+	}
+}
+
+func TestSetBucketLogging(t *testing.T) {
+	cases := []struct {
+		caseName             string
+		shouldPass           bool
+		targetBucket         string
+		putBucketLoggingFunc func(ctx context.Context, params *s3.PutBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			true,
+			"thevpnbeast-logs",
+			func(ctx context.Context, params *s3.PutBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error) {
+				return &s3.PutBucketLoggingOutput{}, nil
+			},
+			prompt.PromptMock{Msg: "y"},
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			true,
+			"thevpnbeast-logs",
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by missing target bucket",
+			false,
+			"",
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by put error",
+			false,
+			"thevpnbeast-logs",
+			func(ctx context.Context, params *s3.PutBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by prompt error",
+			false,
+			"thevpnbeast-logs",
+			nil,
+			prompt.PromptMock{Msg: "n", Err: constants.ErrInjected},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.PutBucketLoggingAPI = tc.putBucketLoggingFunc
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		_, err := SetBucketLogging(mockS3, &accessloggingoptions.AccessLoggingOptions{TargetBucket: tc.targetBucket, RootOptions: rootOpts}, tc.PromptRunner, logging.GetLogger(rootOpts))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestDisableBucketLogging(t *testing.T) {
+	cases := []struct {
+		caseName             string
+		shouldPass           bool
+		putBucketLoggingFunc func(ctx context.Context, params *s3.PutBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			true,
+			func(ctx context.Context, params *s3.PutBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error) {
+				if params.BucketLoggingStatus.LoggingEnabled != nil {
+					return nil, constants.ErrInjected
+				}
+
+				return &s3.PutBucketLoggingOutput{}, nil
+			},
+			prompt.PromptMock{Msg: "y"},
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			true,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by put error",
+			false,
+			func(ctx context.Context, params *s3.PutBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by prompt error",
+			false,
+			nil,
+			prompt.PromptMock{Msg: "n", Err: constants.ErrInjected},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.PutBucketLoggingAPI = tc.putBucketLoggingFunc
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		_, err := DisableBucketLogging(mockS3, &accessloggingoptions.AccessLoggingOptions{RootOptions: rootOpts}, tc.PromptRunner, logging.GetLogger(rootOpts))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
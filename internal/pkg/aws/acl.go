@@ -0,0 +1,30 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	acloptions "github.com/bilalcaliskan/s3-manager/cmd/acl/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+)
+
+// GetBucketAcl retrieves the access control list of an S3 bucket.
+//
+// It accepts an S3API interface and AclOptions as arguments, and returns a GetBucketAclOutput and any error encountered.
+func GetBucketAcl(svc internalawstypes.S3ClientAPI, opts *acloptions.AclOptions) (res *s3.GetBucketAclOutput, err error) {
+	return svc.GetBucketAcl(context.Background(), &s3.GetBucketAclInput{
+		Bucket: aws.String(opts.BucketName),
+	})
+}
+
+// GetObjectAcl retrieves the access control list of an object in an S3 bucket.
+//
+// It accepts an S3API interface, AclOptions and the key of the target object as arguments, and returns a
+// GetObjectAclOutput and any error encountered.
+func GetObjectAcl(svc internalawstypes.S3ClientAPI, opts *acloptions.AclOptions, key string) (res *s3.GetObjectAclOutput, err error) {
+	return svc.GetObjectAcl(context.Background(), &s3.GetObjectAclInput{
+		Bucket: aws.String(opts.BucketName),
+		Key:    aws.String(key),
+	})
+}
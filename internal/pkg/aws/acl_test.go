@@ -0,0 +1,82 @@
+//go:build unit
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	acloptions "github.com/bilalcaliskan/s3-manager/cmd/acl/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBucketAcl(t *testing.T) {
+	cases := []struct {
+		caseName         string
+		expected         error
+		getBucketAclFunc func(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options)) (*s3.GetBucketAclOutput, error)
+	}{
+		{
+			"Success",
+			nil,
+			func(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+				return &s3.GetBucketAclOutput{}, nil
+			},
+		},
+		{
+			"Failure",
+			constants.ErrInjected,
+			func(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketAclAPI = tc.getBucketAclFunc
+
+		_, err := GetBucketAcl(mockS3, &acloptions.AclOptions{RootOptions: options.GetMockedRootOptions()})
+		assert.Equal(t, tc.expected, err)
+	}
+}
+
+func TestGetObjectAcl(t *testing.T) {
+	cases := []struct {
+		caseName         string
+		expected         error
+		getObjectAclFunc func(ctx context.Context, params *s3.GetObjectAclInput, optFns ...func(*s3.Options)) (*s3.GetObjectAclOutput, error)
+	}{
+		{
+			"Success",
+			nil,
+			func(ctx context.Context, params *s3.GetObjectAclInput, optFns ...func(*s3.Options)) (*s3.GetObjectAclOutput, error) {
+				assert.Equal(t, "foo.txt", *params.Key)
+				return &s3.GetObjectAclOutput{}, nil
+			},
+		},
+		{
+			"Failure",
+			constants.ErrInjected,
+			func(ctx context.Context, params *s3.GetObjectAclInput, optFns ...func(*s3.Options)) (*s3.GetObjectAclOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetObjectAclAPI = tc.getObjectAclFunc
+
+		_, err := GetObjectAcl(mockS3, &acloptions.AclOptions{RootOptions: options.GetMockedRootOptions()}, "foo.txt")
+		assert.Equal(t, tc.expected, err)
+	}
+}
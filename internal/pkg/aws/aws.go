@@ -6,7 +6,10 @@ import (
 	"fmt"
 	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/diff"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/restore"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/storageclass"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
 	"regexp"
 	"strings"
@@ -17,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 
@@ -46,14 +50,26 @@ func CreateClient(opts *options.RootOptions) (*s3.Client, error) {
 	return s3.NewFromConfig(cfg), nil
 }
 
+// isErrorCode reports whether the given error is an AWS API error with the given error code.
+func isErrorCode(err error, code string) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == code
+}
+
 // GetBucketTags retrieves all tags attached to a specific S3 bucket.
 //
 // It accepts an S3API interface and pointer of TagOptions as arguments, and returns
 // a GetBucketTaggingOutput, which contains all the bucket's tags, and any error encountered.
+// A bucket without any tags is returned with an empty tag set instead of the NoSuchTagSet error.
 func GetBucketTags(svc internalawstypes.S3ClientAPI, opts *tagoptions.TagOptions) (res *s3.GetBucketTaggingOutput, err error) {
-	return svc.GetBucketTagging(context.Background(), &s3.GetBucketTaggingInput{
+	res, err = svc.GetBucketTagging(context.Background(), &s3.GetBucketTaggingInput{
 		Bucket: aws.String(opts.BucketName),
 	})
+	if isErrorCode(err, "NoSuchTagSet") {
+		return &s3.GetBucketTaggingOutput{}, nil
+	}
+
+	return res, err
 }
 
 // SetBucketTags attaches a set of tags to a specific S3 bucket.
@@ -61,7 +77,8 @@ func GetBucketTags(svc internalawstypes.S3ClientAPI, opts *tagoptions.TagOptions
 // It accepts an S3API interface and TagOptions as arguments.
 // For each tag in the provided TagOptions, a new tag is created and added to a slice of tags.
 // It then attaches these tags to the bucket and returns a PutBucketTaggingOutput and any error encountered.
-func SetBucketTags(svc internalawstypes.S3ClientAPI, opts *tagoptions.TagOptions, runner prompt.PromptRunner, logger zerolog.Logger) error {
+// The optFns are passed to the PutBucketTagging call, e.g. to tag a bucket in another region with InRegion.
+func SetBucketTags(svc internalawstypes.S3ClientAPI, opts *tagoptions.TagOptions, runner prompt.PromptRunner, logger zerolog.Logger, optFns ...func(*s3.Options)) error {
 	if opts.DryRun {
 		logger.Info().Msg(constants.InfDryRun)
 		return nil
@@ -86,7 +103,7 @@ func SetBucketTags(svc internalawstypes.S3ClientAPI, opts *tagoptions.TagOptions
 	_, err := svc.PutBucketTagging(context.Background(), &s3.PutBucketTaggingInput{
 		Bucket:  aws.String(opts.BucketName),
 		Tagging: &types.Tagging{TagSet: tagsSet},
-	})
+	}, optFns...)
 
 	if err != nil {
 		return err
@@ -276,21 +293,10 @@ func GetBucketVersioning(svc internalawstypes.S3ClientAPI, opts *options.RootOpt
 //
 // It accepts an S3API interface, VersioningOptions, a PromptRunner for user confirmations,
 // and a Logger for logging events. The function uses these to check for dry-run or auto-approve
-// flags, confirm versioning state changes with the user if needed, and execute a
+// flags, show the state change and confirm it with the user if needed, and execute a
 // PutBucketVersioningInput request to set the bucket's versioning state.
 // The function logs the process, including any errors encountered, and returns these errors.
 func SetBucketVersioning(svc internalawstypes.S3ClientAPI, versioningOpts *versioningoptions.VersioningOptions, runner prompt.PromptRunner, logger zerolog.Logger) (err error) {
-	if versioningOpts.DryRun {
-		logger.Info().Msg(constants.InfDryRun)
-		return nil
-	}
-
-	if !versioningOpts.AutoApprove {
-		if err := prompt.AskForApproval(runner); err != nil {
-			return err
-		}
-	}
-
 	var versioning *s3.GetBucketVersioningOutput
 	versioning, err = GetBucketVersioning(svc, versioningOpts.RootOptions)
 	if err != nil {
@@ -312,6 +318,18 @@ func SetBucketVersioning(svc internalawstypes.S3ClientAPI, versioningOpts *versi
 	}
 
 	logger.Info().Msgf(versioningutils.InfSettingVersioning, versioningOpts.DesiredState)
+	fmt.Println(diff.State("versioning", versioningOpts.ActualState, versioningOpts.DesiredState))
+
+	if versioningOpts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return nil
+	}
+
+	if !versioningOpts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return err
+		}
+	}
 
 	var str string
 	switch versioningOpts.DesiredState {
@@ -419,16 +437,17 @@ func ListAllObjects(svc internalawstypes.S3ClientAPI, bucketName string) ([]type
 // The function accepts an S3API interface and SearchOptions, which include the bucket
 // name, file name pattern, and search text. It first retrieves a list of objects that match
 // the file name pattern, then concurrently checks each object's content for the search text.
-// The function returns a list of object keys that contain the search text and a list of errors
+// The function returns a list of object keys that contain the search text, the archived objects
+// that are skipped with the reasons if 'SkipArchived' of SearchOptions is set, and a list of errors
 // encountered during the search process.
-func SearchString(svc internalawstypes.S3ClientAPI, opts *searchoptions.SearchOptions) (matchedFiles []string, errs []error) {
+func SearchString(svc internalawstypes.S3ClientAPI, opts *searchoptions.SearchOptions) (matchedFiles []string, skips []storageclass.Skip, errs []error) {
 	var wg sync.WaitGroup
 	mu := &sync.Mutex{}
 
 	resultArr, err := GetDesiredObjects(svc, opts.BucketName, opts.FileName)
 	if err != nil {
 		errs = append(errs, err)
-		return matchedFiles, errs
+		return matchedFiles, skips, errs
 	}
 
 	// check each txt file individually if it contains provided text
@@ -441,26 +460,39 @@ func SearchString(svc internalawstypes.S3ClientAPI, opts *searchoptions.SearchOp
 				Key:    obj.Key,
 			})
 
-			defer func() {
-				if err := getResult.Body.Close(); err != nil {
-					errs = append(errs, errors.Wrap(err, "an error occurred while closing response body"))
-				}
-			}()
-
 			if err != nil {
 				mu.Lock()
+				defer mu.Unlock()
+
+				// archived objects can not be read until they are restored
+				if reason, ok := restore.ArchivedReason(err); ok {
+					if opts.SkipArchived {
+						skips = append(skips, storageclass.Skip{Key: *obj.Key, Reason: reason})
+						return
+					}
+
+					err = errors.Wrapf(err, "%s is %s, '--skip-archived' flag can be used to skip it", *obj.Key, reason)
+				}
+
 				errs = append(errs, err)
-				mu.Unlock()
 				return
 			}
 
+			defer func() {
+				if err := getResult.Body.Close(); err != nil {
+					mu.Lock()
+					errs = append(errs, errors.Wrap(err, "an error occurred while closing response body"))
+					mu.Unlock()
+				}
+			}()
+
 			buf := new(bytes.Buffer)
-			mu.Lock()
 			if _, err := buf.ReadFrom(getResult.Body); err != nil {
+				mu.Lock()
 				errs = append(errs, err)
+				mu.Unlock()
 				return
 			}
-			mu.Unlock()
 
 			if strings.Contains(buf.String(), opts.Text) {
 				mu.Lock()
@@ -471,5 +503,5 @@ func SearchString(svc internalawstypes.S3ClientAPI, opts *searchoptions.SearchOp
 	}
 
 	wg.Wait()
-	return matchedFiles, errs
+	return matchedFiles, skips, errs
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/logging"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/storageclass"
 	"io"
 	"os"
 	"strings"
@@ -17,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
 
 	"github.com/pkg/errors"
 
@@ -68,6 +70,7 @@ var dummyBucketPolicyStr = `
 //
 // The test function iterates through all the test cases and performs the necessary assertions.
 func TestDeleteFiles(t *testing.T) {
+	errAccessDenied := &smithy.GenericAPIError{Code: "AccessDenied"}
 	rootOpts := options.GetMockedRootOptions()
 	cases := []struct {
 		caseName   string
@@ -138,6 +141,34 @@ func TestDeleteFiles(t *testing.T) {
 				},
 			},
 		},
+		{
+			"Failure caused by access denied",
+			errAccessDenied,
+			func(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error) {
+				if *params.Key == "../../testdata/file5.txt" {
+					return nil, errAccessDenied
+				}
+
+				return &s3.DeleteObjectOutput{}, nil
+			},
+			false,
+			[]types.Object{
+				{
+					ETag:         aws.String("03c0fe42b7efa3470fc99037a8e5449d"),
+					Key:          aws.String("../../testdata/file4.txt"),
+					StorageClass: types.ObjectStorageClass("STANDART"),
+					Size:         aws.Int64(500),
+					LastModified: aws.Time(time.Now().Add(-5 * time.Hour)),
+				},
+				{
+					ETag:         aws.String("03c0fe42b7efa3470fc99037a8e54122"),
+					Key:          aws.String("../../testdata/file5.txt"),
+					StorageClass: types.ObjectStorageClass("STANDART"),
+					Size:         aws.Int64(1000),
+					LastModified: aws.Time(time.Now().Add(-2 * time.Hour)),
+				},
+			},
+		},
 		{
 			"Failure caused by delete object err",
 			constants.ErrInjected,
@@ -238,17 +269,17 @@ func TestSearchString(t *testing.T) {
 					Contents: []types.Object{
 						{
 							ETag:         aws.String("03c0fe42b7efa3470fc99037a8e5449d"),
-							Key:          aws.String("../../testdata/file1.txt"),
+							Key:          aws.String("../../../testdata/file1.txt"),
 							StorageClass: types.ObjectStorageClassStandard,
 						},
 						{
 							ETag:         aws.String("03c0fe42b7efa3470fc99037a8e54122"),
-							Key:          aws.String("../../testdata/file2.txt"),
+							Key:          aws.String("../../../testdata/file2.txt"),
 							StorageClass: types.ObjectStorageClassStandard,
 						},
 						{
 							ETag:         aws.String("03c0fe42b7efa3470fc99037a8e5443d"),
-							Key:          aws.String("../../testdata/file3.txt"),
+							Key:          aws.String("../../../testdata/file3.txt"),
 							StorageClass: types.ObjectStorageClassStandard,
 						},
 					},
@@ -280,17 +311,17 @@ func TestSearchString(t *testing.T) {
 					Contents: []types.Object{
 						{
 							ETag:         aws.String("03c0fe42b7efa3470fc99037a8e5449d"),
-							Key:          aws.String("../../testdata/file1.txt"),
+							Key:          aws.String("../../../testdata/file1.txt"),
 							StorageClass: types.ObjectStorageClassStandard,
 						},
 						{
 							ETag:         aws.String("03c0fe42b7efa3470fc99037a8e54122"),
-							Key:          aws.String("../../testdata/file2.txt"),
+							Key:          aws.String("../../../testdata/file2.txt"),
 							StorageClass: types.ObjectStorageClassStandard,
 						},
 						{
 							ETag:         aws.String("03c0fe42b7efa3470fc99037a8e5443d"),
-							Key:          aws.String("../../testdata/file3.txt"),
+							Key:          aws.String("../../../testdata/file3.txt"),
 							StorageClass: types.ObjectStorageClassStandard,
 						},
 					},
@@ -338,17 +369,17 @@ func TestSearchString(t *testing.T) {
 					Contents: []types.Object{
 						{
 							ETag:         aws.String("03c0fe42b7efa3470fc99037a8e5449d"),
-							Key:          aws.String("../../testdata/file1.txttt"),
+							Key:          aws.String("../../../testdata/file1.txttt"),
 							StorageClass: types.ObjectStorageClassStandard,
 						},
 						{
 							ETag:         aws.String("03c0fe42b7efa3470fc99037a8e54122"),
-							Key:          aws.String("../../testdata/file2.txt"),
+							Key:          aws.String("../../../testdata/file2.txt"),
 							StorageClass: types.ObjectStorageClassStandard,
 						},
 						{
 							ETag:         aws.String("03c0fe42b7efa3470fc99037a8e5443d"),
-							Key:          aws.String("../../testdata/file3.txt"),
+							Key:          aws.String("../../../testdata/file3.txt"),
 							StorageClass: types.ObjectStorageClassStandard,
 						},
 					},
@@ -370,7 +401,7 @@ func TestSearchString(t *testing.T) {
 		mockS3.ListObjectsAPI = tc.listObjectsFunc
 		mockS3.GetObjectAPI = tc.getObjectFunc
 
-		res, err := SearchString(mockS3, tc.searchOpts)
+		res, _, err := SearchString(mockS3, tc.searchOpts)
 
 		if tc.shouldPass {
 			assert.Nil(t, err)
@@ -382,6 +413,43 @@ func TestSearchString(t *testing.T) {
 	}
 }
 
+func TestSearchStringArchived(t *testing.T) {
+	listObjectsFunc := func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+		return &s3.ListObjectsOutput{
+			Contents: []types.Object{
+				{Key: aws.String("../../../testdata/file1.txt"), StorageClass: types.ObjectStorageClassStandard},
+				{Key: aws.String("../../../testdata/file2.txt"), StorageClass: types.ObjectStorageClassGlacier},
+			},
+		}, nil
+	}
+
+	getObjectFunc := func(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+		if *params.Key == "../../../testdata/file2.txt" {
+			return nil, &types.InvalidObjectState{StorageClass: types.StorageClassGlacier}
+		}
+
+		return &s3.GetObjectOutput{Body: getMockBody(*params.Key)}, nil
+	}
+
+	mockS3 := new(internalawstypes.MockS3Client)
+	mockS3.ListObjectsAPI = listObjectsFunc
+	mockS3.GetObjectAPI = getObjectFunc
+
+	searchOpts := &options2.SearchOptions{Text: "pvRRTaigmb", RootOptions: options.GetMockedRootOptions()}
+	res, skips, errs := SearchString(mockS3, searchOpts)
+	assert.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "'--skip-archived'")
+	assert.Empty(t, skips)
+	assert.Len(t, res, 1)
+
+	searchOpts.SkipArchived = true
+	res, skips, errs = SearchString(mockS3, searchOpts)
+	assert.Empty(t, errs)
+	assert.Equal(t, []storageclass.Skip{{Key: "../../../testdata/file2.txt",
+		Reason: "archived in GLACIER, it must be restored before it can be read"}}, skips)
+	assert.Len(t, res, 1)
+}
+
 // TestSetBucketVersioning is a test function that tests the behavior of the SetBucketVersioning function.
 //
 // It creates test cases with different scenarios and verifies the expected results.
@@ -425,6 +493,24 @@ func TestSetBucketVersioning(t *testing.T) {
 			true,
 			nil,
 		},
+		{
+			"Success when dry run enabled",
+			&options3.VersioningOptions{
+				ActualState:  "",
+				DesiredState: "enabled",
+				RootOptions:  rootOpts,
+			},
+			func(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
+				return &s3.GetBucketVersioningOutput{
+					Status: types.BucketVersioningStatusSuspended,
+				}, nil
+			},
+			nil,
+			nil,
+			true,
+			false,
+			nil,
+		},
 		{
 			"Successfully enabled when already enabled",
 			&options3.VersioningOptions{
@@ -707,6 +793,16 @@ func TestGetBucketTags(t *testing.T) {
 				}, nil
 			},
 		},
+		{
+			"Success without any tags",
+			nil,
+			&options4.TagOptions{
+				RootOptions: rootOpts,
+			},
+			func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "NoSuchTagSet"}
+			},
+		},
 		{
 			"Failure",
 			constants.ErrInjected,
@@ -725,8 +821,11 @@ func TestGetBucketTags(t *testing.T) {
 		mockS3 := new(internalawstypes.MockS3Client)
 		mockS3.GetBucketTaggingAPI = tc.getBucketTaggingFunc
 
-		_, err := GetBucketTags(mockS3, tc.TagOptions)
+		res, err := GetBucketTags(mockS3, tc.TagOptions)
 		assert.Equal(t, tc.expected, err)
+		if tc.expected == nil {
+			assert.NotNil(t, res)
+		}
 	}
 }
 
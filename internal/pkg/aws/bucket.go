@@ -0,0 +1,245 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	bucketoptions "github.com/bilalcaliskan/s3-manager/cmd/bucket/options"
+	bucketutils "github.com/bilalcaliskan/s3-manager/cmd/bucket/utils"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// defaultRegion is the region that does not accept a location constraint while creating a bucket
+const defaultRegion = "us-east-1"
+
+// CreateBucket creates the target bucket in the region of BucketOptions and configures its versioning, default
+// encryption and tags in one step.
+//
+// It accepts an S3API interface, BucketOptions, a PromptRunner, and a Logger as arguments.
+// The options are validated before anything else so that an invalid configuration is reported even with the
+// 'DryRun' option. If the provided 'DryRun' option is set, the function will return early, if 'AutoApprove' is
+// not set it asks for approval before creating the bucket. Object Lock can only be enabled while creating the
+// bucket and it enables versioning implicitly. A failure after the bucket is created is reported as is, the bucket
+// is not rolled back.
+func CreateBucket(svc internalawstypes.S3ClientAPI, opts *bucketoptions.BucketOptions, runner prompt.PromptRunner, logger zerolog.Logger) error {
+	if err := bucketutils.ValidateCreate(opts); err != nil {
+		return err
+	}
+
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return err
+		}
+	}
+
+	input := &s3.CreateBucketInput{
+		Bucket:                     aws.String(opts.BucketName),
+		ObjectLockEnabledForBucket: aws.Bool(opts.ObjectLock),
+	}
+
+	if opts.Region != "" && opts.Region != defaultRegion {
+		input.CreateBucketConfiguration = &types.CreateBucketConfiguration{
+			LocationConstraint: types.BucketLocationConstraint(opts.Region),
+		}
+	}
+
+	if _, err := svc.CreateBucket(context.Background(), input); err != nil {
+		return err
+	}
+
+	logger.Info().Msg(bucketutils.InfCreated)
+
+	if opts.Versioning && !opts.ObjectLock {
+		if _, err := svc.PutBucketVersioning(context.Background(), &s3.PutBucketVersioningInput{
+			Bucket:                  aws.String(opts.BucketName),
+			VersioningConfiguration: &types.VersioningConfiguration{Status: types.BucketVersioningStatusEnabled},
+		}); err != nil {
+			return errors.Wrap(err, "bucket is created but an error occurred while enabling versioning")
+		}
+	}
+
+	if opts.Encryption != "" {
+		rule := types.ServerSideEncryptionRule{
+			ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{
+				SSEAlgorithm: types.ServerSideEncryption(opts.Encryption),
+			},
+		}
+
+		if opts.KmsKeyID != "" {
+			rule.ApplyServerSideEncryptionByDefault.KMSMasterKeyID = aws.String(opts.KmsKeyID)
+		}
+
+		if _, err := svc.PutBucketEncryption(context.Background(), &s3.PutBucketEncryptionInput{
+			Bucket: aws.String(opts.BucketName),
+			ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+				Rules: []types.ServerSideEncryptionRule{rule},
+			},
+		}); err != nil {
+			return errors.Wrap(err, "bucket is created but an error occurred while setting default encryption")
+		}
+	}
+
+	if len(opts.Tags) > 0 {
+		keys := make([]string, 0, len(opts.Tags))
+		for key := range opts.Tags {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		tagSet := make([]types.Tag, 0, len(keys))
+		for _, key := range keys {
+			tagSet = append(tagSet, types.Tag{Key: aws.String(key), Value: aws.String(opts.Tags[key])})
+		}
+
+		if _, err := svc.PutBucketTagging(context.Background(), &s3.PutBucketTaggingInput{
+			Bucket:  aws.String(opts.BucketName),
+			Tagging: &types.Tagging{TagSet: tagSet},
+		}); err != nil {
+			return errors.Wrap(err, "bucket is created but an error occurred while setting tags")
+		}
+	}
+
+	return nil
+}
+
+// ListAllObjectVersions retrieves all the object versions and delete markers of an S3 bucket as the identifiers
+// that DeleteObjects accepts. Objects of a bucket that has never been versioned are reported with the "null"
+// version ID.
+func ListAllObjectVersions(svc internalawstypes.S3ClientAPI, bucketName string) ([]types.ObjectIdentifier, error) {
+	var (
+		objects         []types.ObjectIdentifier
+		keyMarker       *string
+		versionIDMarker *string
+	)
+
+	for {
+		res, err := svc.ListObjectVersions(context.Background(), &s3.ListObjectVersionsInput{
+			Bucket:          aws.String(bucketName),
+			KeyMarker:       keyMarker,
+			VersionIdMarker: versionIDMarker,
+		})
+		if err != nil {
+			return objects, err
+		}
+
+		for _, v := range res.Versions {
+			objects = append(objects, types.ObjectIdentifier{Key: v.Key, VersionId: v.VersionId})
+		}
+
+		for _, v := range res.DeleteMarkers {
+			objects = append(objects, types.ObjectIdentifier{Key: v.Key, VersionId: v.VersionId})
+		}
+
+		if !aws.ToBool(res.IsTruncated) {
+			break
+		}
+
+		keyMarker = res.NextKeyMarker
+		versionIDMarker = res.NextVersionIdMarker
+	}
+
+	return objects, nil
+}
+
+// DeleteObjectsInBatches deletes the given object versions and delete markers from the target bucket with
+// DeleteObjects calls of BatchSize objects each.
+//
+// It accepts an S3API interface, BucketOptions, the objects to delete, a PromptRunner, and a Logger as arguments,
+// and follows the same 'DryRun' and 'AutoApprove' semantics with CreateBucket. Objects that S3 refuses to delete,
+// such as the ones under Object Lock retention, are logged with a warning and reported with a single error after
+// all the batches are processed.
+func DeleteObjectsInBatches(svc internalawstypes.S3ClientAPI, opts *bucketoptions.BucketOptions, objects []types.ObjectIdentifier, runner prompt.PromptRunner, logger zerolog.Logger) error {
+	if err := bucketutils.ValidateBatchSize(opts.BatchSize); err != nil {
+		return err
+	}
+
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return err
+		}
+	}
+
+	var failed int
+	for start := 0; start < len(objects); start += opts.BatchSize {
+		end := start + opts.BatchSize
+		if end > len(objects) {
+			end = len(objects)
+		}
+
+		res, err := svc.DeleteObjects(context.Background(), &s3.DeleteObjectsInput{
+			Bucket: aws.String(opts.BucketName),
+			Delete: &types.Delete{Objects: objects[start:end], Quiet: aws.Bool(true)},
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, v := range res.Errors {
+			logger.Warn().Str("key", aws.ToString(v.Key)).Str("versionId", aws.ToString(v.VersionId)).
+				Str("code", aws.ToString(v.Code)).Msg(aws.ToString(v.Message))
+		}
+
+		failed += len(res.Errors)
+		logger.Info().Int("deleted", end-start-len(res.Errors)).Int("remaining", len(objects)-end).
+			Msg("processed a batch of objects")
+	}
+
+	if failed > 0 {
+		return fmt.Errorf(bucketutils.ErrObjectsNotDeleted, failed)
+	}
+
+	return nil
+}
+
+// DeleteBucket deletes the target bucket, which must already be empty.
+//
+// It accepts an S3API interface, BucketOptions, a PromptRunner, and a Logger as arguments. Unlike the other
+// operations the runner is expected to ask for the name of the bucket, the bucket is deleted only if the input
+// exactly matches the name of the target bucket. The 'ConfirmBucketName' of BucketOptions is checked instead of
+// asking if set, and it is required with the 'AutoApprove' option, which does not skip the name check. The 'DryRun'
+// option is respected as usual.
+func DeleteBucket(svc internalawstypes.S3ClientAPI, opts *bucketoptions.BucketOptions, runner prompt.PromptRunner, logger zerolog.Logger) (res *s3.DeleteBucketOutput, err error) {
+	if err := bucketutils.ValidateDelete(opts); err != nil {
+		return res, err
+	}
+
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return res, nil
+	}
+
+	if opts.ConfirmBucketName == "" {
+		if err := prompt.AskForExactMatch(runner, opts.BucketName); err != nil {
+			return res, err
+		}
+	}
+
+	res, err = svc.DeleteBucket(context.Background(), &s3.DeleteBucketInput{
+		Bucket: aws.String(opts.BucketName),
+	})
+
+	if err != nil && isErrorCode(err, "BucketNotEmpty") {
+		return res, errors.New(bucketutils.ErrBucketNotEmpty)
+	}
+
+	return res, err
+}
@@ -0,0 +1,528 @@
+//go:build unit
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	bucketoptions "github.com/bilalcaliskan/s3-manager/cmd/bucket/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/logging"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateBucket(t *testing.T) {
+	createBucketFunc := func(ctx context.Context, params *s3.CreateBucketInput, optFns ...func(*s3.Options)) (*s3.CreateBucketOutput, error) {
+		return &s3.CreateBucketOutput{}, nil
+	}
+
+	cases := []struct {
+		caseName                string
+		shouldPass              bool
+		region                  string
+		objectLock              bool
+		versioning              bool
+		encryption              string
+		tags                    map[string]string
+		createBucketFunc        func(ctx context.Context, params *s3.CreateBucketInput, optFns ...func(*s3.Options)) (*s3.CreateBucketOutput, error)
+		putBucketVersioningFunc func(ctx context.Context, params *s3.PutBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.PutBucketVersioningOutput, error)
+		putBucketEncryptionFunc func(ctx context.Context, params *s3.PutBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error)
+		putBucketTaggingFunc    func(ctx context.Context, params *s3.PutBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success with all the configurations",
+			true,
+			"eu-west-1",
+			false,
+			true,
+			"aws:kms",
+			map[string]string{"team": "platform", "env": "prod"},
+			func(ctx context.Context, params *s3.CreateBucketInput, optFns ...func(*s3.Options)) (*s3.CreateBucketOutput, error) {
+				assert.Equal(t, types.BucketLocationConstraint("eu-west-1"), params.CreateBucketConfiguration.LocationConstraint)
+				assert.False(t, *params.ObjectLockEnabledForBucket)
+				return &s3.CreateBucketOutput{}, nil
+			},
+			func(ctx context.Context, params *s3.PutBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.PutBucketVersioningOutput, error) {
+				return &s3.PutBucketVersioningOutput{}, nil
+			},
+			func(ctx context.Context, params *s3.PutBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error) {
+				return &s3.PutBucketEncryptionOutput{}, nil
+			},
+			func(ctx context.Context, params *s3.PutBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error) {
+				assert.Equal(t, "env", *params.Tagging.TagSet[0].Key)
+				assert.Equal(t, "team", *params.Tagging.TagSet[1].Key)
+				return &s3.PutBucketTaggingOutput{}, nil
+			},
+			prompt.PromptMock{Msg: "y"},
+			false,
+			false,
+		},
+		{
+			"Success in default region with object lock",
+			true,
+			"us-east-1",
+			true,
+			true,
+			"",
+			nil,
+			func(ctx context.Context, params *s3.CreateBucketInput, optFns ...func(*s3.Options)) (*s3.CreateBucketOutput, error) {
+				assert.Nil(t, params.CreateBucketConfiguration)
+				assert.True(t, *params.ObjectLockEnabledForBucket)
+				return &s3.CreateBucketOutput{}, nil
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with dry run",
+			true,
+			"eu-west-1",
+			false,
+			false,
+			"",
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by invalid options",
+			false,
+			"eu-west-1",
+			false,
+			false,
+			"DES",
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by prompt error",
+			false,
+			"eu-west-1",
+			false,
+			false,
+			"",
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			prompt.PromptMock{Msg: "n", Err: constants.ErrInjected},
+			false,
+			false,
+		},
+		{
+			"Failure caused by create error",
+			false,
+			"eu-west-1",
+			false,
+			false,
+			"",
+			nil,
+			func(ctx context.Context, params *s3.CreateBucketInput, optFns ...func(*s3.Options)) (*s3.CreateBucketOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by versioning error",
+			false,
+			"eu-west-1",
+			false,
+			true,
+			"",
+			nil,
+			createBucketFunc,
+			func(ctx context.Context, params *s3.PutBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.PutBucketVersioningOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by encryption error",
+			false,
+			"eu-west-1",
+			false,
+			false,
+			"AES256",
+			nil,
+			createBucketFunc,
+			nil,
+			func(ctx context.Context, params *s3.PutBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by tagging error",
+			false,
+			"eu-west-1",
+			false,
+			false,
+			"",
+			map[string]string{"team": "platform"},
+			createBucketFunc,
+			nil,
+			nil,
+			func(ctx context.Context, params *s3.PutBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.CreateBucketAPI = tc.createBucketFunc
+		mockS3.PutBucketVersioningAPI = tc.putBucketVersioningFunc
+		mockS3.PutBucketEncryptionAPI = tc.putBucketEncryptionFunc
+		mockS3.PutBucketTaggingAPI = tc.putBucketTaggingFunc
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.Region = tc.region
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		err := CreateBucket(mockS3, &bucketoptions.BucketOptions{
+			ObjectLock:  tc.objectLock,
+			Versioning:  tc.versioning,
+			Encryption:  tc.encryption,
+			Tags:        tc.tags,
+			RootOptions: rootOpts,
+		}, tc.PromptRunner, logging.GetLogger(rootOpts))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestListAllObjectVersions(t *testing.T) {
+	cases := []struct {
+		caseName               string
+		expected               error
+		objects                int
+		listObjectVersionsFunc func(ctx context.Context, params *s3.ListObjectVersionsInput, optFns ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error)
+	}{
+		{
+			"Success with pagination",
+			nil,
+			4,
+			func(ctx context.Context, params *s3.ListObjectVersionsInput, optFns ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error) {
+				if params.KeyMarker == nil {
+					return &s3.ListObjectVersionsOutput{
+						Versions: []types.ObjectVersion{
+							{Key: aws.String("file1.txt"), VersionId: aws.String("v1")},
+							{Key: aws.String("file1.txt"), VersionId: aws.String("v2")},
+						},
+						DeleteMarkers:       []types.DeleteMarkerEntry{{Key: aws.String("file2.txt"), VersionId: aws.String("v3")}},
+						IsTruncated:         aws.Bool(true),
+						NextKeyMarker:       aws.String("file2.txt"),
+						NextVersionIdMarker: aws.String("v3"),
+					}, nil
+				}
+
+				assert.Equal(t, "v3", *params.VersionIdMarker)
+
+				return &s3.ListObjectVersionsOutput{
+					Versions:    []types.ObjectVersion{{Key: aws.String("file3.txt"), VersionId: aws.String("null")}},
+					IsTruncated: aws.Bool(false),
+				}, nil
+			},
+		},
+		{
+			"Failure",
+			constants.ErrInjected,
+			0,
+			func(ctx context.Context, params *s3.ListObjectVersionsInput, optFns ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListObjectVersionsAPI = tc.listObjectVersionsFunc
+
+		objects, err := ListAllObjectVersions(mockS3, "thisisbucketname")
+		assert.Equal(t, tc.expected, err)
+		assert.Len(t, objects, tc.objects)
+	}
+}
+
+func TestDeleteObjectsInBatches(t *testing.T) {
+	objects := []types.ObjectIdentifier{
+		{Key: aws.String("file1.txt"), VersionId: aws.String("v1")},
+		{Key: aws.String("file1.txt"), VersionId: aws.String("v2")},
+		{Key: aws.String("file2.txt"), VersionId: aws.String("v3")},
+	}
+
+	var calls int
+	cases := []struct {
+		caseName          string
+		shouldPass        bool
+		batchSize         int
+		expectedCalls     int
+		deleteObjectsFunc func(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			true,
+			2,
+			2,
+			func(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
+				calls++
+				assert.LessOrEqual(t, len(params.Delete.Objects), 2)
+				return &s3.DeleteObjectsOutput{}, nil
+			},
+			prompt.PromptMock{Msg: "y"},
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			true,
+			1000,
+			0,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by invalid batch size",
+			false,
+			0,
+			0,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by prompt error",
+			false,
+			1000,
+			0,
+			nil,
+			prompt.PromptMock{Msg: "n", Err: constants.ErrInjected},
+			false,
+			false,
+		},
+		{
+			"Failure caused by delete error",
+			false,
+			1000,
+			1,
+			func(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
+				calls++
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by locked objects",
+			false,
+			1,
+			3,
+			func(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
+				calls++
+				return &s3.DeleteObjectsOutput{Errors: []types.Error{{
+					Key:     params.Delete.Objects[0].Key,
+					Code:    aws.String("AccessDenied"),
+					Message: aws.String("Access Denied because object protected by object lock."),
+				}}}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		calls = 0
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.DeleteObjectsAPI = tc.deleteObjectsFunc
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		err := DeleteObjectsInBatches(mockS3, &bucketoptions.BucketOptions{BatchSize: tc.batchSize, RootOptions: rootOpts},
+			objects, tc.PromptRunner, logging.GetLogger(rootOpts))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		assert.Equal(t, tc.expectedCalls, calls)
+	}
+}
+
+func TestDeleteBucket(t *testing.T) {
+	cases := []struct {
+		caseName         string
+		shouldPass       bool
+		deleteBucketFunc func(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error)
+		prompt.PromptRunner
+		dryRun            bool
+		autoApprove       bool
+		confirmBucketName string
+	}{
+		{
+			"Success",
+			true,
+			func(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
+				return &s3.DeleteBucketOutput{}, nil
+			},
+			prompt.PromptMock{Msg: "thisisbucketname"},
+			false,
+			false,
+			"",
+		},
+		{
+			"Success with dry run",
+			true,
+			nil,
+			nil,
+			true,
+			false,
+			"",
+		},
+		{
+			"Failure caused by bucket name mismatch",
+			false,
+			nil,
+			prompt.PromptMock{Msg: "y"},
+			false,
+			false,
+			"",
+		},
+		{
+			"Failure caused by non empty bucket",
+			false,
+			func(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "BucketNotEmpty"}
+			},
+			nil,
+			false,
+			true,
+			"thisisbucketname",
+		},
+		{
+			"Failure caused by delete error",
+			false,
+			func(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+			"thisisbucketname",
+		},
+		{
+			"Success with confirm bucket name",
+			true,
+			func(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
+				return &s3.DeleteBucketOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+			"thisisbucketname",
+		},
+		{
+			"Failure caused by auto approve without confirm bucket name",
+			false,
+			func(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
+				return &s3.DeleteBucketOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+			"",
+		},
+		{
+			"Failure caused by confirm bucket name mismatch",
+			false,
+			func(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
+				return &s3.DeleteBucketOutput{}, nil
+			},
+			nil,
+			false,
+			false,
+			"foo",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.DeleteBucketAPI = tc.deleteBucketFunc
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		opts := &bucketoptions.BucketOptions{RootOptions: rootOpts, ConfirmBucketName: tc.confirmBucketName}
+		_, err := DeleteBucket(mockS3, opts, tc.PromptRunner, logging.GetLogger(rootOpts))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
@@ -0,0 +1,366 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	accessloggingoptions "github.com/bilalcaliskan/s3-manager/cmd/accesslogging/options"
+	accessloggingutils "github.com/bilalcaliskan/s3-manager/cmd/accesslogging/utils"
+	acloptions "github.com/bilalcaliskan/s3-manager/cmd/acl/options"
+	bucketpolicyoptions "github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/options"
+	bucketsoptions "github.com/bilalcaliskan/s3-manager/cmd/buckets/options"
+	corsoptions "github.com/bilalcaliskan/s3-manager/cmd/cors/options"
+	encryptionoptions "github.com/bilalcaliskan/s3-manager/cmd/encryption/options"
+	encryptionutils "github.com/bilalcaliskan/s3-manager/cmd/encryption/utils"
+	inventoryoptions "github.com/bilalcaliskan/s3-manager/cmd/inventory/options"
+	notificationsoptions "github.com/bilalcaliskan/s3-manager/cmd/notifications/options"
+	objectlockutils "github.com/bilalcaliskan/s3-manager/cmd/objectlock/utils"
+	publicaccessoptions "github.com/bilalcaliskan/s3-manager/cmd/publicaccess/options"
+	publicaccessutils "github.com/bilalcaliskan/s3-manager/cmd/publicaccess/utils"
+	replicationoptions "github.com/bilalcaliskan/s3-manager/cmd/replication/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	tagoptions "github.com/bilalcaliskan/s3-manager/cmd/tags/options"
+	taoptions "github.com/bilalcaliskan/s3-manager/cmd/transferacceleration/options"
+	websiteoptions "github.com/bilalcaliskan/s3-manager/cmd/website/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/bucketinfo"
+	"github.com/pkg/errors"
+)
+
+// ListBuckets lists all the buckets of the account with their regions and creation dates.
+//
+// It accepts an S3API interface and BucketsOptions as arguments. The region of each bucket is fetched concurrently,
+// limited by the 'Concurrency' of BucketsOptions. If the 'Details' option is set, the versioning, default encryption,
+// transfer acceleration and bucket policy status of each bucket are also fetched from the region of the bucket.
+// The settings that could not be fetched are reported as unknown, their errors are returned alongside the buckets.
+func ListBuckets(svc internalawstypes.S3ClientAPI, opts *bucketsoptions.BucketsOptions) ([]bucketinfo.Info, []error) {
+	res, err := svc.ListBuckets(context.Background(), &s3.ListBucketsInput{})
+	if err != nil {
+		return nil, []error{errors.Wrap(err, "an error occurred while listing buckets")}
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mu    = &sync.Mutex{}
+		errs  []error
+		sem   = make(chan struct{}, opts.Concurrency)
+		infos = make([]bucketinfo.Info, len(res.Buckets))
+	)
+
+	for i, bucket := range res.Buckets {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, name string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			info, bucketErrs := describeBucketSummary(svc, name, opts.Details)
+			info.CreationDate = aws.ToTime(res.Buckets[i].CreationDate)
+			infos[i] = info
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			errs = append(errs, bucketErrs...)
+		}(i, aws.ToString(bucket.Name))
+	}
+
+	wg.Wait()
+
+	return infos, errs
+}
+
+// ListBucketNames lists the names of all the buckets of the account.
+func ListBucketNames(svc internalawstypes.S3ClientAPI) ([]string, error) {
+	res, err := svc.ListBuckets(context.Background(), &s3.ListBucketsInput{})
+	if err != nil {
+		return nil, errors.Wrap(err, "an error occurred while listing buckets")
+	}
+
+	names := make([]string, 0, len(res.Buckets))
+	for _, bucket := range res.Buckets {
+		names = append(names, aws.ToString(bucket.Name))
+	}
+
+	return names, nil
+}
+
+// InRegion returns the option that sends a request to the given region instead of the region of the client, it is
+// required for the buckets that are not in the region of the target bucket.
+func InRegion(region string) func(*s3.Options) {
+	return func(o *s3.Options) {
+		o.Region = region
+	}
+}
+
+// describeBucketSummary fetches the region of a bucket and, if details is set, its statuses. The statuses are
+// fetched from the region of the bucket since the client is bound to the region of the target bucket.
+func describeBucketSummary(svc internalawstypes.S3ClientAPI, name string, details bool) (bucketinfo.Info, []error) {
+	info := bucketinfo.Info{
+		Name:         name,
+		Region:       bucketinfo.StatusUnknown,
+		Versioning:   bucketinfo.StatusUnknown,
+		Encryption:   bucketinfo.StatusUnknown,
+		Acceleration: bucketinfo.StatusUnknown,
+		Policy:       bucketinfo.StatusUnknown,
+	}
+
+	var errs []error
+	location, err := svc.GetBucketLocation(context.Background(), &s3.GetBucketLocationInput{Bucket: aws.String(name)})
+	if err != nil {
+		return info, []error{errors.Wrapf(err, "an error occurred while getting location of %s", name)}
+	}
+
+	info.Region = bucketinfo.RegionOf(location.LocationConstraint)
+	if !details {
+		return info, nil
+	}
+
+	inRegion := InRegion(info.Region)
+	if res, err := svc.GetBucketVersioning(context.Background(), &s3.GetBucketVersioningInput{Bucket: aws.String(name)}, inRegion); err != nil {
+		errs = append(errs, errors.Wrapf(err, "an error occurred while getting versioning of %s", name))
+	} else {
+		info.Versioning = bucketinfo.VersioningStatus(res.Status)
+	}
+
+	if res, err := svc.GetBucketEncryption(context.Background(), &s3.GetBucketEncryptionInput{Bucket: aws.String(name)}, inRegion); err == nil {
+		info.Encryption = bucketinfo.EncryptionStatus(res.ServerSideEncryptionConfiguration)
+	} else if isErrorCode(err, "ServerSideEncryptionConfigurationNotFoundError") {
+		info.Encryption = bucketinfo.StatusNone
+	} else {
+		errs = append(errs, errors.Wrapf(err, "an error occurred while getting encryption of %s", name))
+	}
+
+	if res, err := svc.GetBucketAccelerateConfiguration(context.Background(), &s3.GetBucketAccelerateConfigurationInput{Bucket: aws.String(name)}, inRegion); err != nil {
+		errs = append(errs, errors.Wrapf(err, "an error occurred while getting transfer acceleration of %s", name))
+	} else {
+		info.Acceleration = bucketinfo.AccelerationStatus(res.Status)
+	}
+
+	if _, err := svc.GetBucketPolicy(context.Background(), &s3.GetBucketPolicyInput{Bucket: aws.String(name)}, inRegion); err == nil {
+		info.Policy = bucketinfo.StatusPresent
+	} else if isErrorCode(err, "NoSuchBucketPolicy") {
+		info.Policy = bucketinfo.StatusNone
+	} else {
+		errs = append(errs, errors.Wrapf(err, "an error occurred while getting bucket policy of %s", name))
+	}
+
+	return info, errs
+}
+
+// settingFetcher fetches the human-readable value of a single setting of the Report of DescribeBucket
+type settingFetcher struct {
+	name  string
+	fetch func() (string, error)
+}
+
+// DescribeBucket builds a consolidated Report of every setting of the target bucket that s3-manager manages.
+//
+// It accepts an S3API interface and RootOptions as arguments. The settings are fetched concurrently with the
+// existing getters, a setting that could not be fetched is reported as unknown and its error is returned alongside
+// the Report. The settings are always reported in the same order.
+func DescribeBucket(svc internalawstypes.S3ClientAPI, opts *options.RootOptions) (bucketinfo.Report, []error) {
+	fetchers := []settingFetcher{
+		{"region", func() (string, error) {
+			res, err := svc.GetBucketLocation(context.Background(), &s3.GetBucketLocationInput{Bucket: aws.String(opts.BucketName)})
+			if err != nil {
+				return "", err
+			}
+
+			return bucketinfo.RegionOf(res.LocationConstraint), nil
+		}},
+		{"versioning", func() (string, error) {
+			res, err := GetBucketVersioning(svc, opts)
+			if err != nil {
+				return "", err
+			}
+
+			return bucketinfo.VersioningStatus(res.Status), nil
+		}},
+		{"default encryption", func() (string, error) {
+			encryptionOpts := &encryptionoptions.EncryptionOptions{RootOptions: opts}
+			res, err := GetBucketEncryption(svc, encryptionOpts)
+			if err != nil {
+				return "", err
+			}
+
+			if err := encryptionutils.DecideActualState(res, encryptionOpts); err != nil {
+				return "", err
+			}
+
+			return encryptionutils.FormatState(encryptionOpts.ActualState), nil
+		}},
+		{"transfer acceleration", func() (string, error) {
+			res, err := GetTransferAcceleration(svc, &taoptions.TransferAccelerationOptions{RootOptions: opts})
+			if err != nil {
+				return "", err
+			}
+
+			return bucketinfo.AccelerationStatus(res.Status), nil
+		}},
+		{"bucket policy", func() (string, error) {
+			doc, err := GetBucketPolicyDocument(svc, &bucketpolicyoptions.BucketPolicyOptions{RootOptions: opts})
+			if err != nil || doc == nil {
+				return bucketinfo.StatusNone, err
+			}
+
+			return fmt.Sprintf("%d statements", len(doc.Statement)), nil
+		}},
+		{"public access", func() (string, error) {
+			verdict, err := GetPublicAccessVerdict(svc, &publicaccessoptions.PublicAccessOptions{RootOptions: opts})
+			if err != nil {
+				return "", err
+			}
+
+			if verdict.Public {
+				return "public", nil
+			}
+
+			return "not public", nil
+		}},
+		{"public access block", func() (string, error) {
+			publicAccessOpts := &publicaccessoptions.PublicAccessOptions{RootOptions: opts}
+			res, err := GetPublicAccessBlock(svc, publicAccessOpts)
+			if err != nil {
+				return "", err
+			}
+
+			publicaccessutils.DecideActualState(res, publicAccessOpts)
+
+			return publicaccessutils.FormatState(publicAccessOpts.ActualState), nil
+		}},
+		{"acl", func() (string, error) {
+			res, err := GetBucketAcl(svc, &acloptions.AclOptions{RootOptions: opts})
+			if err != nil {
+				return "", err
+			}
+
+			return fmt.Sprintf("%d grants", len(res.Grants)), nil
+		}},
+		{"cors", func() (string, error) {
+			cfg, err := GetCorsConfiguration(svc, &corsoptions.CorsOptions{RootOptions: opts})
+			if err != nil {
+				return "", err
+			}
+
+			return countOf(len(cfg.CORSRules), "rules"), nil
+		}},
+		{"website", func() (string, error) {
+			cfg, err := GetWebsiteConfiguration(svc, &websiteoptions.WebsiteOptions{RootOptions: opts})
+			if err != nil {
+				return "", err
+			}
+
+			if cfg.IsEmpty() {
+				return "disabled", nil
+			}
+
+			return "enabled", nil
+		}},
+		{"replication", func() (string, error) {
+			cfg, err := GetReplicationConfiguration(svc, &replicationoptions.ReplicationOptions{RootOptions: opts})
+			if err != nil {
+				return "", err
+			}
+
+			return countOf(len(cfg.Rules), "rules"), nil
+		}},
+		{"object lock", func() (string, error) {
+			cfg, err := GetObjectLockConfiguration(svc, opts)
+			if err != nil {
+				return "", err
+			}
+
+			return objectlockutils.FormatConfiguration(cfg), nil
+		}},
+		{"access logging", func() (string, error) {
+			enabled, err := GetBucketLogging(svc, &accessloggingoptions.AccessLoggingOptions{RootOptions: opts})
+			if err != nil {
+				return "", err
+			}
+
+			return accessloggingutils.FormatLogging(enabled), nil
+		}},
+		{"inventory", func() (string, error) {
+			configurations, err := ListInventoryConfigurations(svc, &inventoryoptions.InventoryOptions{RootOptions: opts})
+			if err != nil {
+				return "", err
+			}
+
+			return countOf(len(configurations), "configurations"), nil
+		}},
+		{"notifications", func() (string, error) {
+			cfg, err := GetNotificationConfiguration(svc, &notificationsoptions.NotificationsOptions{RootOptions: opts})
+			if err != nil {
+				return "", err
+			}
+
+			return countOf(len(cfg.Rules), "rules"), nil
+		}},
+		{"tags", func() (string, error) {
+			res, err := GetBucketTags(svc, &tagoptions.TagOptions{RootOptions: opts})
+			if err != nil {
+				if isErrorCode(err, "NoSuchTagSet") {
+					return bucketinfo.StatusNone, nil
+				}
+
+				return "", err
+			}
+
+			tags := make([]string, 0, len(res.TagSet))
+			for _, tag := range res.TagSet {
+				tags = append(tags, fmt.Sprintf("%s=%s", aws.ToString(tag.Key), aws.ToString(tag.Value)))
+			}
+
+			if len(tags) == 0 {
+				return bucketinfo.StatusNone, nil
+			}
+
+			return strings.Join(tags, ", "), nil
+		}},
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mu     = &sync.Mutex{}
+		errs   []error
+		report = make(bucketinfo.Report, len(fetchers))
+	)
+
+	for i, fetcher := range fetchers {
+		wg.Add(1)
+		go func(i int, fetcher settingFetcher) {
+			defer wg.Done()
+
+			value, err := fetcher.fetch()
+			if err != nil {
+				value = bucketinfo.StatusUnknown
+
+				mu.Lock()
+				errs = append(errs, errors.Wrapf(err, "an error occurred while getting %s", fetcher.name))
+				mu.Unlock()
+			}
+
+			report[i] = bucketinfo.Setting{Name: fetcher.name, Value: value}
+		}(i, fetcher)
+	}
+
+	wg.Wait()
+
+	return report, errs
+}
+
+// countOf returns the count of the configured items of a setting, or none if there are no items
+func countOf(count int, noun string) string {
+	if count == 0 {
+		return bucketinfo.StatusNone
+	}
+
+	return fmt.Sprintf("%d %s", count, noun)
+}
@@ -0,0 +1,265 @@
+//go:build unit
+
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	bucketsoptions "github.com/bilalcaliskan/s3-manager/cmd/buckets/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/bucketinfo"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListBuckets(t *testing.T) {
+	created := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	listBucketsFunc := func(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+		return &s3.ListBucketsOutput{Buckets: []types.Bucket{
+			{Name: aws.String("virginia"), CreationDate: aws.Time(created)},
+			{Name: aws.String("ireland"), CreationDate: aws.Time(created)},
+		}}, nil
+	}
+	getBucketLocationFunc := func(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+		if aws.ToString(params.Bucket) == "ireland" {
+			return &s3.GetBucketLocationOutput{LocationConstraint: types.BucketLocationConstraintEu}, nil
+		}
+
+		return &s3.GetBucketLocationOutput{}, nil
+	}
+
+	cases := []struct {
+		caseName              string
+		details               bool
+		errs                  int
+		expected              []bucketinfo.Info
+		listBucketsFunc       func(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error)
+		getBucketLocationFunc func(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error)
+		getBucketPolicyFunc   func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error)
+	}{
+		{
+			"Success",
+			false,
+			0,
+			[]bucketinfo.Info{
+				{Name: "virginia", Region: "us-east-1", CreationDate: created, Versioning: bucketinfo.StatusUnknown,
+					Encryption: bucketinfo.StatusUnknown, Acceleration: bucketinfo.StatusUnknown, Policy: bucketinfo.StatusUnknown},
+				{Name: "ireland", Region: "eu-west-1", CreationDate: created, Versioning: bucketinfo.StatusUnknown,
+					Encryption: bucketinfo.StatusUnknown, Acceleration: bucketinfo.StatusUnknown, Policy: bucketinfo.StatusUnknown},
+			},
+			listBucketsFunc,
+			getBucketLocationFunc,
+			nil,
+		},
+		{
+			"Success with details",
+			true,
+			0,
+			[]bucketinfo.Info{
+				{Name: "virginia", Region: "us-east-1", CreationDate: created, Versioning: "Enabled",
+					Encryption: bucketinfo.StatusNone, Acceleration: "Disabled", Policy: bucketinfo.StatusPresent},
+				{Name: "ireland", Region: "eu-west-1", CreationDate: created, Versioning: "Enabled",
+					Encryption: bucketinfo.StatusNone, Acceleration: "Disabled", Policy: bucketinfo.StatusNone},
+			},
+			listBucketsFunc,
+			getBucketLocationFunc,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				if aws.ToString(params.Bucket) == "ireland" {
+					return nil, &smithy.GenericAPIError{Code: "NoSuchBucketPolicy"}
+				}
+
+				return &s3.GetBucketPolicyOutput{Policy: aws.String("{}")}, nil
+			},
+		},
+		{
+			"Success with details but policy error",
+			true,
+			2,
+			[]bucketinfo.Info{
+				{Name: "virginia", Region: "us-east-1", CreationDate: created, Versioning: "Enabled",
+					Encryption: bucketinfo.StatusNone, Acceleration: "Disabled", Policy: bucketinfo.StatusUnknown},
+				{Name: "ireland", Region: "eu-west-1", CreationDate: created, Versioning: "Enabled",
+					Encryption: bucketinfo.StatusNone, Acceleration: "Disabled", Policy: bucketinfo.StatusUnknown},
+			},
+			listBucketsFunc,
+			getBucketLocationFunc,
+			func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+		{
+			"Failure caused by location error",
+			true,
+			2,
+			[]bucketinfo.Info{
+				{Name: "virginia", Region: bucketinfo.StatusUnknown, CreationDate: created, Versioning: bucketinfo.StatusUnknown,
+					Encryption: bucketinfo.StatusUnknown, Acceleration: bucketinfo.StatusUnknown, Policy: bucketinfo.StatusUnknown},
+				{Name: "ireland", Region: bucketinfo.StatusUnknown, CreationDate: created, Versioning: bucketinfo.StatusUnknown,
+					Encryption: bucketinfo.StatusUnknown, Acceleration: bucketinfo.StatusUnknown, Policy: bucketinfo.StatusUnknown},
+			},
+			listBucketsFunc,
+			func(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+		},
+		{
+			"Failure caused by list error",
+			false,
+			1,
+			nil,
+			func(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListBucketsAPI = tc.listBucketsFunc
+		mockS3.GetBucketLocationAPI = tc.getBucketLocationFunc
+		mockS3.GetBucketPolicyAPI = tc.getBucketPolicyFunc
+		mockS3.GetBucketVersioningAPI = func(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
+			return &s3.GetBucketVersioningOutput{Status: types.BucketVersioningStatusEnabled}, nil
+		}
+		mockS3.GetBucketEncryptionAPI = func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+			return nil, &smithy.GenericAPIError{Code: "ServerSideEncryptionConfigurationNotFoundError"}
+		}
+		mockS3.GetBucketAccelerateConfigurationAPI = func(ctx context.Context, params *s3.GetBucketAccelerateConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketAccelerateConfigurationOutput, error) {
+			return &s3.GetBucketAccelerateConfigurationOutput{}, nil
+		}
+
+		infos, errs := ListBuckets(mockS3, &bucketsoptions.BucketsOptions{
+			Details:     tc.details,
+			Concurrency: 1,
+			RootOptions: options.GetMockedRootOptions(),
+		})
+		assert.Len(t, errs, tc.errs)
+		assert.Equal(t, tc.expected, infos)
+	}
+}
+
+// mockDescribeBucket sets all the getters that DescribeBucket calls, the ones that succeed report a bucket with
+// everything configured
+func mockDescribeBucket(mockS3 *internalawstypes.MockS3Client, err error) {
+	mockS3.GetBucketLocationAPI = func(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+		return &s3.GetBucketLocationOutput{LocationConstraint: types.BucketLocationConstraintEuCentral1}, err
+	}
+	mockS3.GetBucketVersioningAPI = func(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
+		return &s3.GetBucketVersioningOutput{Status: types.BucketVersioningStatusEnabled}, err
+	}
+	mockS3.GetBucketEncryptionAPI = func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+		return &s3.GetBucketEncryptionOutput{ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+			Rules: []types.ServerSideEncryptionRule{{
+				ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{SSEAlgorithm: types.ServerSideEncryptionAes256},
+			}},
+		}}, err
+	}
+	mockS3.GetBucketAccelerateConfigurationAPI = func(ctx context.Context, params *s3.GetBucketAccelerateConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketAccelerateConfigurationOutput, error) {
+		return &s3.GetBucketAccelerateConfigurationOutput{Status: types.BucketAccelerateStatusEnabled}, err
+	}
+	mockS3.GetBucketPolicyAPI = func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+		return &s3.GetBucketPolicyOutput{Policy: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny",` +
+			`"Principal":"*","Action":"s3:*","Resource":"arn:aws:s3:::thisisbucketname/*"}]}`)}, err
+	}
+	mockS3.GetBucketAclAPI = func(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+		return &s3.GetBucketAclOutput{Grants: []types.Grant{{Permission: types.PermissionFullControl,
+			Grantee: &types.Grantee{Type: types.TypeCanonicalUser, ID: aws.String("owner")}}}}, err
+	}
+	mockS3.GetPublicAccessBlockAPI = func(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+		return &s3.GetPublicAccessBlockOutput{}, err
+	}
+	mockS3.GetBucketCorsAPI = func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+		return &s3.GetBucketCorsOutput{}, err
+	}
+	mockS3.GetBucketWebsiteAPI = func(ctx context.Context, params *s3.GetBucketWebsiteInput, optFns ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error) {
+		return &s3.GetBucketWebsiteOutput{IndexDocument: &types.IndexDocument{Suffix: aws.String("index.html")}}, err
+	}
+	mockS3.GetBucketReplicationAPI = func(ctx context.Context, params *s3.GetBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
+		return nil, &smithy.GenericAPIError{Code: "ReplicationConfigurationNotFoundError"}
+	}
+	mockS3.GetObjectLockConfigurationAPI = func(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+		return &s3.GetObjectLockConfigurationOutput{}, err
+	}
+	mockS3.GetBucketLoggingAPI = func(ctx context.Context, params *s3.GetBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+		return &s3.GetBucketLoggingOutput{}, err
+	}
+	mockS3.ListBucketInventoryConfigurationsAPI = func(ctx context.Context, params *s3.ListBucketInventoryConfigurationsInput, optFns ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+		return &s3.ListBucketInventoryConfigurationsOutput{}, err
+	}
+	mockS3.GetBucketNotificationConfigurationAPI = func(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+		return &s3.GetBucketNotificationConfigurationOutput{}, err
+	}
+	mockS3.GetBucketTaggingAPI = func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+		return &s3.GetBucketTaggingOutput{TagSet: []types.Tag{{Key: aws.String("team"), Value: aws.String("platform")}}}, err
+	}
+}
+
+func TestDescribeBucket(t *testing.T) {
+	mockS3 := new(internalawstypes.MockS3Client)
+	mockDescribeBucket(mockS3, nil)
+
+	report, errs := DescribeBucket(mockS3, options.GetMockedRootOptions())
+	assert.Empty(t, errs)
+	assert.Equal(t, bucketinfo.Report{
+		{Name: "region", Value: "eu-central-1"},
+		{Name: "versioning", Value: "Enabled"},
+		{Name: "default encryption", Value: "AES256"},
+		{Name: "transfer acceleration", Value: "Enabled"},
+		{Name: "bucket policy", Value: "1 statements"},
+		{Name: "public access", Value: "not public"},
+		{Name: "public access block", Value: "blockPublicAcls=false, ignorePublicAcls=false, blockPublicPolicy=false, restrictPublicBuckets=false"},
+		{Name: "acl", Value: "1 grants"},
+		{Name: "cors", Value: bucketinfo.StatusNone},
+		{Name: "website", Value: "enabled"},
+		{Name: "replication", Value: bucketinfo.StatusNone},
+		{Name: "object lock", Value: "not enabled"},
+		{Name: "access logging", Value: "disabled"},
+		{Name: "inventory", Value: bucketinfo.StatusNone},
+		{Name: "notifications", Value: bucketinfo.StatusNone},
+		{Name: "tags", Value: "team=platform"},
+	}, report)
+}
+
+func TestDescribeBucket_Failure(t *testing.T) {
+	mockS3 := new(internalawstypes.MockS3Client)
+	mockDescribeBucket(mockS3, constants.ErrInjected)
+	mockS3.GetBucketTaggingAPI = func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+		return nil, &smithy.GenericAPIError{Code: "NoSuchTagSet"}
+	}
+
+	report, errs := DescribeBucket(mockS3, options.GetMockedRootOptions())
+	// replication is reported as not configured by the mock and the tag set is missing, the rest fails
+	assert.Len(t, errs, len(report)-2)
+	assert.Equal(t, bucketinfo.Setting{Name: "region", Value: bucketinfo.StatusUnknown}, report[0])
+	assert.Equal(t, bucketinfo.Setting{Name: "tags", Value: bucketinfo.StatusNone}, report[len(report)-1])
+}
+
+func TestListBucketNames(t *testing.T) {
+	mockS3 := new(internalawstypes.MockS3Client)
+	mockS3.ListBucketsAPI = func(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+		return &s3.ListBucketsOutput{Buckets: []types.Bucket{{Name: aws.String("foo")}, {Name: aws.String("bar")}}}, nil
+	}
+
+	names, err := ListBucketNames(mockS3)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"foo", "bar"}, names)
+
+	mockS3.ListBucketsAPI = func(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+		return nil, constants.ErrInjected
+	}
+
+	_, err = ListBucketNames(mockS3)
+	assert.NotNil(t, err)
+}
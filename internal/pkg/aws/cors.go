@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	corsoptions "github.com/bilalcaliskan/s3-manager/cmd/cors/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/cors"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/rs/zerolog"
+)
+
+// GetCorsConfiguration retrieves the CORS configuration of an S3 bucket.
+//
+// It accepts an S3API interface and CorsOptions as arguments, and returns the parsed Configuration and any error
+// encountered. A bucket without any CORS configuration is reported with an empty Configuration instead of an error.
+func GetCorsConfiguration(svc internalawstypes.S3ClientAPI, opts *corsoptions.CorsOptions) (*cors.Configuration, error) {
+	res, err := svc.GetBucketCors(context.Background(), &s3.GetBucketCorsInput{
+		Bucket: aws.String(opts.BucketName),
+	})
+
+	if err != nil {
+		if isErrorCode(err, "NoSuchCORSConfiguration") {
+			return cors.FromSDK(nil), nil
+		}
+
+		return nil, err
+	}
+
+	return cors.FromSDK(res.CORSRules), nil
+}
+
+// SetBucketCors replaces the CORS configuration of an S3 bucket with the Configuration of CorsOptions.
+//
+// It accepts an S3API interface, CorsOptions, a PromptRunner, and a Logger as arguments.
+// The Configuration is validated before anything else so that an invalid configuration is reported even with
+// the 'DryRun' option. If the provided 'DryRun' option is set, the function will return early, if 'AutoApprove'
+// is not set it asks for approval before putting the configuration.
+func SetBucketCors(svc internalawstypes.S3ClientAPI, opts *corsoptions.CorsOptions, runner prompt.PromptRunner, logger zerolog.Logger) (res *s3.PutBucketCorsOutput, err error) {
+	if err := opts.Configuration.Validate(); err != nil {
+		return res, err
+	}
+
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return res, nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return res, err
+		}
+	}
+
+	return svc.PutBucketCors(context.Background(), &s3.PutBucketCorsInput{
+		Bucket:            aws.String(opts.BucketName),
+		CORSConfiguration: opts.Configuration.ToSDK(),
+	})
+}
+
+// DeleteBucketCors removes the whole CORS configuration of an S3 bucket.
+//
+// It accepts an S3API interface, CorsOptions, a PromptRunner, and a Logger as arguments, and follows the same
+// 'DryRun' and 'AutoApprove' semantics with SetBucketCors.
+func DeleteBucketCors(svc internalawstypes.S3ClientAPI, opts *corsoptions.CorsOptions, runner prompt.PromptRunner, logger zerolog.Logger) (res *s3.DeleteBucketCorsOutput, err error) {
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return res, nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return res, err
+		}
+	}
+
+	return svc.DeleteBucketCors(context.Background(), &s3.DeleteBucketCorsInput{
+		Bucket: aws.String(opts.BucketName),
+	})
+}
@@ -0,0 +1,225 @@
+//go:build unit
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	corsoptions "github.com/bilalcaliskan/s3-manager/cmd/cors/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/cors"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/logging"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+var validCorsConfiguration = &cors.Configuration{
+	CORSRules: []cors.Rule{
+		{
+			ID:             "frontend",
+			AllowedMethods: []string{"GET"},
+			AllowedOrigins: []string{"*"},
+		},
+	},
+}
+
+func TestGetCorsConfiguration(t *testing.T) {
+	cases := []struct {
+		caseName          string
+		expected          error
+		rules             int
+		getBucketCorsFunc func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error)
+	}{
+		{
+			"Success",
+			nil,
+			1,
+			func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+				return &s3.GetBucketCorsOutput{
+					CORSRules: []types.CORSRule{{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}}},
+				}, nil
+			},
+		},
+		{
+			"Success when not configured",
+			nil,
+			0,
+			func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "NoSuchCORSConfiguration"}
+			},
+		},
+		{
+			"Failure",
+			constants.ErrInjected,
+			0,
+			func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketCorsAPI = tc.getBucketCorsFunc
+
+		cfg, err := GetCorsConfiguration(mockS3, &corsoptions.CorsOptions{RootOptions: options.GetMockedRootOptions()})
+		assert.Equal(t, tc.expected, err)
+		if err == nil {
+			assert.Len(t, cfg.CORSRules, tc.rules)
+		}
+	}
+}
+
+func TestSetBucketCors(t *testing.T) {
+	cases := []struct {
+		caseName          string
+		shouldPass        bool
+		cfg               *cors.Configuration
+		putBucketCorsFunc func(ctx context.Context, params *s3.PutBucketCorsInput, optFns ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			true,
+			validCorsConfiguration,
+			func(ctx context.Context, params *s3.PutBucketCorsInput, optFns ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error) {
+				return &s3.PutBucketCorsOutput{}, nil
+			},
+			prompt.PromptMock{Msg: "y"},
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			true,
+			validCorsConfiguration,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by invalid configuration",
+			false,
+			&cors.Configuration{},
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by put error",
+			false,
+			validCorsConfiguration,
+			func(ctx context.Context, params *s3.PutBucketCorsInput, optFns ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by prompt error",
+			false,
+			validCorsConfiguration,
+			nil,
+			prompt.PromptMock{Msg: "n", Err: constants.ErrInjected},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.PutBucketCorsAPI = tc.putBucketCorsFunc
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		_, err := SetBucketCors(mockS3, &corsoptions.CorsOptions{Configuration: tc.cfg, RootOptions: rootOpts}, tc.PromptRunner, logging.GetLogger(rootOpts))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestDeleteBucketCors(t *testing.T) {
+	cases := []struct {
+		caseName             string
+		shouldPass           bool
+		deleteBucketCorsFunc func(ctx context.Context, params *s3.DeleteBucketCorsInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketCorsOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			true,
+			func(ctx context.Context, params *s3.DeleteBucketCorsInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketCorsOutput, error) {
+				return &s3.DeleteBucketCorsOutput{}, nil
+			},
+			prompt.PromptMock{Msg: "y"},
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			true,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by delete error",
+			false,
+			func(ctx context.Context, params *s3.DeleteBucketCorsInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketCorsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by prompt error",
+			false,
+			nil,
+			prompt.PromptMock{Msg: "n", Err: constants.ErrInjected},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.DeleteBucketCorsAPI = tc.deleteBucketCorsFunc
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		_, err := DeleteBucketCors(mockS3, &corsoptions.CorsOptions{RootOptions: rootOpts}, tc.PromptRunner, logging.GetLogger(rootOpts))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
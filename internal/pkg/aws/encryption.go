@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	encryptionoptions "github.com/bilalcaliskan/s3-manager/cmd/encryption/options"
+	encryptionutils "github.com/bilalcaliskan/s3-manager/cmd/encryption/utils"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/rs/zerolog"
+)
+
+// GetBucketEncryption retrieves the default encryption configuration of an S3 bucket.
+//
+// It accepts an S3API interface and EncryptionOptions as arguments, and returns a GetBucketEncryptionOutput and
+// any error encountered. A bucket without any default encryption configuration is reported with an empty
+// GetBucketEncryptionOutput instead of an error.
+func GetBucketEncryption(svc internalawstypes.S3ClientAPI, opts *encryptionoptions.EncryptionOptions) (res *s3.GetBucketEncryptionOutput, err error) {
+	res, err = svc.GetBucketEncryption(context.Background(), &s3.GetBucketEncryptionInput{
+		Bucket: aws.String(opts.BucketName),
+	})
+
+	if err != nil && isErrorCode(err, "ServerSideEncryptionConfigurationNotFoundError") {
+		return &s3.GetBucketEncryptionOutput{}, nil
+	}
+
+	return res, err
+}
+
+// SetBucketEncryption sets the default encryption configuration of an S3 bucket.
+//
+// It accepts an S3API interface, EncryptionOptions, a PromptRunner, and a Logger as arguments.
+// If the provided 'DryRun' option is set, the function will return early, if 'AutoApprove' is not set it asks for
+// approval. It then compares the actual state with the desired state and skips the configuration with a warning
+// if they already match, otherwise it puts the desired default encryption rule onto the bucket.
+// It logs any errors encountered and returns them.
+func SetBucketEncryption(svc internalawstypes.S3ClientAPI, opts *encryptionoptions.EncryptionOptions, runner prompt.PromptRunner, logger zerolog.Logger) error {
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return err
+		}
+	}
+
+	res, err := GetBucketEncryption(svc, opts)
+	if err != nil {
+		logger.Error().Msg(err.Error())
+		return err
+	}
+
+	if err := encryptionutils.DecideActualState(res, opts); err != nil {
+		logger.Error().Msg(err.Error())
+		return err
+	}
+
+	logger.Info().Msgf(encryptionutils.InfCurrentState, encryptionutils.FormatState(opts.ActualState))
+	if opts.ActualState == opts.DesiredState {
+		logger.Warn().
+			Str("state", encryptionutils.FormatState(opts.ActualState)).
+			Msg(encryptionutils.WarnDesiredState)
+		return nil
+	}
+
+	logger.Info().Msgf(encryptionutils.InfSettingEncryption, encryptionutils.FormatState(opts.DesiredState))
+
+	rule := types.ServerSideEncryptionRule{
+		ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{
+			SSEAlgorithm: types.ServerSideEncryption(opts.DesiredState.Algorithm),
+		},
+		BucketKeyEnabled: aws.Bool(opts.DesiredState.BucketKeyEnabled),
+	}
+
+	if opts.DesiredState.KmsKeyID != "" {
+		rule.ApplyServerSideEncryptionByDefault.KMSMasterKeyID = aws.String(opts.DesiredState.KmsKeyID)
+	}
+
+	if _, err := svc.PutBucketEncryption(context.Background(), &s3.PutBucketEncryptionInput{
+		Bucket: aws.String(opts.BucketName),
+		ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+			Rules: []types.ServerSideEncryptionRule{rule},
+		},
+	}); err != nil {
+		logger.Error().Msg(err.Error())
+		return err
+	}
+
+	logger.Info().Msgf(encryptionutils.InfSuccess, encryptionutils.FormatState(opts.DesiredState))
+
+	return nil
+}
+
+// DeleteBucketEncryption removes the default encryption configuration of an S3 bucket, which resets the bucket
+// to the default SSE-S3 encryption applied by AWS.
+//
+// It accepts an S3API interface, EncryptionOptions, a PromptRunner, and a Logger as arguments,
+// and returns a DeleteBucketEncryptionOutput and any error encountered.
+func DeleteBucketEncryption(svc internalawstypes.S3ClientAPI, opts *encryptionoptions.EncryptionOptions, runner prompt.PromptRunner, logger zerolog.Logger) (res *s3.DeleteBucketEncryptionOutput, err error) {
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return res, nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return res, err
+		}
+	}
+
+	return svc.DeleteBucketEncryption(context.Background(), &s3.DeleteBucketEncryptionInput{
+		Bucket: aws.String(opts.BucketName),
+	})
+}
@@ -0,0 +1,234 @@
+//go:build unit
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	encryptionoptions "github.com/bilalcaliskan/s3-manager/cmd/encryption/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/logging"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func getBucketEncryptionOutput(algorithm types.ServerSideEncryption, kmsKeyID *string) *s3.GetBucketEncryptionOutput {
+	return &s3.GetBucketEncryptionOutput{
+		ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+			Rules: []types.ServerSideEncryptionRule{
+				{
+					ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{
+						SSEAlgorithm:   algorithm,
+						KMSMasterKeyID: kmsKeyID,
+					},
+				},
+			},
+		},
+	}
+}
+
+// TestGetBucketEncryption is a test function that tests the behavior of the GetBucketEncryption function.
+//
+// It verifies that a missing default encryption configuration is reported as an empty output instead of an error.
+func TestGetBucketEncryption(t *testing.T) {
+	cases := []struct {
+		caseName                string
+		expected                error
+		getBucketEncryptionFunc func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error)
+	}{
+		{
+			"Success",
+			nil,
+			func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+				return getBucketEncryptionOutput(types.ServerSideEncryptionAes256, nil), nil
+			},
+		},
+		{
+			"Success when not configured",
+			nil,
+			func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "ServerSideEncryptionConfigurationNotFoundError"}
+			},
+		},
+		{
+			"Failure",
+			constants.ErrInjected,
+			func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketEncryptionAPI = tc.getBucketEncryptionFunc
+
+		res, err := GetBucketEncryption(mockS3, &encryptionoptions.EncryptionOptions{RootOptions: options.GetMockedRootOptions()})
+		assert.Equal(t, tc.expected, err)
+		if err == nil {
+			assert.NotNil(t, res)
+		}
+	}
+}
+
+// TestSetBucketEncryption is a test function that tests the behavior of the SetBucketEncryption function.
+//
+// It verifies the dry-run, approval and desired state handling, and the rule sent to PutBucketEncryption.
+func TestSetBucketEncryption(t *testing.T) {
+	cases := []struct {
+		caseName     string
+		expected     error
+		desiredState encryptionoptions.State
+		actual       *s3.GetBucketEncryptionOutput
+		getErr       error
+		putErr       error
+		shouldPut    bool
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success sse-kms with key",
+			nil,
+			encryptionoptions.State{Algorithm: "aws:kms", KmsKeyID: "thisiskmskeyid", BucketKeyEnabled: true},
+			getBucketEncryptionOutput(types.ServerSideEncryptionAes256, nil),
+			nil,
+			nil,
+			true,
+			prompt.PromptMock{Msg: "y"},
+			false,
+			false,
+		},
+		{
+			"Success already at desired state",
+			nil,
+			encryptionoptions.State{Algorithm: "aws:kms", KmsKeyID: "thisiskmskeyid"},
+			getBucketEncryptionOutput(types.ServerSideEncryptionAwsKms, aws.String("thisiskmskeyid")),
+			nil,
+			nil,
+			false,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success when dry-run enabled",
+			nil,
+			encryptionoptions.State{Algorithm: "AES256"},
+			nil,
+			nil,
+			nil,
+			false,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by get error",
+			constants.ErrInjected,
+			encryptionoptions.State{Algorithm: "AES256"},
+			nil,
+			constants.ErrInjected,
+			nil,
+			false,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by put error",
+			constants.ErrInjected,
+			encryptionoptions.State{Algorithm: "AES256"},
+			getBucketEncryptionOutput(types.ServerSideEncryptionAwsKms, nil),
+			nil,
+			constants.ErrInjected,
+			true,
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by user terminated the process",
+			constants.ErrUserTerminated,
+			encryptionoptions.State{Algorithm: "AES256"},
+			nil,
+			nil,
+			nil,
+			false,
+			prompt.PromptMock{Msg: "n", Err: constants.ErrInjected},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+		opts := &encryptionoptions.EncryptionOptions{DesiredState: tc.desiredState, RootOptions: rootOpts}
+
+		var put *s3.PutBucketEncryptionInput
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketEncryptionAPI = func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+			return tc.actual, tc.getErr
+		}
+		mockS3.PutBucketEncryptionAPI = func(ctx context.Context, params *s3.PutBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error) {
+			put = params
+			return &s3.PutBucketEncryptionOutput{}, tc.putErr
+		}
+
+		err := SetBucketEncryption(mockS3, opts, tc.PromptRunner, logging.GetLogger(rootOpts))
+		assert.Equal(t, tc.expected, err)
+		assert.Equal(t, tc.shouldPut, put != nil)
+
+		if put != nil && tc.putErr == nil {
+			rule := put.ServerSideEncryptionConfiguration.Rules[0]
+			assert.Equal(t, tc.desiredState.Algorithm, string(rule.ApplyServerSideEncryptionByDefault.SSEAlgorithm))
+			assert.Equal(t, tc.desiredState.KmsKeyID, aws.ToString(rule.ApplyServerSideEncryptionByDefault.KMSMasterKeyID))
+			assert.Equal(t, tc.desiredState.BucketKeyEnabled, aws.ToBool(rule.BucketKeyEnabled))
+		}
+	}
+}
+
+// TestDeleteBucketEncryption is a test function that tests the behavior of the DeleteBucketEncryption function.
+func TestDeleteBucketEncryption(t *testing.T) {
+	cases := []struct {
+		caseName string
+		expected error
+		deleteFn func(ctx context.Context, params *s3.DeleteBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketEncryptionOutput, error)
+		prompt.PromptRunner
+		dryRun bool
+	}{
+		{"Success", nil, internalawstypes.DefaultDeleteBucketEncryptionFunc, prompt.PromptMock{Msg: "y"}, false},
+		{"Success when dry-run enabled", nil, nil, nil, true},
+		{"Failure caused by delete error", constants.ErrInjected,
+			func(ctx context.Context, params *s3.DeleteBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketEncryptionOutput, error) {
+				return nil, constants.ErrInjected
+			}, prompt.PromptMock{Msg: "y"}, false},
+		{"Failure caused by prompt error", constants.ErrInvalidInput, nil, prompt.PromptMock{Msg: "asdf", Err: constants.ErrInjected}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.DeleteBucketEncryptionAPI = tc.deleteFn
+
+		_, err := DeleteBucketEncryption(mockS3, &encryptionoptions.EncryptionOptions{RootOptions: rootOpts}, tc.PromptRunner, logging.GetLogger(rootOpts))
+		assert.Equal(t, tc.expected, err)
+	}
+}
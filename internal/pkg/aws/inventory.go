@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	inventoryoptions "github.com/bilalcaliskan/s3-manager/cmd/inventory/options"
+	inventoryutils "github.com/bilalcaliskan/s3-manager/cmd/inventory/utils"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/rs/zerolog"
+)
+
+// ListInventoryConfigurations retrieves all the inventory configurations of an S3 bucket, following the
+// continuation tokens.
+func ListInventoryConfigurations(svc internalawstypes.S3ClientAPI, opts *inventoryoptions.InventoryOptions) ([]types.InventoryConfiguration, error) {
+	var (
+		configurations []types.InventoryConfiguration
+		continuation   *string
+	)
+
+	for {
+		res, err := svc.ListBucketInventoryConfigurations(context.Background(), &s3.ListBucketInventoryConfigurationsInput{
+			Bucket:            aws.String(opts.BucketName),
+			ContinuationToken: continuation,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		configurations = append(configurations, res.InventoryConfigurationList...)
+		if !aws.ToBool(res.IsTruncated) {
+			break
+		}
+
+		continuation = res.NextContinuationToken
+	}
+
+	return configurations, nil
+}
+
+// GetInventoryConfiguration retrieves the inventory configuration of an S3 bucket with the 'ID' of
+// InventoryOptions. A missing inventory configuration is reported as nil instead of an error.
+func GetInventoryConfiguration(svc internalawstypes.S3ClientAPI, opts *inventoryoptions.InventoryOptions) (*types.InventoryConfiguration, error) {
+	res, err := svc.GetBucketInventoryConfiguration(context.Background(), &s3.GetBucketInventoryConfigurationInput{
+		Bucket: aws.String(opts.BucketName),
+		Id:     aws.String(opts.ID),
+	})
+
+	if err != nil {
+		if isErrorCode(err, "NoSuchConfiguration") {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return res.InventoryConfiguration, nil
+}
+
+// SetInventoryConfiguration creates or replaces the inventory configuration of an S3 bucket with the 'ID' of
+// InventoryOptions.
+//
+// It accepts an S3API interface, InventoryOptions, a PromptRunner, and a Logger as arguments.
+// The desired configuration is validated before anything else, then the 'DryRun' and 'AutoApprove' options are
+// handled just like SetBucketPolicy before putting the configuration.
+func SetInventoryConfiguration(svc internalawstypes.S3ClientAPI, opts *inventoryoptions.InventoryOptions, runner prompt.PromptRunner, logger zerolog.Logger) (res *s3.PutBucketInventoryConfigurationOutput, err error) {
+	if err := inventoryutils.Validate(opts); err != nil {
+		return res, err
+	}
+
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return res, nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return res, err
+		}
+	}
+
+	return svc.PutBucketInventoryConfiguration(context.Background(), &s3.PutBucketInventoryConfigurationInput{
+		Bucket:                 aws.String(opts.BucketName),
+		Id:                     aws.String(opts.ID),
+		InventoryConfiguration: inventoryutils.ToConfiguration(opts),
+	})
+}
+
+// DeleteInventoryConfiguration removes the inventory configuration of an S3 bucket with the 'ID' of
+// InventoryOptions.
+//
+// It accepts an S3API interface, InventoryOptions, a PromptRunner, and a Logger as arguments, and follows the
+// same 'DryRun' and 'AutoApprove' semantics with SetInventoryConfiguration.
+func DeleteInventoryConfiguration(svc internalawstypes.S3ClientAPI, opts *inventoryoptions.InventoryOptions, runner prompt.PromptRunner, logger zerolog.Logger) (res *s3.DeleteBucketInventoryConfigurationOutput, err error) {
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return res, nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return res, err
+		}
+	}
+
+	return svc.DeleteBucketInventoryConfiguration(context.Background(), &s3.DeleteBucketInventoryConfigurationInput{
+		Bucket: aws.String(opts.BucketName),
+		Id:     aws.String(opts.ID),
+	})
+}
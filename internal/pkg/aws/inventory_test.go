@@ -0,0 +1,278 @@
+//go:build unit
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	inventoryoptions "github.com/bilalcaliskan/s3-manager/cmd/inventory/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/logging"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func getInventoryOptions(rootOpts *options.RootOptions) *inventoryoptions.InventoryOptions {
+	return &inventoryoptions.InventoryOptions{
+		ID:                "daily",
+		DestinationBucket: "thevpnbeast-inventory",
+		Format:            "CSV",
+		Schedule:          "Daily",
+		IncludedVersions:  "Current",
+		RootOptions:       rootOpts,
+	}
+}
+
+func TestListInventoryConfigurations(t *testing.T) {
+	cases := []struct {
+		caseName                              string
+		shouldPass                            bool
+		expected                              int
+		listBucketInventoryConfigurationsFunc func(ctx context.Context, params *s3.ListBucketInventoryConfigurationsInput, optFns ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error)
+	}{
+		{
+			"Success with pagination",
+			true,
+			3,
+			func(ctx context.Context, params *s3.ListBucketInventoryConfigurationsInput, optFns ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+				if params.ContinuationToken == nil {
+					return &s3.ListBucketInventoryConfigurationsOutput{
+						InventoryConfigurationList: []types.InventoryConfiguration{{Id: aws.String("1")}, {Id: aws.String("2")}},
+						IsTruncated:                aws.Bool(true),
+						NextContinuationToken:      aws.String("next"),
+					}, nil
+				}
+
+				return &s3.ListBucketInventoryConfigurationsOutput{
+					InventoryConfigurationList: []types.InventoryConfiguration{{Id: aws.String("3")}},
+					IsTruncated:                aws.Bool(false),
+				}, nil
+			},
+		},
+		{
+			"Failure",
+			false,
+			0,
+			func(ctx context.Context, params *s3.ListBucketInventoryConfigurationsInput, optFns ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListBucketInventoryConfigurationsAPI = tc.listBucketInventoryConfigurationsFunc
+
+		res, err := ListInventoryConfigurations(mockS3, getInventoryOptions(options.GetMockedRootOptions()))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		assert.Len(t, res, tc.expected)
+	}
+}
+
+func TestGetInventoryConfiguration(t *testing.T) {
+	cases := []struct {
+		caseName                            string
+		expected                            error
+		found                               bool
+		getBucketInventoryConfigurationFunc func(ctx context.Context, params *s3.GetBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketInventoryConfigurationOutput, error)
+	}{
+		{
+			"Success",
+			nil,
+			true,
+			func(ctx context.Context, params *s3.GetBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketInventoryConfigurationOutput, error) {
+				return &s3.GetBucketInventoryConfigurationOutput{InventoryConfiguration: &types.InventoryConfiguration{Id: params.Id}}, nil
+			},
+		},
+		{
+			"Success when not found",
+			nil,
+			false,
+			func(ctx context.Context, params *s3.GetBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketInventoryConfigurationOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "NoSuchConfiguration"}
+			},
+		},
+		{
+			"Failure",
+			constants.ErrInjected,
+			false,
+			func(ctx context.Context, params *s3.GetBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketInventoryConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketInventoryConfigurationAPI = tc.getBucketInventoryConfigurationFunc
+
+		res, err := GetInventoryConfiguration(mockS3, getInventoryOptions(options.GetMockedRootOptions()))
+		assert.Equal(t, tc.expected, err)
+		assert.Equal(t, tc.found, res != nil)
+	}
+}
+
+func TestSetInventoryConfiguration(t *testing.T) {
+	cases := []struct {
+		caseName                            string
+		shouldPass                          bool
+		format                              string
+		putBucketInventoryConfigurationFunc func(ctx context.Context, params *s3.PutBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			true,
+			"CSV",
+			func(ctx context.Context, params *s3.PutBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error) {
+				return &s3.PutBucketInventoryConfigurationOutput{}, nil
+			},
+			prompt.PromptMock{Msg: "y"},
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			true,
+			"Parquet",
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by invalid format",
+			false,
+			"JSON",
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by put error",
+			false,
+			"ORC",
+			func(ctx context.Context, params *s3.PutBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by prompt error",
+			false,
+			"CSV",
+			nil,
+			prompt.PromptMock{Msg: "n", Err: constants.ErrInjected},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.PutBucketInventoryConfigurationAPI = tc.putBucketInventoryConfigurationFunc
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		opts := getInventoryOptions(rootOpts)
+		opts.Format = tc.format
+
+		_, err := SetInventoryConfiguration(mockS3, opts, tc.PromptRunner, logging.GetLogger(rootOpts))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestDeleteInventoryConfiguration(t *testing.T) {
+	cases := []struct {
+		caseName                               string
+		shouldPass                             bool
+		deleteBucketInventoryConfigurationFunc func(ctx context.Context, params *s3.DeleteBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			true,
+			func(ctx context.Context, params *s3.DeleteBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error) {
+				return &s3.DeleteBucketInventoryConfigurationOutput{}, nil
+			},
+			prompt.PromptMock{Msg: "y"},
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			true,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by delete error",
+			false,
+			func(ctx context.Context, params *s3.DeleteBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by prompt error",
+			false,
+			nil,
+			prompt.PromptMock{Msg: "n", Err: constants.ErrInjected},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.DeleteBucketInventoryConfigurationAPI = tc.deleteBucketInventoryConfigurationFunc
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		_, err := DeleteInventoryConfiguration(mockS3, getInventoryOptions(rootOpts), tc.PromptRunner, logging.GetLogger(rootOpts))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
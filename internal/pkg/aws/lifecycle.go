@@ -0,0 +1,32 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/lifecycle"
+)
+
+// GetLifecycleConfiguration retrieves the lifecycle configuration of an S3 bucket.
+//
+// It accepts an S3API interface and RootOptions as arguments, and returns the parsed Configuration and any error
+// encountered. A bucket without lifecycle rules is reported with an empty Configuration instead of an error, a bucket
+// with a rule that the Configuration can not express is reported with an error.
+func GetLifecycleConfiguration(svc internalawstypes.S3ClientAPI, opts *options.RootOptions) (*lifecycle.Configuration, error) {
+	res, err := svc.GetBucketLifecycleConfiguration(context.Background(), &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(opts.BucketName),
+	})
+
+	if err != nil {
+		if isErrorCode(err, "NoSuchLifecycleConfiguration") {
+			return &lifecycle.Configuration{Rules: []lifecycle.Rule{}}, nil
+		}
+
+		return nil, err
+	}
+
+	return lifecycle.FromSDK(res.Rules)
+}
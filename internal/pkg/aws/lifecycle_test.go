@@ -0,0 +1,82 @@
+//go:build unit
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetLifecycleConfiguration(t *testing.T) {
+	cases := []struct {
+		caseName                            string
+		expected                            error
+		rules                               int
+		getBucketLifecycleConfigurationFunc func(ctx context.Context, params *s3.GetBucketLifecycleConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error)
+	}{
+		{
+			"Success",
+			nil,
+			1,
+			func(ctx context.Context, params *s3.GetBucketLifecycleConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+				return &s3.GetBucketLifecycleConfigurationOutput{Rules: []types.LifecycleRule{{
+					ID:         aws.String("logs"),
+					Status:     types.ExpirationStatusEnabled,
+					Filter:     &types.LifecycleRuleFilterMemberPrefix{Value: "logs/"},
+					Expiration: &types.LifecycleExpiration{Days: aws.Int32(90)},
+				}}}, nil
+			},
+		},
+		{
+			"Success when not configured",
+			nil,
+			0,
+			func(ctx context.Context, params *s3.GetBucketLifecycleConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "NoSuchLifecycleConfiguration"}
+			},
+		},
+		{
+			"Failure",
+			constants.ErrInjected,
+			0,
+			func(ctx context.Context, params *s3.GetBucketLifecycleConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketLifecycleConfigurationAPI = tc.getBucketLifecycleConfigurationFunc
+
+		cfg, err := GetLifecycleConfiguration(mockS3, options.GetMockedRootOptions())
+		assert.Equal(t, tc.expected, err)
+		if err == nil {
+			assert.Len(t, cfg.Rules, tc.rules)
+		}
+	}
+	// a rule that the configuration can not express is reported instead of being widened to the whole bucket
+	mockS3 := new(internalawstypes.MockS3Client)
+	mockS3.GetBucketLifecycleConfigurationAPI = func(ctx context.Context, params *s3.GetBucketLifecycleConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+		return &s3.GetBucketLifecycleConfigurationOutput{Rules: []types.LifecycleRule{{
+			ID:         aws.String("unknown"),
+			Status:     types.ExpirationStatusEnabled,
+			Filter:     &types.UnknownUnionMember{Tag: "Foo"},
+			Expiration: &types.LifecycleExpiration{Days: aws.Int32(90)},
+		}}}, nil
+	}
+
+	_, err := GetLifecycleConfiguration(mockS3, options.GetMockedRootOptions())
+	assert.NotNil(t, err)
+}
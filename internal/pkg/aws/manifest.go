@@ -0,0 +1,219 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	applyoptions "github.com/bilalcaliskan/s3-manager/cmd/apply/options"
+	bucketpolicyoptions "github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/options"
+	encryptionoptions "github.com/bilalcaliskan/s3-manager/cmd/encryption/options"
+	encryptionutils "github.com/bilalcaliskan/s3-manager/cmd/encryption/utils"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	tagoptions "github.com/bilalcaliskan/s3-manager/cmd/tags/options"
+	taoptions "github.com/bilalcaliskan/s3-manager/cmd/transferacceleration/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/manifest"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// GetManifest builds the Manifest of the current state of an S3 bucket with the existing getters.
+//
+// It accepts an S3API interface and RootOptions as arguments, and returns the Manifest and the first error
+// encountered. The settings that are not configured on the bucket are left empty in the Manifest.
+func GetManifest(svc internalawstypes.S3ClientAPI, opts *options.RootOptions) (*manifest.Manifest, error) {
+	m := &manifest.Manifest{}
+
+	tags, err := GetBucketTags(svc, &tagoptions.TagOptions{RootOptions: opts})
+	if err != nil {
+		return nil, errors.Wrap(err, "an error occurred while getting bucket tags")
+	}
+
+	if len(tags.TagSet) > 0 {
+		m.Tags = make(map[string]string, len(tags.TagSet))
+		for _, tag := range tags.TagSet {
+			m.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+	}
+
+	versioning, err := GetBucketVersioning(svc, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "an error occurred while getting bucket versioning")
+	}
+
+	m.Versioning = string(versioning.Status)
+
+	acceleration, err := GetTransferAcceleration(svc, &taoptions.TransferAccelerationOptions{RootOptions: opts})
+	if err != nil {
+		return nil, errors.Wrap(err, "an error occurred while getting transfer acceleration")
+	}
+
+	m.TransferAcceleration = string(acceleration.Status)
+
+	policy, err := GetBucketPolicy(svc, &bucketpolicyoptions.BucketPolicyOptions{RootOptions: opts})
+	if err != nil && !isErrorCode(err, "NoSuchBucketPolicy") {
+		return nil, errors.Wrap(err, "an error occurred while getting bucket policy")
+	}
+
+	if err == nil && aws.ToString(policy.Policy) != "" {
+		if err := json.Unmarshal([]byte(aws.ToString(policy.Policy)), &m.Policy); err != nil {
+			return nil, errors.Wrap(err, "an error occurred while parsing bucket policy")
+		}
+	}
+
+	lifecycleCfg, err := GetLifecycleConfiguration(svc, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "an error occurred while getting lifecycle configuration")
+	}
+
+	if len(lifecycleCfg.Rules) > 0 {
+		m.Lifecycle = lifecycleCfg
+	}
+
+	encryptionOpts := &encryptionoptions.EncryptionOptions{RootOptions: opts}
+	encryption, err := GetBucketEncryption(svc, encryptionOpts)
+	if err != nil {
+		return nil, errors.Wrap(err, "an error occurred while getting bucket encryption")
+	}
+
+	if err := encryptionutils.DecideActualState(encryption, encryptionOpts); err != nil {
+		return nil, err
+	}
+
+	if encryptionOpts.ActualState.Algorithm != "" {
+		m.Encryption = &manifest.Encryption{
+			Algorithm:        encryptionOpts.ActualState.Algorithm,
+			KmsKeyID:         encryptionOpts.ActualState.KmsKeyID,
+			BucketKeyEnabled: encryptionOpts.ActualState.BucketKeyEnabled,
+		}
+	}
+
+	return m, nil
+}
+
+// ApplyManifest applies the given changes on an S3 bucket, taking the desired values from the Manifest of
+// ApplyOptions. The changes are expected to be computed with manifest.Diff so that only the differences are applied.
+//
+// It accepts an S3API interface, ApplyOptions, the changes, a PromptRunner, and a Logger as arguments.
+// The Manifest is validated before anything else, then the 'DryRun' and 'AutoApprove' options are handled just
+// like SetBucketPolicy. A single approval covers all the changes. The changes are applied one by one and the
+// function stops at the first failure, the changes that are already applied are not rolled back.
+func ApplyManifest(svc internalawstypes.S3ClientAPI, opts *applyoptions.ApplyOptions, changes []manifest.Change, runner prompt.PromptRunner, logger zerolog.Logger) error {
+	if err := opts.Manifest.Validate(); err != nil {
+		return err
+	}
+
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return err
+		}
+	}
+
+	for _, change := range changes {
+		if err := applyChange(svc, opts, change); err != nil {
+			return errors.Wrapf(err, "an error occurred while applying %s", change.Setting)
+		}
+
+		logger.Info().Str("setting", change.Setting).Str("action", change.Action).Msg("applied change")
+	}
+
+	return nil
+}
+
+func applyChange(svc internalawstypes.S3ClientAPI, opts *applyoptions.ApplyOptions, change manifest.Change) (err error) {
+	bucket := aws.String(opts.BucketName)
+	desired := opts.Manifest
+
+	switch change.Setting {
+	case manifest.SettingTags:
+		if change.Action == manifest.ActionRemove {
+			_, err = svc.DeleteBucketTagging(context.Background(), &s3.DeleteBucketTaggingInput{Bucket: bucket})
+			return err
+		}
+
+		keys := make([]string, 0, len(desired.Tags))
+		for key := range desired.Tags {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		tagSet := make([]types.Tag, 0, len(keys))
+		for _, key := range keys {
+			tagSet = append(tagSet, types.Tag{Key: aws.String(key), Value: aws.String(desired.Tags[key])})
+		}
+
+		_, err = svc.PutBucketTagging(context.Background(), &s3.PutBucketTaggingInput{
+			Bucket:  bucket,
+			Tagging: &types.Tagging{TagSet: tagSet},
+		})
+	case manifest.SettingVersioning:
+		_, err = svc.PutBucketVersioning(context.Background(), &s3.PutBucketVersioningInput{
+			Bucket:                  bucket,
+			VersioningConfiguration: &types.VersioningConfiguration{Status: types.BucketVersioningStatus(desired.Versioning)},
+		})
+	case manifest.SettingTransferAcceleration:
+		_, err = svc.PutBucketAccelerateConfiguration(context.Background(), &s3.PutBucketAccelerateConfigurationInput{
+			Bucket:                  bucket,
+			AccelerateConfiguration: &types.AccelerateConfiguration{Status: types.BucketAccelerateStatus(desired.TransferAcceleration)},
+		})
+	case manifest.SettingPolicy:
+		if change.Action == manifest.ActionRemove {
+			_, err = svc.DeleteBucketPolicy(context.Background(), &s3.DeleteBucketPolicyInput{Bucket: bucket})
+			return err
+		}
+
+		policy, err := desired.PolicyJSON()
+		if err != nil {
+			return err
+		}
+
+		_, err = svc.PutBucketPolicy(context.Background(), &s3.PutBucketPolicyInput{
+			Bucket: bucket,
+			Policy: aws.String(policy),
+		})
+
+		return err
+	case manifest.SettingLifecycle:
+		if change.Action == manifest.ActionRemove {
+			_, err = svc.DeleteBucketLifecycle(context.Background(), &s3.DeleteBucketLifecycleInput{Bucket: bucket})
+			return err
+		}
+
+		_, err = svc.PutBucketLifecycleConfiguration(context.Background(), &s3.PutBucketLifecycleConfigurationInput{
+			Bucket:                 bucket,
+			LifecycleConfiguration: desired.Lifecycle.ToSDK(),
+		})
+	case manifest.SettingEncryption:
+		rule := types.ServerSideEncryptionRule{
+			ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{
+				SSEAlgorithm: types.ServerSideEncryption(desired.Encryption.Algorithm),
+			},
+			BucketKeyEnabled: aws.Bool(desired.Encryption.BucketKeyEnabled),
+		}
+
+		if desired.Encryption.KmsKeyID != "" {
+			rule.ApplyServerSideEncryptionByDefault.KMSMasterKeyID = aws.String(desired.Encryption.KmsKeyID)
+		}
+
+		_, err = svc.PutBucketEncryption(context.Background(), &s3.PutBucketEncryptionInput{
+			Bucket: bucket,
+			ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+				Rules: []types.ServerSideEncryptionRule{rule},
+			},
+		})
+	}
+
+	return err
+}
@@ -0,0 +1,254 @@
+//go:build unit
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	applyoptions "github.com/bilalcaliskan/s3-manager/cmd/apply/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/lifecycle"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/logging"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/manifest"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockBucketState sets the getters that GetManifest calls to report a bucket that has all the settings of a
+// Manifest configured
+func mockBucketState(mockS3 *internalawstypes.MockS3Client) {
+	mockS3.GetBucketTaggingAPI = func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+		return &s3.GetBucketTaggingOutput{TagSet: []types.Tag{{Key: aws.String("team"), Value: aws.String("platform")}}}, nil
+	}
+	mockS3.GetBucketVersioningAPI = func(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
+		return &s3.GetBucketVersioningOutput{Status: types.BucketVersioningStatusEnabled}, nil
+	}
+	mockS3.GetBucketAccelerateConfigurationAPI = func(ctx context.Context, params *s3.GetBucketAccelerateConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketAccelerateConfigurationOutput, error) {
+		return &s3.GetBucketAccelerateConfigurationOutput{Status: types.BucketAccelerateStatusSuspended}, nil
+	}
+	mockS3.GetBucketPolicyAPI = func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+		return &s3.GetBucketPolicyOutput{Policy: aws.String(`{"Version":"2012-10-17","Statement":[]}`)}, nil
+	}
+	mockS3.GetBucketLifecycleConfigurationAPI = func(ctx context.Context, params *s3.GetBucketLifecycleConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+		return &s3.GetBucketLifecycleConfigurationOutput{Rules: []types.LifecycleRule{{
+			ID:         aws.String("logs"),
+			Status:     types.ExpirationStatusEnabled,
+			Filter:     &types.LifecycleRuleFilterMemberPrefix{Value: "logs/"},
+			Expiration: &types.LifecycleExpiration{Days: aws.Int32(90)},
+		}}}, nil
+	}
+	mockS3.GetBucketEncryptionAPI = func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+		return &s3.GetBucketEncryptionOutput{ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+			Rules: []types.ServerSideEncryptionRule{{
+				ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{SSEAlgorithm: types.ServerSideEncryptionAes256},
+			}},
+		}}, nil
+	}
+}
+
+func TestGetManifest(t *testing.T) {
+	mockS3 := new(internalawstypes.MockS3Client)
+	mockBucketState(mockS3)
+
+	m, err := GetManifest(mockS3, options.GetMockedRootOptions())
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "platform"}, m.Tags)
+	assert.Equal(t, "Enabled", m.Versioning)
+	assert.Equal(t, "Suspended", m.TransferAcceleration)
+	assert.Equal(t, "2012-10-17", m.Policy["Version"])
+	assert.Len(t, m.Lifecycle.Rules, 1)
+	assert.Equal(t, &manifest.Encryption{Algorithm: "AES256"}, m.Encryption)
+}
+
+func TestGetManifest_NotConfigured(t *testing.T) {
+	mockS3 := new(internalawstypes.MockS3Client)
+	mockBucketState(mockS3)
+	mockS3.GetBucketTaggingAPI = func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+		return nil, &smithy.GenericAPIError{Code: "NoSuchTagSet"}
+	}
+	mockS3.GetBucketVersioningAPI = internalawstypes.DefaultGetBucketVersioningFunc
+	mockS3.GetBucketAccelerateConfigurationAPI = func(ctx context.Context, params *s3.GetBucketAccelerateConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketAccelerateConfigurationOutput, error) {
+		return &s3.GetBucketAccelerateConfigurationOutput{}, nil
+	}
+	mockS3.GetBucketPolicyAPI = func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+		return nil, &smithy.GenericAPIError{Code: "NoSuchBucketPolicy"}
+	}
+	mockS3.GetBucketLifecycleConfigurationAPI = func(ctx context.Context, params *s3.GetBucketLifecycleConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+		return nil, &smithy.GenericAPIError{Code: "NoSuchLifecycleConfiguration"}
+	}
+	mockS3.GetBucketEncryptionAPI = func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+		return nil, &smithy.GenericAPIError{Code: "ServerSideEncryptionConfigurationNotFoundError"}
+	}
+
+	m, err := GetManifest(mockS3, options.GetMockedRootOptions())
+	assert.Nil(t, err)
+	assert.Equal(t, &manifest.Manifest{}, m)
+}
+
+func TestGetManifest_Failure(t *testing.T) {
+	cases := []struct {
+		caseName string
+		breakAPI func(mockS3 *internalawstypes.MockS3Client)
+	}{
+		{"Failure caused by tagging error", func(mockS3 *internalawstypes.MockS3Client) {
+			mockS3.GetBucketTaggingAPI = func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+				return nil, constants.ErrInjected
+			}
+		}},
+		{"Failure caused by versioning error", func(mockS3 *internalawstypes.MockS3Client) {
+			mockS3.GetBucketVersioningAPI = func(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
+				return nil, constants.ErrInjected
+			}
+		}},
+		{"Failure caused by transfer acceleration error", func(mockS3 *internalawstypes.MockS3Client) {
+			mockS3.GetBucketAccelerateConfigurationAPI = func(ctx context.Context, params *s3.GetBucketAccelerateConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketAccelerateConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			}
+		}},
+		{"Failure caused by policy error", func(mockS3 *internalawstypes.MockS3Client) {
+			mockS3.GetBucketPolicyAPI = func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return nil, constants.ErrInjected
+			}
+		}},
+		{"Failure caused by invalid policy", func(mockS3 *internalawstypes.MockS3Client) {
+			mockS3.GetBucketPolicyAPI = func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+				return &s3.GetBucketPolicyOutput{Policy: aws.String("{")}, nil
+			}
+		}},
+		{"Failure caused by lifecycle error", func(mockS3 *internalawstypes.MockS3Client) {
+			mockS3.GetBucketLifecycleConfigurationAPI = func(ctx context.Context, params *s3.GetBucketLifecycleConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			}
+		}},
+		{"Failure caused by encryption error", func(mockS3 *internalawstypes.MockS3Client) {
+			mockS3.GetBucketEncryptionAPI = func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+				return nil, constants.ErrInjected
+			}
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockBucketState(mockS3)
+		tc.breakAPI(mockS3)
+
+		_, err := GetManifest(mockS3, options.GetMockedRootOptions())
+		assert.NotNil(t, err)
+	}
+}
+
+func TestApplyManifest(t *testing.T) {
+	desired := &manifest.Manifest{
+		Tags:                 map[string]string{"team": "platform", "env": "prod"},
+		Versioning:           "Enabled",
+		TransferAcceleration: "Enabled",
+		Policy:               map[string]interface{}{"Version": "2012-10-17", "Statement": []interface{}{}},
+		Lifecycle: &lifecycle.Configuration{Rules: []lifecycle.Rule{{ID: "logs", Status: lifecycle.StatusEnabled,
+			Expiration: &lifecycle.Expiration{Days: 1}}}},
+		Encryption: &manifest.Encryption{Algorithm: "aws:kms", KmsKeyID: "alias/s3"},
+	}
+	allChanges := []manifest.Change{
+		{Setting: manifest.SettingTags, Action: manifest.ActionAdd},
+		{Setting: manifest.SettingVersioning, Action: manifest.ActionChange},
+		{Setting: manifest.SettingTransferAcceleration, Action: manifest.ActionAdd},
+		{Setting: manifest.SettingPolicy, Action: manifest.ActionChange},
+		{Setting: manifest.SettingLifecycle, Action: manifest.ActionAdd},
+		{Setting: manifest.SettingEncryption, Action: manifest.ActionChange},
+	}
+	removals := []manifest.Change{
+		{Setting: manifest.SettingTags, Action: manifest.ActionRemove},
+		{Setting: manifest.SettingPolicy, Action: manifest.ActionRemove},
+		{Setting: manifest.SettingLifecycle, Action: manifest.ActionRemove},
+	}
+
+	cases := []struct {
+		caseName   string
+		shouldPass bool
+		manifest   *manifest.Manifest
+		changes    []manifest.Change
+		putErr     error
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{"Success", true, desired, allChanges, nil, prompt.PromptMock{Msg: "y"}, false, false},
+		{"Success with removals", true, &manifest.Manifest{}, removals, nil, nil, false, true},
+		{"Success with dry run", true, desired, allChanges, nil, nil, true, false},
+		{"Failure caused by invalid manifest", false, &manifest.Manifest{Versioning: "Disabled"}, nil, nil, nil, true, false},
+		{"Failure caused by prompt error", false, desired, allChanges, nil, prompt.PromptMock{Msg: "n", Err: constants.ErrInjected}, false, false},
+		{"Failure caused by put error", false, desired, allChanges, constants.ErrInjected, nil, false, true},
+		{"Failure caused by delete error", false, &manifest.Manifest{}, removals, constants.ErrInjected, nil, false, true},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		var applied []string
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.PutBucketTaggingAPI = func(ctx context.Context, params *s3.PutBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error) {
+			assert.Equal(t, "env", aws.ToString(params.Tagging.TagSet[0].Key))
+			applied = append(applied, "PutBucketTagging")
+			return &s3.PutBucketTaggingOutput{}, tc.putErr
+		}
+		mockS3.DeleteBucketTaggingAPI = func(ctx context.Context, params *s3.DeleteBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketTaggingOutput, error) {
+			applied = append(applied, "DeleteBucketTagging")
+			return &s3.DeleteBucketTaggingOutput{}, tc.putErr
+		}
+		mockS3.PutBucketVersioningAPI = func(ctx context.Context, params *s3.PutBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.PutBucketVersioningOutput, error) {
+			applied = append(applied, "PutBucketVersioning")
+			return &s3.PutBucketVersioningOutput{}, tc.putErr
+		}
+		mockS3.PutBucketAccelerateConfigurationAPI = func(ctx context.Context, params *s3.PutBucketAccelerateConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketAccelerateConfigurationOutput, error) {
+			applied = append(applied, "PutBucketAccelerateConfiguration")
+			return &s3.PutBucketAccelerateConfigurationOutput{}, tc.putErr
+		}
+		mockS3.PutBucketPolicyAPI = func(ctx context.Context, params *s3.PutBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.PutBucketPolicyOutput, error) {
+			assert.JSONEq(t, `{"Version":"2012-10-17","Statement":[]}`, aws.ToString(params.Policy))
+			applied = append(applied, "PutBucketPolicy")
+			return &s3.PutBucketPolicyOutput{}, tc.putErr
+		}
+		mockS3.DeleteBucketPolicyAPI = func(ctx context.Context, params *s3.DeleteBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketPolicyOutput, error) {
+			applied = append(applied, "DeleteBucketPolicy")
+			return &s3.DeleteBucketPolicyOutput{}, tc.putErr
+		}
+		mockS3.PutBucketLifecycleConfigurationAPI = func(ctx context.Context, params *s3.PutBucketLifecycleConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error) {
+			applied = append(applied, "PutBucketLifecycleConfiguration")
+			return &s3.PutBucketLifecycleConfigurationOutput{}, tc.putErr
+		}
+		mockS3.DeleteBucketLifecycleAPI = func(ctx context.Context, params *s3.DeleteBucketLifecycleInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketLifecycleOutput, error) {
+			applied = append(applied, "DeleteBucketLifecycle")
+			return &s3.DeleteBucketLifecycleOutput{}, tc.putErr
+		}
+		mockS3.PutBucketEncryptionAPI = func(ctx context.Context, params *s3.PutBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error) {
+			assert.Equal(t, "alias/s3", aws.ToString(params.ServerSideEncryptionConfiguration.Rules[0].ApplyServerSideEncryptionByDefault.KMSMasterKeyID))
+			applied = append(applied, "PutBucketEncryption")
+			return &s3.PutBucketEncryptionOutput{}, tc.putErr
+		}
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		err := ApplyManifest(mockS3, &applyoptions.ApplyOptions{Manifest: tc.manifest, RootOptions: rootOpts}, tc.changes,
+			tc.PromptRunner, logging.GetLogger(rootOpts))
+		if !tc.shouldPass {
+			assert.NotNil(t, err)
+			assert.LessOrEqual(t, len(applied), 1)
+			continue
+		}
+
+		assert.Nil(t, err)
+		if !tc.dryRun {
+			assert.Len(t, applied, len(tc.changes))
+		}
+	}
+}
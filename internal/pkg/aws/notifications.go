@@ -0,0 +1,60 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	notificationsoptions "github.com/bilalcaliskan/s3-manager/cmd/notifications/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/notification"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/rs/zerolog"
+)
+
+// GetNotificationConfiguration retrieves the event notification configuration of an S3 bucket.
+//
+// It accepts an S3API interface and NotificationsOptions as arguments, and returns the queue, topic and lambda
+// function notifications of the bucket as a single Configuration.
+func GetNotificationConfiguration(svc internalawstypes.S3ClientAPI, opts *notificationsoptions.NotificationsOptions) (*notification.Configuration, error) {
+	res, err := svc.GetBucketNotificationConfiguration(context.Background(), &s3.GetBucketNotificationConfigurationInput{
+		Bucket: aws.String(opts.BucketName),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return notification.FromSDK(res.QueueConfigurations, res.TopicConfigurations, res.LambdaFunctionConfigurations,
+		res.EventBridgeConfiguration), nil
+}
+
+// SetNotificationConfiguration replaces the event notification configuration of an S3 bucket with the
+// Configuration of NotificationsOptions, an empty Configuration removes all the notifications of the bucket.
+//
+// It accepts an S3API interface, NotificationsOptions, a PromptRunner, and a Logger as arguments.
+// The Configuration is validated before anything else so that overlapping rules are reported even with the
+// 'DryRun' option. If the provided 'DryRun' option is set, the function will return early, if 'AutoApprove'
+// is not set it asks for approval before putting the configuration.
+func SetNotificationConfiguration(svc internalawstypes.S3ClientAPI, opts *notificationsoptions.NotificationsOptions, runner prompt.PromptRunner, logger zerolog.Logger) (res *s3.PutBucketNotificationConfigurationOutput, err error) {
+	if err := opts.Configuration.Validate(); err != nil {
+		return res, err
+	}
+
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return res, nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return res, err
+		}
+	}
+
+	return svc.PutBucketNotificationConfiguration(context.Background(), &s3.PutBucketNotificationConfigurationInput{
+		Bucket:                    aws.String(opts.BucketName),
+		NotificationConfiguration: opts.Configuration.ToSDK(),
+	})
+}
@@ -0,0 +1,185 @@
+//go:build unit
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	notificationsoptions "github.com/bilalcaliskan/s3-manager/cmd/notifications/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/logging"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/notification"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+var validNotificationConfiguration = &notification.Configuration{
+	Rules: []notification.Rule{
+		{
+			ID:     "ingestion",
+			Type:   notification.TypeQueue,
+			Arn:    "arn:aws:sqs:us-east-1:123456789012:ingestion",
+			Events: []string{"s3:ObjectCreated:*"},
+			Prefix: "incoming/",
+		},
+	},
+}
+
+func TestGetNotificationConfiguration(t *testing.T) {
+	cases := []struct {
+		caseName                               string
+		expected                               error
+		rules                                  int
+		getBucketNotificationConfigurationFunc func(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error)
+	}{
+		{
+			"Success",
+			nil,
+			2,
+			func(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+				return &s3.GetBucketNotificationConfigurationOutput{
+					QueueConfigurations: []types.QueueConfiguration{
+						{Id: aws.String("ingestion"), QueueArn: aws.String("arn:aws:sqs:us-east-1:123456789012:ingestion"), Events: []types.Event{types.EventS3ObjectCreated}},
+					},
+					LambdaFunctionConfigurations: []types.LambdaFunctionConfiguration{
+						{Id: aws.String("thumbnail"), LambdaFunctionArn: aws.String("arn:aws:lambda:us-east-1:123456789012:function:thumbnail"), Events: []types.Event{types.EventS3ObjectRemoved}},
+					},
+				}, nil
+			},
+		},
+		{
+			"Success when not configured",
+			nil,
+			0,
+			func(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+				return &s3.GetBucketNotificationConfigurationOutput{}, nil
+			},
+		},
+		{
+			"Failure",
+			constants.ErrInjected,
+			0,
+			func(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketNotificationConfigurationAPI = tc.getBucketNotificationConfigurationFunc
+
+		cfg, err := GetNotificationConfiguration(mockS3, &notificationsoptions.NotificationsOptions{RootOptions: options.GetMockedRootOptions()})
+		assert.Equal(t, tc.expected, err)
+		if err == nil {
+			assert.Len(t, cfg.Rules, tc.rules)
+		}
+	}
+}
+
+func TestSetNotificationConfiguration(t *testing.T) {
+	overlapping := validNotificationConfiguration.Clone()
+	overlapping.Put(notification.Rule{
+		ID:     "duplicate",
+		Type:   notification.TypeTopic,
+		Arn:    "arn:aws:sns:us-east-1:123456789012:uploads",
+		Events: []string{"s3:ObjectCreated:Put"},
+	})
+
+	cases := []struct {
+		caseName                               string
+		shouldPass                             bool
+		cfg                                    *notification.Configuration
+		putBucketNotificationConfigurationFunc func(ctx context.Context, params *s3.PutBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			true,
+			validNotificationConfiguration,
+			func(ctx context.Context, params *s3.PutBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error) {
+				return &s3.PutBucketNotificationConfigurationOutput{}, nil
+			},
+			prompt.PromptMock{Msg: "y"},
+			false,
+			false,
+		},
+		{
+			"Success with empty configuration",
+			true,
+			&notification.Configuration{},
+			func(ctx context.Context, params *s3.PutBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error) {
+				return &s3.PutBucketNotificationConfigurationOutput{}, nil
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Success with dry run",
+			true,
+			validNotificationConfiguration,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by overlapping rules",
+			false,
+			overlapping,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by put error",
+			false,
+			validNotificationConfiguration,
+			func(ctx context.Context, params *s3.PutBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by prompt error",
+			false,
+			validNotificationConfiguration,
+			nil,
+			prompt.PromptMock{Msg: "n", Err: constants.ErrInjected},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.PutBucketNotificationConfigurationAPI = tc.putBucketNotificationConfigurationFunc
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		_, err := SetNotificationConfiguration(mockS3, &notificationsoptions.NotificationsOptions{Configuration: tc.cfg, RootOptions: rootOpts}, tc.PromptRunner, logging.GetLogger(rootOpts))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
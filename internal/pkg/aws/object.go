@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	objectoptions "github.com/bilalcaliskan/s3-manager/cmd/object/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/objectinfo"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// HeadObject retrieves the metadata of an object of an S3 bucket without fetching its content.
+//
+// It accepts an S3API interface, ObjectOptions and the key of the object as arguments. The 'VersionID' of
+// ObjectOptions is headed if set, the latest version otherwise. The checksums are only returned if the object was
+// uploaded with one.
+func HeadObject(svc internalawstypes.S3ClientAPI, opts *objectoptions.ObjectOptions, key string) (*s3.HeadObjectOutput, error) {
+	input := &s3.HeadObjectInput{
+		Bucket:       aws.String(opts.BucketName),
+		Key:          aws.String(key),
+		ChecksumMode: types.ChecksumModeEnabled,
+	}
+
+	if opts.VersionID != "" {
+		input.VersionId = aws.String(opts.VersionID)
+	}
+
+	res, err := svc.HeadObject(context.Background(), input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "an error occurred while heading %s", key)
+	}
+
+	return res, nil
+}
+
+// SetObjectMetadata replaces the headers and the user metadata of the given objects of an S3 bucket with their
+// desired metadata by copying them onto themselves with the REPLACE metadata directive.
+//
+// It accepts an S3API interface, ObjectOptions, the updates of the objects, a PromptRunner, and a Logger as
+// arguments, and follows the same 'DryRun' and 'AutoApprove' semantics with SetObjectLegalHold. The storage class,
+// the server-side encryption, the website redirect location and the tags of the objects are preserved, a new version
+// is created on versioned buckets. It stops at the first error.
+func SetObjectMetadata(svc internalawstypes.S3ClientAPI, opts *objectoptions.ObjectOptions, updates []objectinfo.Update, runner prompt.PromptRunner, logger zerolog.Logger) error {
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return err
+		}
+	}
+
+	for _, update := range updates {
+		if _, err := svc.CopyObject(context.Background(), toCopyObjectInput(opts.BucketName, update)); err != nil {
+			return errors.Wrapf(err, "an error occurred while setting metadata of %s", update.Key)
+		}
+
+		logger.Debug().Str("key", update.Key).Msg("successfully set metadata")
+	}
+
+	return nil
+}
+
+// toCopyObjectInput builds the in place CopyObject request that replaces the metadata of the object with its desired
+// metadata, the settings that CopyObject does not copy with the REPLACE metadata directive are carried over from the
+// HeadObject output of the object.
+func toCopyObjectInput(bucketName string, update objectinfo.Update) *s3.CopyObjectInput {
+	headerOf := func(header string) *string {
+		if value, ok := update.Desired[header]; ok {
+			return aws.String(value)
+		}
+
+		return nil
+	}
+
+	input := &s3.CopyObjectInput{
+		Bucket:                  aws.String(bucketName),
+		Key:                     aws.String(update.Key),
+		CopySource:              aws.String(url.PathEscape(bucketName + "/" + update.Key)),
+		MetadataDirective:       types.MetadataDirectiveReplace,
+		Metadata:                objectinfo.UserMetadata(update.Desired),
+		ContentType:             headerOf(objectinfo.HeaderContentType),
+		CacheControl:            headerOf(objectinfo.HeaderCacheControl),
+		ContentDisposition:      headerOf(objectinfo.HeaderContentDisposition),
+		ContentEncoding:         headerOf(objectinfo.HeaderContentEncoding),
+		ContentLanguage:         headerOf(objectinfo.HeaderContentLanguage),
+		Expires:                 update.Head.Expires,
+		StorageClass:            types.StorageClass(objectinfo.StorageClass(update.Head)),
+		WebsiteRedirectLocation: update.Head.WebsiteRedirectLocation,
+	}
+
+	if update.Head.ServerSideEncryption != "" {
+		input.ServerSideEncryption = update.Head.ServerSideEncryption
+		input.SSEKMSKeyId = update.Head.SSEKMSKeyId
+		input.BucketKeyEnabled = update.Head.BucketKeyEnabled
+	}
+
+	return input
+}
@@ -0,0 +1,119 @@
+//go:build unit
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	objectoptions "github.com/bilalcaliskan/s3-manager/cmd/object/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/logging"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/objectinfo"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeadObject(t *testing.T) {
+	mockS3 := new(internalawstypes.MockS3Client)
+	mockS3.HeadObjectAPI = func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+		if aws.ToString(params.VersionId) != "v1" || params.ChecksumMode != types.ChecksumModeEnabled {
+			return nil, constants.ErrInjected
+		}
+
+		return &s3.HeadObjectOutput{VersionId: params.VersionId}, nil
+	}
+
+	opts := &objectoptions.ObjectOptions{RootOptions: options.GetMockedRootOptions(), VersionID: "v1"}
+	res, err := HeadObject(mockS3, opts, "foo")
+	assert.Nil(t, err)
+	assert.Equal(t, "v1", aws.ToString(res.VersionId))
+
+	opts.VersionID = ""
+	_, err = HeadObject(mockS3, opts, "foo")
+	assert.NotNil(t, err)
+}
+
+func TestSetObjectMetadata(t *testing.T) {
+	updates := []objectinfo.Update{
+		{
+			Key: "assets/app v1.js",
+			Head: &s3.HeadObjectOutput{
+				StorageClass:         types.StorageClassStandardIa,
+				ServerSideEncryption: types.ServerSideEncryptionAwsKms,
+				SSEKMSKeyId:          aws.String("key-1"),
+			},
+			Desired: map[string]string{objectinfo.HeaderCacheControl: "no-cache", objectinfo.MetadataPrefix + "owner": "web"},
+		},
+		{
+			Key:     "assets/app.css",
+			Head:    &s3.HeadObjectOutput{},
+			Desired: map[string]string{objectinfo.HeaderContentType: "text/css"},
+		},
+	}
+
+	copyObjectFunc := func(ctx context.Context, params *s3.CopyObjectInput, optFns ...func(*s3.Options)) (*s3.CopyObjectOutput, error) {
+		if params.MetadataDirective != types.MetadataDirectiveReplace {
+			return nil, constants.ErrInjected
+		}
+
+		switch aws.ToString(params.Key) {
+		case "assets/app v1.js":
+			if aws.ToString(params.CopySource) != "thisisbucketname%2Fassets%2Fapp%20v1.js" ||
+				aws.ToString(params.CacheControl) != "no-cache" || params.ContentType != nil ||
+				params.Metadata["owner"] != "web" || params.StorageClass != types.StorageClassStandardIa ||
+				aws.ToString(params.SSEKMSKeyId) != "key-1" {
+				return nil, constants.ErrInjected
+			}
+		case "assets/app.css":
+			if aws.ToString(params.ContentType) != "text/css" || len(params.Metadata) != 0 ||
+				params.StorageClass != types.StorageClassStandard || params.ServerSideEncryption != "" {
+				return nil, constants.ErrInjected
+			}
+		}
+
+		return &s3.CopyObjectOutput{}, nil
+	}
+
+	cases := []struct {
+		caseName       string
+		copyObjectFunc func(ctx context.Context, params *s3.CopyObjectInput, optFns ...func(*s3.Options)) (*s3.CopyObjectOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+		shouldPass  bool
+	}{
+		{"Success", copyObjectFunc, prompt.PromptMock{Msg: "y"}, false, false, true},
+		{"Success with dry run", nil, nil, true, false, true},
+		{"Failure caused by copy error",
+			func(ctx context.Context, params *s3.CopyObjectInput, optFns ...func(*s3.Options)) (*s3.CopyObjectOutput, error) {
+				return nil, constants.ErrInjected
+			}, nil, false, true, false,
+		},
+		{"Failure caused by user terminated the process", nil, prompt.PromptMock{Msg: "n", Err: constants.ErrInjected}, false, false, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.CopyObjectAPI = tc.copyObjectFunc
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+		opts := &objectoptions.ObjectOptions{RootOptions: rootOpts}
+
+		err := SetObjectMetadata(mockS3, opts, updates, tc.PromptRunner, logging.GetLogger(rootOpts))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	objectlockoptions "github.com/bilalcaliskan/s3-manager/cmd/objectlock/options"
+	objectlockutils "github.com/bilalcaliskan/s3-manager/cmd/objectlock/utils"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// GetObjectLockConfiguration retrieves the Object Lock configuration of an S3 bucket.
+//
+// It accepts an S3API interface and RootOptions as arguments, and returns the ObjectLockConfiguration and any error
+// encountered. A bucket without Object Lock is reported with an empty ObjectLockConfiguration instead of an error.
+func GetObjectLockConfiguration(svc internalawstypes.S3ClientAPI, opts *options.RootOptions) (*types.ObjectLockConfiguration, error) {
+	res, err := svc.GetObjectLockConfiguration(context.Background(), &s3.GetObjectLockConfigurationInput{
+		Bucket: aws.String(opts.BucketName),
+	})
+
+	if err != nil {
+		if isErrorCode(err, "ObjectLockConfigurationNotFoundError") {
+			return &types.ObjectLockConfiguration{}, nil
+		}
+
+		return nil, err
+	}
+
+	if res.ObjectLockConfiguration == nil {
+		return &types.ObjectLockConfiguration{}, nil
+	}
+
+	return res.ObjectLockConfiguration, nil
+}
+
+// SetDefaultRetention sets the default retention of the new objects of an S3 bucket, enabling Object Lock on it.
+//
+// It accepts an S3API interface, ObjectLockOptions, a PromptRunner, and a Logger as arguments.
+// The retention flags are validated before anything else, then the 'DryRun' and 'AutoApprove' options are handled
+// just like SetBucketPolicy before putting the configuration.
+func SetDefaultRetention(svc internalawstypes.S3ClientAPI, opts *objectlockoptions.ObjectLockOptions, runner prompt.PromptRunner, logger zerolog.Logger) (res *s3.PutObjectLockConfigurationOutput, err error) {
+	if err := objectlockutils.ValidateDefaultRetention(opts); err != nil {
+		return res, err
+	}
+
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return res, nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return res, err
+		}
+	}
+
+	return svc.PutObjectLockConfiguration(context.Background(), &s3.PutObjectLockConfigurationInput{
+		Bucket:                  aws.String(opts.BucketName),
+		ObjectLockConfiguration: objectlockutils.ToConfiguration(opts),
+	})
+}
+
+// SetObjectRetention sets the retention of the given objects of an S3 bucket, they are retained until 'Days' days
+// from now with the retention 'Mode' of ObjectLockOptions.
+//
+// It accepts an S3API interface, ObjectLockOptions, the target objects, a PromptRunner, and a Logger as arguments,
+// and follows the same 'DryRun' and 'AutoApprove' semantics with SetDefaultRetention. It stops at the first error.
+func SetObjectRetention(svc internalawstypes.S3ClientAPI, opts *objectlockoptions.ObjectLockOptions, objects []types.Object, runner prompt.PromptRunner, logger zerolog.Logger) error {
+	if err := objectlockutils.ValidateRetention(opts); err != nil {
+		return err
+	}
+
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return err
+		}
+	}
+
+	retainUntil := time.Now().AddDate(0, 0, opts.Days)
+	for _, obj := range objects {
+		if _, err := svc.PutObjectRetention(context.Background(), &s3.PutObjectRetentionInput{
+			Bucket: aws.String(opts.BucketName),
+			Key:    obj.Key,
+			Retention: &types.ObjectLockRetention{
+				Mode:            types.ObjectLockRetentionMode(opts.Mode),
+				RetainUntilDate: aws.Time(retainUntil),
+			},
+		}); err != nil {
+			return errors.Wrapf(err, "an error occurred while setting retention of %s", aws.ToString(obj.Key))
+		}
+
+		logger.Debug().Str("key", aws.ToString(obj.Key)).Msg("successfully set retention")
+	}
+
+	return nil
+}
+
+// SetObjectLegalHold sets the legal hold status of the given objects of an S3 bucket as the 'LegalHoldStatus' of
+// ObjectLockOptions.
+//
+// It accepts an S3API interface, ObjectLockOptions, the target objects, a PromptRunner, and a Logger as arguments,
+// and follows the same 'DryRun' and 'AutoApprove' semantics with SetDefaultRetention. It stops at the first error.
+func SetObjectLegalHold(svc internalawstypes.S3ClientAPI, opts *objectlockoptions.ObjectLockOptions, objects []types.Object, runner prompt.PromptRunner, logger zerolog.Logger) error {
+	if err := objectlockutils.ValidateLegalHold(opts); err != nil {
+		return err
+	}
+
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return err
+		}
+	}
+
+	for _, obj := range objects {
+		if _, err := svc.PutObjectLegalHold(context.Background(), &s3.PutObjectLegalHoldInput{
+			Bucket:    aws.String(opts.BucketName),
+			Key:       obj.Key,
+			LegalHold: &types.ObjectLockLegalHold{Status: types.ObjectLockLegalHoldStatus(opts.LegalHoldStatus)},
+		}); err != nil {
+			return errors.Wrapf(err, "an error occurred while setting legal hold of %s", aws.ToString(obj.Key))
+		}
+
+		logger.Debug().Str("key", aws.ToString(obj.Key)).Msg("successfully set legal hold")
+	}
+
+	return nil
+}
+
+// IsObjectLocked reports whether the object can not be deleted because it is under an active retention period or a
+// legal hold. The objects without any Object Lock settings are reported as unlocked.
+func IsObjectLocked(svc internalawstypes.S3ClientAPI, bucketName, key string) (bool, error) {
+	retention, err := svc.GetObjectRetention(context.Background(), &s3.GetObjectRetentionInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	})
+
+	if err != nil && !isErrorCode(err, "NoSuchObjectLockConfiguration") {
+		return false, err
+	}
+
+	if err == nil && retention.Retention != nil && aws.ToTime(retention.Retention.RetainUntilDate).After(time.Now()) {
+		return true, nil
+	}
+
+	legalHold, err := svc.GetObjectLegalHold(context.Background(), &s3.GetObjectLegalHoldInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		if isErrorCode(err, "NoSuchObjectLockConfiguration") {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return legalHold.LegalHold != nil && legalHold.LegalHold.Status == types.ObjectLockLegalHoldStatusOn, nil
+}
+
+// SplitLockedObjects splits the given objects of an S3 bucket into the ones that can be deleted and the ones that
+// are still under Object Lock retention or legal hold. If Object Lock is not enabled on the bucket, all the objects
+// are reported as unlocked without checking them one by one.
+func SplitLockedObjects(svc internalawstypes.S3ClientAPI, opts *options.RootOptions, objects []types.Object) (unlocked, locked []types.Object, err error) {
+	cfg, err := GetObjectLockConfiguration(svc, opts)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "an error occurred while getting Object Lock configuration")
+	}
+
+	if cfg.ObjectLockEnabled != types.ObjectLockEnabledEnabled {
+		return objects, nil, nil
+	}
+
+	for _, obj := range objects {
+		isLocked, err := IsObjectLocked(svc, opts.BucketName, aws.ToString(obj.Key))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "an error occurred while checking Object Lock status of %s", aws.ToString(obj.Key))
+		}
+
+		if isLocked {
+			locked = append(locked, obj)
+			continue
+		}
+
+		unlocked = append(unlocked, obj)
+	}
+
+	return unlocked, locked, nil
+}
@@ -0,0 +1,494 @@
+//go:build unit
+
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	objectlockoptions "github.com/bilalcaliskan/s3-manager/cmd/objectlock/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/logging"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+var lockedObjects = []types.Object{{Key: aws.String("invoices/1.pdf")}, {Key: aws.String("invoices/2.pdf")}}
+
+func TestGetObjectLockConfiguration(t *testing.T) {
+	cases := []struct {
+		caseName                       string
+		expected                       error
+		enabled                        bool
+		getObjectLockConfigurationFunc func(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error)
+	}{
+		{
+			"Success",
+			nil,
+			true,
+			func(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+				return &s3.GetObjectLockConfigurationOutput{
+					ObjectLockConfiguration: &types.ObjectLockConfiguration{ObjectLockEnabled: types.ObjectLockEnabledEnabled},
+				}, nil
+			},
+		},
+		{
+			"Success when not configured",
+			nil,
+			false,
+			func(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "ObjectLockConfigurationNotFoundError"}
+			},
+		},
+		{
+			"Success with empty output",
+			nil,
+			false,
+			func(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+				return &s3.GetObjectLockConfigurationOutput{}, nil
+			},
+		},
+		{
+			"Failure",
+			constants.ErrInjected,
+			false,
+			func(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetObjectLockConfigurationAPI = tc.getObjectLockConfigurationFunc
+
+		cfg, err := GetObjectLockConfiguration(mockS3, options.GetMockedRootOptions())
+		assert.Equal(t, tc.expected, err)
+		if err == nil {
+			assert.Equal(t, tc.enabled, cfg.ObjectLockEnabled == types.ObjectLockEnabledEnabled)
+		}
+	}
+}
+
+func TestSetDefaultRetention(t *testing.T) {
+	cases := []struct {
+		caseName                       string
+		shouldPass                     bool
+		mode                           string
+		putObjectLockConfigurationFunc func(ctx context.Context, params *s3.PutObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			true,
+			"GOVERNANCE",
+			func(ctx context.Context, params *s3.PutObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
+				return &s3.PutObjectLockConfigurationOutput{}, nil
+			},
+			prompt.PromptMock{Msg: "y"},
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			true,
+			"GOVERNANCE",
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by invalid mode",
+			false,
+			"foo",
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by put error",
+			false,
+			"COMPLIANCE",
+			func(ctx context.Context, params *s3.PutObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by prompt error",
+			false,
+			"GOVERNANCE",
+			nil,
+			prompt.PromptMock{Msg: "n", Err: constants.ErrInjected},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.PutObjectLockConfigurationAPI = tc.putObjectLockConfigurationFunc
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		_, err := SetDefaultRetention(mockS3, &objectlockoptions.ObjectLockOptions{Mode: tc.mode, Days: 30, RootOptions: rootOpts}, tc.PromptRunner, logging.GetLogger(rootOpts))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestSetObjectRetention(t *testing.T) {
+	cases := []struct {
+		caseName               string
+		shouldPass             bool
+		days                   int
+		putObjectRetentionFunc func(ctx context.Context, params *s3.PutObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.PutObjectRetentionOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			true,
+			365,
+			func(ctx context.Context, params *s3.PutObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.PutObjectRetentionOutput, error) {
+				return &s3.PutObjectRetentionOutput{}, nil
+			},
+			prompt.PromptMock{Msg: "y"},
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			true,
+			365,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by invalid days",
+			false,
+			0,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by put error",
+			false,
+			365,
+			func(ctx context.Context, params *s3.PutObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.PutObjectRetentionOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by prompt error",
+			false,
+			365,
+			nil,
+			prompt.PromptMock{Msg: "n", Err: constants.ErrInjected},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.PutObjectRetentionAPI = tc.putObjectRetentionFunc
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		err := SetObjectRetention(mockS3, &objectlockoptions.ObjectLockOptions{Mode: "COMPLIANCE", Days: tc.days, RootOptions: rootOpts}, lockedObjects, tc.PromptRunner, logging.GetLogger(rootOpts))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestSetObjectLegalHold(t *testing.T) {
+	cases := []struct {
+		caseName               string
+		shouldPass             bool
+		status                 string
+		putObjectLegalHoldFunc func(ctx context.Context, params *s3.PutObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.PutObjectLegalHoldOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			true,
+			"ON",
+			func(ctx context.Context, params *s3.PutObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.PutObjectLegalHoldOutput, error) {
+				return &s3.PutObjectLegalHoldOutput{}, nil
+			},
+			prompt.PromptMock{Msg: "y"},
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			true,
+			"OFF",
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by invalid status",
+			false,
+			"foo",
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by put error",
+			false,
+			"ON",
+			func(ctx context.Context, params *s3.PutObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.PutObjectLegalHoldOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by prompt error",
+			false,
+			"ON",
+			nil,
+			prompt.PromptMock{Msg: "n", Err: constants.ErrInjected},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.PutObjectLegalHoldAPI = tc.putObjectLegalHoldFunc
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		err := SetObjectLegalHold(mockS3, &objectlockoptions.ObjectLockOptions{LegalHoldStatus: tc.status, RootOptions: rootOpts}, lockedObjects, tc.PromptRunner, logging.GetLogger(rootOpts))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestIsObjectLocked(t *testing.T) {
+	noLock := &smithy.GenericAPIError{Code: "NoSuchObjectLockConfiguration"}
+	cases := []struct {
+		caseName               string
+		expected               bool
+		shouldPass             bool
+		getObjectRetentionFunc func(ctx context.Context, params *s3.GetObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.GetObjectRetentionOutput, error)
+		getObjectLegalHoldFunc func(ctx context.Context, params *s3.GetObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.GetObjectLegalHoldOutput, error)
+	}{
+		{
+			"Locked by active retention",
+			true,
+			true,
+			func(ctx context.Context, params *s3.GetObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.GetObjectRetentionOutput, error) {
+				return &s3.GetObjectRetentionOutput{Retention: &types.ObjectLockRetention{RetainUntilDate: aws.Time(time.Now().Add(time.Hour))}}, nil
+			},
+			nil,
+		},
+		{
+			"Locked by legal hold after expired retention",
+			true,
+			true,
+			func(ctx context.Context, params *s3.GetObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.GetObjectRetentionOutput, error) {
+				return &s3.GetObjectRetentionOutput{Retention: &types.ObjectLockRetention{RetainUntilDate: aws.Time(time.Now().Add(-time.Hour))}}, nil
+			},
+			func(ctx context.Context, params *s3.GetObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.GetObjectLegalHoldOutput, error) {
+				return &s3.GetObjectLegalHoldOutput{LegalHold: &types.ObjectLockLegalHold{Status: types.ObjectLockLegalHoldStatusOn}}, nil
+			},
+		},
+		{
+			"Not locked",
+			false,
+			true,
+			func(ctx context.Context, params *s3.GetObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.GetObjectRetentionOutput, error) {
+				return nil, noLock
+			},
+			func(ctx context.Context, params *s3.GetObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.GetObjectLegalHoldOutput, error) {
+				return nil, noLock
+			},
+		},
+		{
+			"Not locked with legal hold off",
+			false,
+			true,
+			func(ctx context.Context, params *s3.GetObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.GetObjectRetentionOutput, error) {
+				return nil, noLock
+			},
+			func(ctx context.Context, params *s3.GetObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.GetObjectLegalHoldOutput, error) {
+				return &s3.GetObjectLegalHoldOutput{LegalHold: &types.ObjectLockLegalHold{Status: types.ObjectLockLegalHoldStatusOff}}, nil
+			},
+		},
+		{
+			"Failure caused by get retention error",
+			false,
+			false,
+			func(ctx context.Context, params *s3.GetObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.GetObjectRetentionOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+		},
+		{
+			"Failure caused by get legal hold error",
+			false,
+			false,
+			func(ctx context.Context, params *s3.GetObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.GetObjectRetentionOutput, error) {
+				return nil, noLock
+			},
+			func(ctx context.Context, params *s3.GetObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.GetObjectLegalHoldOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetObjectRetentionAPI = tc.getObjectRetentionFunc
+		mockS3.GetObjectLegalHoldAPI = tc.getObjectLegalHoldFunc
+
+		locked, err := IsObjectLocked(mockS3, "thevpnbeast-releases-1", "invoices/1.pdf")
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		assert.Equal(t, tc.expected, locked)
+	}
+}
+
+func TestSplitLockedObjects(t *testing.T) {
+	enabledFunc := func(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+		return &s3.GetObjectLockConfigurationOutput{
+			ObjectLockConfiguration: &types.ObjectLockConfiguration{ObjectLockEnabled: types.ObjectLockEnabledEnabled},
+		}, nil
+	}
+
+	cases := []struct {
+		caseName                       string
+		shouldPass                     bool
+		unlocked                       int
+		locked                         int
+		getObjectLockConfigurationFunc func(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error)
+		getObjectRetentionFunc         func(ctx context.Context, params *s3.GetObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.GetObjectRetentionOutput, error)
+	}{
+		{
+			"Success when Object Lock is not enabled",
+			true,
+			2,
+			0,
+			func(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "ObjectLockConfigurationNotFoundError"}
+			},
+			nil,
+		},
+		{
+			"Success when Object Lock is enabled",
+			true,
+			1,
+			1,
+			enabledFunc,
+			func(ctx context.Context, params *s3.GetObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.GetObjectRetentionOutput, error) {
+				if *params.Key == "invoices/1.pdf" {
+					return &s3.GetObjectRetentionOutput{Retention: &types.ObjectLockRetention{RetainUntilDate: aws.Time(time.Now().Add(time.Hour))}}, nil
+				}
+
+				return &s3.GetObjectRetentionOutput{Retention: &types.ObjectLockRetention{RetainUntilDate: aws.Time(time.Now().Add(-time.Hour))}}, nil
+			},
+		},
+		{
+			"Failure caused by get object lock configuration error",
+			false,
+			0,
+			0,
+			func(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+		},
+		{
+			"Failure caused by get retention error",
+			false,
+			0,
+			0,
+			enabledFunc,
+			func(ctx context.Context, params *s3.GetObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.GetObjectRetentionOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetObjectLockConfigurationAPI = tc.getObjectLockConfigurationFunc
+		mockS3.GetObjectRetentionAPI = tc.getObjectRetentionFunc
+		mockS3.GetObjectLegalHoldAPI = func(ctx context.Context, params *s3.GetObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.GetObjectLegalHoldOutput, error) {
+			return nil, &smithy.GenericAPIError{Code: "NoSuchObjectLockConfiguration"}
+		}
+
+		unlocked, locked, err := SplitLockedObjects(mockS3, options.GetMockedRootOptions(), lockedObjects)
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		assert.Len(t, unlocked, tc.unlocked)
+		assert.Len(t, locked, tc.locked)
+	}
+}
@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	presignoptions "github.com/bilalcaliskan/s3-manager/cmd/presign/options"
+	presignutils "github.com/bilalcaliskan/s3-manager/cmd/presign/utils"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/presign"
+	"github.com/pkg/errors"
+)
+
+// NewPresignClient returns the presign client that is built on the given S3 client, so the URLs are signed with the
+// credentials and the region of the client created by CreateClient.
+func NewPresignClient(svc internalawstypes.S3ClientAPI) (internalawstypes.S3PresignAPI, error) {
+	switch client := svc.(type) {
+	case *s3.Client:
+		return s3.NewPresignClient(client), nil
+	case internalawstypes.S3PresignAPI:
+		return client, nil
+	default:
+		return nil, errors.New(presignutils.ErrPresignNotSupported)
+	}
+}
+
+// PresignGetObjects presigns GetObject requests of the given objects of an S3 bucket.
+//
+// It accepts an S3PresignAPI interface, PresignOptions and the keys of the objects as arguments. The URLs are valid
+// for 'ExpiryMinutes' of PresignOptions, the 'ResponseContentDisposition' and 'ResponseContentType' of
+// PresignOptions override the headers of the responses if set. Presigning does not send any request to S3, so
+// the existence of the objects is not checked. It stops at the first error.
+func PresignGetObjects(presigner internalawstypes.S3PresignAPI, opts *presignoptions.PresignOptions, keys []string) ([]presign.URL, error) {
+	urls := make([]presign.URL, 0, len(keys))
+	for _, key := range keys {
+		input := &s3.GetObjectInput{
+			Bucket: aws.String(opts.BucketName),
+			Key:    aws.String(key),
+		}
+
+		if opts.ResponseContentDisposition != "" {
+			input.ResponseContentDisposition = aws.String(opts.ResponseContentDisposition)
+		}
+
+		if opts.ResponseContentType != "" {
+			input.ResponseContentType = aws.String(opts.ResponseContentType)
+		}
+
+		req, err := presigner.PresignGetObject(context.Background(), input, s3.WithPresignExpires(expiry(opts)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "an error occurred while presigning %s", key)
+		}
+
+		urls = append(urls, presign.FromRequest(key, req))
+	}
+
+	return urls, nil
+}
+
+// PresignPutObjects presigns PutObject requests of the given objects of an S3 bucket.
+//
+// It accepts an S3PresignAPI interface, PresignOptions and the keys of the objects as arguments. The URLs are valid
+// for 'ExpiryMinutes' of PresignOptions. The 'ContentType' of PresignOptions is signed, so the uploads must be sent
+// with the same Content-Type header, and the uploads must match the 'ChecksumSHA256' of PresignOptions if set. It
+// stops at the first error.
+func PresignPutObjects(presigner internalawstypes.S3PresignAPI, opts *presignoptions.PresignOptions, keys []string) ([]presign.URL, error) {
+	optFns := []func(*s3.PresignOptions){s3.WithPresignExpires(expiry(opts))}
+	if opts.ContentType != "" {
+		optFns = append(optFns, withSignedContentType(opts.ContentType))
+	}
+
+	urls := make([]presign.URL, 0, len(keys))
+	for _, key := range keys {
+		input := &s3.PutObjectInput{
+			Bucket: aws.String(opts.BucketName),
+			Key:    aws.String(key),
+		}
+
+		if opts.ChecksumSHA256 != "" {
+			input.ChecksumSHA256 = aws.String(opts.ChecksumSHA256)
+		}
+
+		req, err := presigner.PresignPutObject(context.Background(), input, optFns...)
+		if err != nil {
+			return nil, errors.Wrapf(err, "an error occurred while presigning %s", key)
+		}
+
+		urls = append(urls, presign.FromRequest(key, req))
+	}
+
+	return urls, nil
+}
+
+func expiry(opts *presignoptions.PresignOptions) time.Duration {
+	return time.Duration(opts.ExpiryMinutes) * time.Minute
+}
+
+// withSignedContentType signs the Content-Type header of the presigned PutObject requests, the presign client
+// removes it from the requests otherwise and the uploads can be sent with any content type.
+func withSignedContentType(contentType string) func(*s3.PresignOptions) {
+	return s3.WithPresignClientFromClientOptions(func(o *s3.Options) {
+		o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+			return stack.Build.Add(middleware.BuildMiddlewareFunc("SignedContentType",
+				func(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (middleware.BuildOutput, middleware.Metadata, error) {
+					if req, ok := in.Request.(*smithyhttp.Request); ok {
+						req.Header.Set("Content-Type", contentType)
+					}
+
+					return next.HandleBuild(ctx, in)
+				}), middleware.After)
+		})
+	})
+}
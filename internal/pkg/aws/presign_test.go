@@ -0,0 +1,103 @@
+//go:build unit
+
+package aws
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	presignoptions "github.com/bilalcaliskan/s3-manager/cmd/presign/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPresignClient(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+	client, err := CreateClient(rootOpts)
+	assert.Nil(t, err)
+
+	presigner, err := NewPresignClient(client)
+	assert.Nil(t, err)
+	assert.IsType(t, &s3.PresignClient{}, presigner)
+
+	mockS3 := new(internalawstypes.MockS3Client)
+	presigner, err = NewPresignClient(mockS3)
+	assert.Nil(t, err)
+	assert.Equal(t, mockS3, presigner)
+}
+
+func TestPresignGetObjects(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+	rootOpts.Region = "us-east-1"
+	client, err := CreateClient(rootOpts)
+	assert.Nil(t, err)
+
+	presigner, _ := NewPresignClient(client)
+	opts := &presignoptions.PresignOptions{
+		RootOptions:                rootOpts,
+		ExpiryMinutes:              60,
+		ResponseContentDisposition: `attachment; filename="report.csv"`,
+	}
+
+	urls, err := PresignGetObjects(presigner, opts, []string{"reports/a.csv", "reports/b c.csv"})
+	assert.Nil(t, err)
+	assert.Len(t, urls, 2)
+	assert.Equal(t, http.MethodGet, urls[0].Method)
+	assert.True(t, strings.HasPrefix(urls[0].URL, "https://thisisbucketname.s3.us-east-1.amazonaws.com/reports/a.csv?"))
+	assert.Contains(t, urls[0].URL, "X-Amz-Expires=3600")
+	assert.Contains(t, urls[0].URL, "response-content-disposition=attachment%3B%20filename%3D%22report.csv%22")
+	assert.Contains(t, urls[1].URL, "/reports/b%20c.csv?")
+	assert.Empty(t, urls[0].HeaderLines())
+
+	mockS3 := new(internalawstypes.MockS3Client)
+	mockS3.PresignGetObjectAPI = func(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+		return nil, constants.ErrInjected
+	}
+
+	_, err = PresignGetObjects(mockS3, opts, []string{"reports/a.csv"})
+	assert.NotNil(t, err)
+}
+
+func TestPresignPutObjects(t *testing.T) {
+	rootOpts := options.GetMockedRootOptions()
+	rootOpts.Region = "us-east-1"
+	client, err := CreateClient(rootOpts)
+	assert.Nil(t, err)
+
+	presigner, _ := NewPresignClient(client)
+	opts := &presignoptions.PresignOptions{
+		RootOptions:    rootOpts,
+		ExpiryMinutes:  15,
+		ContentType:    "text/csv",
+		ChecksumSHA256: "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=",
+	}
+
+	urls, err := PresignPutObjects(presigner, opts, []string{"reports/a.csv"})
+	assert.Nil(t, err)
+	assert.Equal(t, http.MethodPut, urls[0].Method)
+	assert.Contains(t, urls[0].URL, "X-Amz-Expires=900")
+	assert.Contains(t, urls[0].URL, "X-Amz-SignedHeaders=content-type%3Bhost")
+	assert.Contains(t, urls[0].URL, "X-Amz-Checksum-Sha256=47DEQpj8HBSa%2B%2FTImW%2B5JCeuQeRkm5NMpJWZG3hSuFU%3D")
+	assert.Equal(t, []string{"Content-Type: text/csv"}, urls[0].HeaderLines())
+
+	opts.ContentType = ""
+	opts.ChecksumSHA256 = ""
+	urls, err = PresignPutObjects(presigner, opts, []string{"reports/a.csv"})
+	assert.Nil(t, err)
+	assert.Contains(t, urls[0].URL, "X-Amz-SignedHeaders=host&")
+	assert.Empty(t, urls[0].HeaderLines())
+
+	mockS3 := new(internalawstypes.MockS3Client)
+	mockS3.PresignPutObjectAPI = func(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+		return nil, constants.ErrInjected
+	}
+
+	_, err = PresignPutObjects(mockS3, opts, []string{"reports/a.csv"})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	acloptions "github.com/bilalcaliskan/s3-manager/cmd/acl/options"
+	bucketpolicyoptions "github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/options"
+	publicaccessoptions "github.com/bilalcaliskan/s3-manager/cmd/publicaccess/options"
+	publicaccessutils "github.com/bilalcaliskan/s3-manager/cmd/publicaccess/utils"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/policy"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/publicaccess"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// GetPublicAccessBlock retrieves the public access block configuration of an S3 bucket.
+//
+// It accepts an S3API interface and PublicAccessOptions as arguments, and returns a GetPublicAccessBlockOutput and
+// any error encountered. A bucket without any public access block configuration is reported with an empty
+// GetPublicAccessBlockOutput instead of an error.
+func GetPublicAccessBlock(svc internalawstypes.S3ClientAPI, opts *publicaccessoptions.PublicAccessOptions) (res *s3.GetPublicAccessBlockOutput, err error) {
+	res, err = svc.GetPublicAccessBlock(context.Background(), &s3.GetPublicAccessBlockInput{
+		Bucket: aws.String(opts.BucketName),
+	})
+
+	if err != nil && isErrorCode(err, "NoSuchPublicAccessBlockConfiguration") {
+		return &s3.GetPublicAccessBlockOutput{}, nil
+	}
+
+	return res, err
+}
+
+// SetPublicAccessBlock sets the public access block configuration of an S3 bucket.
+//
+// It accepts an S3API interface, PublicAccessOptions, a PromptRunner, and a Logger as arguments.
+// If the provided 'DryRun' option is set, the function will return early, if 'AutoApprove' is not set it asks for
+// approval. It then compares the actual state with the desired state and skips the configuration with a warning
+// if they already match, otherwise it puts the desired configuration onto the bucket.
+// It logs any errors encountered and returns them.
+func SetPublicAccessBlock(svc internalawstypes.S3ClientAPI, opts *publicaccessoptions.PublicAccessOptions, runner prompt.PromptRunner, logger zerolog.Logger) error {
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return err
+		}
+	}
+
+	res, err := GetPublicAccessBlock(svc, opts)
+	if err != nil {
+		logger.Error().Msg(err.Error())
+		return err
+	}
+
+	publicaccessutils.DecideActualState(res, opts)
+
+	logger.Info().Msgf(publicaccessutils.InfCurrentState, publicaccessutils.FormatState(opts.ActualState))
+	if opts.ActualState == opts.DesiredState {
+		logger.Warn().
+			Str("state", publicaccessutils.FormatState(opts.ActualState)).
+			Msg(publicaccessutils.WarnDesiredState)
+		return nil
+	}
+
+	logger.Info().Msgf(publicaccessutils.InfSettingPublicAccess, publicaccessutils.FormatState(opts.DesiredState))
+
+	if _, err := svc.PutPublicAccessBlock(context.Background(), &s3.PutPublicAccessBlockInput{
+		Bucket:                         aws.String(opts.BucketName),
+		PublicAccessBlockConfiguration: publicaccessutils.ToConfiguration(opts.DesiredState),
+	}); err != nil {
+		logger.Error().Msg(err.Error())
+		return err
+	}
+
+	logger.Info().Msgf(publicaccessutils.InfSuccess, publicaccessutils.FormatState(opts.DesiredState))
+
+	return nil
+}
+
+// GetBucketPolicyDocument retrieves the current policy of an S3 bucket and parses it into a policy Document.
+//
+// It accepts an S3API interface and BucketPolicyOptions as arguments. A bucket without any policy is reported with
+// a nil Document instead of an error.
+func GetBucketPolicyDocument(svc internalawstypes.S3ClientAPI, opts *bucketpolicyoptions.BucketPolicyOptions) (*policy.Document, error) {
+	res, err := GetBucketPolicy(svc, opts)
+	if err != nil {
+		if isErrorCode(err, "NoSuchBucketPolicy") {
+			return nil, nil
+		}
+
+		return nil, errors.Wrap(err, "an error occurred while getting bucket policy")
+	}
+
+	doc, err := policy.Parse(aws.ToString(res.Policy))
+	if err != nil {
+		return nil, errors.Wrap(err, "an error occurred while parsing bucket policy")
+	}
+
+	return doc, nil
+}
+
+// GetPublicAccessVerdict decides whether an S3 bucket is publicly accessible.
+//
+// It fetches the bucket policy, the bucket ACL and the public access block configuration of the bucket, and
+// evaluates them together. It returns the Verdict and any error encountered while fetching the configurations.
+func GetPublicAccessVerdict(svc internalawstypes.S3ClientAPI, opts *publicaccessoptions.PublicAccessOptions) (verdict publicaccess.Verdict, err error) {
+	doc, err := GetBucketPolicyDocument(svc, &bucketpolicyoptions.BucketPolicyOptions{RootOptions: opts.RootOptions})
+	if err != nil {
+		return verdict, err
+	}
+
+	acl, err := GetBucketAcl(svc, &acloptions.AclOptions{RootOptions: opts.RootOptions})
+	if err != nil {
+		return verdict, errors.Wrap(err, "an error occurred while getting bucket acl")
+	}
+
+	block, err := GetPublicAccessBlock(svc, opts)
+	if err != nil {
+		return verdict, errors.Wrap(err, "an error occurred while getting public access block")
+	}
+
+	return publicaccess.Evaluate(doc, acl.Grants, block.PublicAccessBlockConfiguration), nil
+}
@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	replicationoptions "github.com/bilalcaliskan/s3-manager/cmd/replication/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/replication"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// GetReplicationConfiguration retrieves the replication configuration of an S3 bucket.
+//
+// It accepts an S3API interface and ReplicationOptions as arguments, and returns the parsed Configuration and any
+// error encountered. A bucket without replication is reported with an empty Configuration instead of an error.
+func GetReplicationConfiguration(svc internalawstypes.S3ClientAPI, opts *replicationoptions.ReplicationOptions) (*replication.Configuration, error) {
+	res, err := svc.GetBucketReplication(context.Background(), &s3.GetBucketReplicationInput{
+		Bucket: aws.String(opts.BucketName),
+	})
+
+	if err != nil {
+		if isErrorCode(err, "ReplicationConfigurationNotFoundError") {
+			return replication.FromSDK(nil), nil
+		}
+
+		return nil, err
+	}
+
+	return replication.FromSDK(res.ReplicationConfiguration), nil
+}
+
+// SetBucketReplication replaces the replication configuration of an S3 bucket with the Configuration of
+// ReplicationOptions.
+//
+// It accepts an S3API interface, ReplicationOptions, a PromptRunner, and a Logger as arguments.
+// The Configuration is validated before anything else, then the 'DryRun' and 'AutoApprove' options are handled
+// just like SetBucketPolicy before putting the configuration.
+func SetBucketReplication(svc internalawstypes.S3ClientAPI, opts *replicationoptions.ReplicationOptions, runner prompt.PromptRunner, logger zerolog.Logger) (res *s3.PutBucketReplicationOutput, err error) {
+	if err := opts.Configuration.Validate(); err != nil {
+		return res, err
+	}
+
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return res, nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return res, err
+		}
+	}
+
+	return svc.PutBucketReplication(context.Background(), &s3.PutBucketReplicationInput{
+		Bucket:                   aws.String(opts.BucketName),
+		ReplicationConfiguration: opts.Configuration.ToSDK(),
+	})
+}
+
+// DeleteBucketReplication removes the replication configuration of an S3 bucket.
+//
+// It accepts an S3API interface, ReplicationOptions, a PromptRunner, and a Logger as arguments, and follows the
+// same 'DryRun' and 'AutoApprove' semantics with SetBucketReplication.
+func DeleteBucketReplication(svc internalawstypes.S3ClientAPI, opts *replicationoptions.ReplicationOptions, runner prompt.PromptRunner, logger zerolog.Logger) (res *s3.DeleteBucketReplicationOutput, err error) {
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return res, nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return res, err
+		}
+	}
+
+	return svc.DeleteBucketReplication(context.Background(), &s3.DeleteBucketReplicationInput{
+		Bucket: aws.String(opts.BucketName),
+	})
+}
+
+// ListObjectsWithPrefix lists the objects of an S3 bucket under the given prefix, following the continuation
+// tokens. If limit is greater than 0, listing stops as soon as limit objects are collected. The optFns are passed to
+// the ListObjectsV2 calls.
+func ListObjectsWithPrefix(svc internalawstypes.S3ClientAPI, bucketName, prefix string, limit int, optFns ...func(*s3.Options)) ([]types.Object, error) {
+	var (
+		objects      []types.Object
+		continuation *string
+	)
+
+	for {
+		input := &s3.ListObjectsV2Input{
+			Bucket:            aws.String(bucketName),
+			ContinuationToken: continuation,
+		}
+
+		if prefix != "" {
+			input.Prefix = aws.String(prefix)
+		}
+
+		result, err := svc.ListObjectsV2(context.Background(), input, optFns...)
+		if err != nil {
+			return objects, err
+		}
+
+		objects = append(objects, result.Contents...)
+		if limit > 0 && len(objects) >= limit {
+			return objects[:limit], nil
+		}
+
+		if !aws.ToBool(result.IsTruncated) {
+			break
+		}
+
+		continuation = result.NextContinuationToken
+	}
+
+	return objects, nil
+}
+
+// GetReplicationSummary reports the replication status of the objects of an S3 bucket per prefix.
+//
+// It lists the objects under the 'Prefix' of ReplicationOptions, limited by its 'Sample', and concurrently calls
+// HeadObject for each of them to find out their replication status. The objects that HeadObject fails for are
+// not counted, their errors are returned alongside the Summary.
+func GetReplicationSummary(svc internalawstypes.S3ClientAPI, opts *replicationoptions.ReplicationOptions) (*replication.Summary, []error) {
+	summary := replication.NewSummary(opts.Depth)
+
+	objects, err := ListObjectsWithPrefix(svc, opts.BucketName, opts.Prefix, opts.Sample)
+	if err != nil {
+		return summary, []error{errors.Wrap(err, "an error occurred while listing objects")}
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   = &sync.Mutex{}
+		errs []error
+		sem  = make(chan struct{}, opts.Concurrency)
+	)
+
+	for _, obj := range objects {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(key string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			res, err := svc.HeadObject(context.Background(), &s3.HeadObjectInput{
+				Bucket: aws.String(opts.BucketName),
+				Key:    aws.String(key),
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = append(errs, errors.Wrapf(err, "an error occurred while getting metadata of %s", key))
+				return
+			}
+
+			summary.Add(key, res.ReplicationStatus)
+		}(aws.ToString(obj.Key))
+	}
+
+	wg.Wait()
+
+	return summary, errs
+}
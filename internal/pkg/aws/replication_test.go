@@ -0,0 +1,352 @@
+//go:build unit
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	replicationoptions "github.com/bilalcaliskan/s3-manager/cmd/replication/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/logging"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/replication"
+	"github.com/stretchr/testify/assert"
+)
+
+var validReplicationConfiguration = &replication.Configuration{
+	Role: "arn:aws:iam::123456789012:role/s3-replication",
+	Rules: []replication.Rule{
+		{
+			Status:      replication.StatusEnabled,
+			Destination: replication.Destination{Bucket: "arn:aws:s3:::thevpnbeast-releases-dr"},
+		},
+	},
+}
+
+func TestGetReplicationConfiguration(t *testing.T) {
+	cases := []struct {
+		caseName                 string
+		expected                 error
+		rules                    int
+		getBucketReplicationFunc func(ctx context.Context, params *s3.GetBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error)
+	}{
+		{
+			"Success",
+			nil,
+			1,
+			func(ctx context.Context, params *s3.GetBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
+				return &s3.GetBucketReplicationOutput{ReplicationConfiguration: validReplicationConfiguration.ToSDK()}, nil
+			},
+		},
+		{
+			"Success when not configured",
+			nil,
+			0,
+			func(ctx context.Context, params *s3.GetBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "ReplicationConfigurationNotFoundError"}
+			},
+		},
+		{
+			"Failure",
+			constants.ErrInjected,
+			0,
+			func(ctx context.Context, params *s3.GetBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketReplicationAPI = tc.getBucketReplicationFunc
+
+		cfg, err := GetReplicationConfiguration(mockS3, &replicationoptions.ReplicationOptions{RootOptions: options.GetMockedRootOptions()})
+		assert.Equal(t, tc.expected, err)
+		if err == nil {
+			assert.Len(t, cfg.Rules, tc.rules)
+		}
+	}
+}
+
+func TestSetBucketReplication(t *testing.T) {
+	cases := []struct {
+		caseName                 string
+		shouldPass               bool
+		cfg                      *replication.Configuration
+		putBucketReplicationFunc func(ctx context.Context, params *s3.PutBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.PutBucketReplicationOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			true,
+			validReplicationConfiguration,
+			func(ctx context.Context, params *s3.PutBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.PutBucketReplicationOutput, error) {
+				return &s3.PutBucketReplicationOutput{}, nil
+			},
+			prompt.PromptMock{Msg: "y"},
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			true,
+			validReplicationConfiguration,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by invalid configuration",
+			false,
+			&replication.Configuration{},
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by put error",
+			false,
+			validReplicationConfiguration,
+			func(ctx context.Context, params *s3.PutBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.PutBucketReplicationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by prompt error",
+			false,
+			validReplicationConfiguration,
+			nil,
+			prompt.PromptMock{Msg: "n", Err: constants.ErrInjected},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.PutBucketReplicationAPI = tc.putBucketReplicationFunc
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		_, err := SetBucketReplication(mockS3, &replicationoptions.ReplicationOptions{Configuration: tc.cfg, RootOptions: rootOpts}, tc.PromptRunner, logging.GetLogger(rootOpts))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestDeleteBucketReplication(t *testing.T) {
+	cases := []struct {
+		caseName                    string
+		shouldPass                  bool
+		deleteBucketReplicationFunc func(ctx context.Context, params *s3.DeleteBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketReplicationOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+	}{
+		{
+			"Success",
+			true,
+			func(ctx context.Context, params *s3.DeleteBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketReplicationOutput, error) {
+				return &s3.DeleteBucketReplicationOutput{}, nil
+			},
+			prompt.PromptMock{Msg: "y"},
+			false,
+			false,
+		},
+		{
+			"Success with dry run",
+			true,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"Failure caused by delete error",
+			false,
+			func(ctx context.Context, params *s3.DeleteBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketReplicationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"Failure caused by prompt error",
+			false,
+			nil,
+			prompt.PromptMock{Msg: "n", Err: constants.ErrInjected},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.DeleteBucketReplicationAPI = tc.deleteBucketReplicationFunc
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+
+		_, err := DeleteBucketReplication(mockS3, &replicationoptions.ReplicationOptions{RootOptions: rootOpts}, tc.PromptRunner, logging.GetLogger(rootOpts))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestListObjectsWithPrefix(t *testing.T) {
+	pages := map[string]*s3.ListObjectsV2Output{
+		"": {
+			Contents:              []types.Object{{Key: aws.String("a/1")}, {Key: aws.String("a/2")}},
+			IsTruncated:           aws.Bool(true),
+			NextContinuationToken: aws.String("next"),
+		},
+		"next": {
+			Contents:    []types.Object{{Key: aws.String("b/1")}},
+			IsTruncated: aws.Bool(false),
+		},
+	}
+
+	listFunc := func(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+		return pages[aws.ToString(params.ContinuationToken)], nil
+	}
+
+	cases := []struct {
+		caseName          string
+		limit             int
+		expected          int
+		shouldPass        bool
+		listObjectsV2Func func(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error)
+	}{
+		{"Success with pagination", 0, 3, true, listFunc},
+		{"Success with limit", 1, 1, true, listFunc},
+		{"Success with limit greater than object count", 10, 3, true, listFunc},
+		{
+			"Failure",
+			0,
+			0,
+			false,
+			func(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListObjectsV2API = tc.listObjectsV2Func
+
+		objects, err := ListObjectsWithPrefix(mockS3, "thevpnbeast-releases-1", "", tc.limit)
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		assert.Len(t, objects, tc.expected)
+	}
+}
+
+func TestGetReplicationSummary(t *testing.T) {
+	listFunc := func(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+		return &s3.ListObjectsV2Output{
+			Contents: []types.Object{
+				{Key: aws.String("backups/1.tar.gz")},
+				{Key: aws.String("backups/2.tar.gz")},
+				{Key: aws.String("logs/app.log")},
+			},
+			IsTruncated: aws.Bool(false),
+		}, nil
+	}
+
+	cases := []struct {
+		caseName          string
+		errors            int
+		completed         int
+		listObjectsV2Func func(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error)
+		headObjectFunc    func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	}{
+		{
+			"Success",
+			0,
+			2,
+			listFunc,
+			func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+				if aws.ToString(params.Key) == "logs/app.log" {
+					return &s3.HeadObjectOutput{ReplicationStatus: types.ReplicationStatusFailed}, nil
+				}
+
+				return &s3.HeadObjectOutput{ReplicationStatus: types.ReplicationStatusCompleted}, nil
+			},
+		},
+		{
+			"Failure caused by head object error",
+			1,
+			2,
+			listFunc,
+			func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+				if aws.ToString(params.Key) == "logs/app.log" {
+					return nil, constants.ErrInjected
+				}
+
+				return &s3.HeadObjectOutput{ReplicationStatus: types.ReplicationStatusCompleted}, nil
+			},
+		},
+		{
+			"Failure caused by list objects error",
+			1,
+			0,
+			func(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListObjectsV2API = tc.listObjectsV2Func
+		mockS3.HeadObjectAPI = tc.headObjectFunc
+
+		summary, errs := GetReplicationSummary(mockS3, &replicationoptions.ReplicationOptions{
+			RootOptions: options.GetMockedRootOptions(),
+			Depth:       1,
+			Concurrency: 2,
+		})
+		assert.Len(t, errs, tc.errors)
+		assert.Equal(t, tc.completed, summary.Count(string(types.ReplicationStatusCompleted)))
+	}
+}
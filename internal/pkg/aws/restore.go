@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	restoreoptions "github.com/bilalcaliskan/s3-manager/cmd/restore/options"
+	restoreutils "github.com/bilalcaliskan/s3-manager/cmd/restore/utils"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/restore"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// GetRestoreStatuses retrieves the restore status of the given objects of an S3 bucket from the restore headers
+// that HeadObject returns.
+//
+// It accepts an S3API interface, RestoreOptions and the keys of the objects as arguments. It stops at the first
+// error.
+func GetRestoreStatuses(svc internalawstypes.S3ClientAPI, opts *restoreoptions.RestoreOptions, keys []string) ([]restore.Status, error) {
+	statuses := make([]restore.Status, 0, len(keys))
+	for _, key := range keys {
+		head, err := svc.HeadObject(context.Background(), &s3.HeadObjectInput{
+			Bucket: aws.String(opts.BucketName),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "an error occurred while heading %s", key)
+		}
+
+		status, err := restore.ParseStatus(key, head)
+		if err != nil {
+			return nil, err
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// RequestRestore requests a temporary copy of the given archived objects of an S3 bucket with the 'Tier' of
+// RestoreOptions, the copies are kept for 'Days' of RestoreOptions.
+//
+// It accepts an S3API interface, RestoreOptions, the statuses of the objects, a PromptRunner, and a Logger as
+// arguments, and follows the same 'DryRun' and 'AutoApprove' semantics with TransitionObjects. The objects in the
+// archive access tiers of INTELLIGENT_TIERING are requested without 'Days' since they are moved back to the frequent
+// access tier instead of being copied. It does not stop at the first error, the outcome of each object is returned
+// and the failed ones are reported with a single error.
+func RequestRestore(svc internalawstypes.S3ClientAPI, opts *restoreoptions.RestoreOptions, requests []restore.Status, runner prompt.PromptRunner, logger zerolog.Logger) ([]restore.Result, error) {
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return nil, nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return nil, err
+		}
+	}
+
+	tier, _ := restore.ParseTier(opts.Tier)
+
+	var failed int
+	results := make([]restore.Result, 0, len(requests))
+	for _, request := range requests {
+		input := &s3.RestoreObjectInput{
+			Bucket: aws.String(opts.BucketName),
+			Key:    aws.String(request.Key),
+			RestoreRequest: &types.RestoreRequest{
+				GlacierJobParameters: &types.GlacierJobParameters{Tier: tier},
+			},
+		}
+
+		if request.ArchiveStatus == "" {
+			input.RestoreRequest.Days = aws.Int32(opts.Days)
+		}
+
+		result := restore.Result{Key: request.Key}
+		if _, result.Err = svc.RestoreObject(context.Background(), input); result.Err != nil {
+			failed++
+		}
+
+		results = append(results, result)
+	}
+
+	if failed > 0 {
+		return results, fmt.Errorf(restoreutils.ErrObjectsNotRestored, failed)
+	}
+
+	return results, nil
+}
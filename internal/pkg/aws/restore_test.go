@@ -0,0 +1,118 @@
+//go:build unit
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	restoreoptions "github.com/bilalcaliskan/s3-manager/cmd/restore/options"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/logging"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/restore"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRestoreStatuses(t *testing.T) {
+	mockS3 := new(internalawstypes.MockS3Client)
+	mockS3.HeadObjectAPI = func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+		switch aws.ToString(params.Key) {
+		case "archived":
+			return &s3.HeadObjectOutput{StorageClass: types.StorageClassGlacier, Restore: aws.String(`ongoing-request="true"`)}, nil
+		case "invalid":
+			return &s3.HeadObjectOutput{Restore: aws.String("invalid")}, nil
+		case "missing":
+			return nil, constants.ErrInjected
+		}
+
+		return &s3.HeadObjectOutput{}, nil
+	}
+
+	opts := &restoreoptions.RestoreOptions{RootOptions: options.GetMockedRootOptions()}
+	statuses, err := GetRestoreStatuses(mockS3, opts, []string{"archived", "standard"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{restore.StateInProgress, restore.StateNotArchived}, []string{statuses[0].State, statuses[1].State})
+
+	_, err = GetRestoreStatuses(mockS3, opts, []string{"archived", "missing"})
+	assert.NotNil(t, err)
+
+	_, err = GetRestoreStatuses(mockS3, opts, []string{"invalid"})
+	assert.NotNil(t, err)
+}
+
+func TestRequestRestore(t *testing.T) {
+	requests := []restore.Status{
+		{Key: "glacier", StorageClass: "GLACIER", State: restore.StateNotRestored},
+		{Key: "archive-access", StorageClass: "INTELLIGENT_TIERING", ArchiveStatus: "ARCHIVE_ACCESS", State: restore.StateNotRestored},
+	}
+
+	restoreObjectFunc := func(ctx context.Context, params *s3.RestoreObjectInput, optFns ...func(*s3.Options)) (*s3.RestoreObjectOutput, error) {
+		if params.RestoreRequest.GlacierJobParameters.Tier != types.TierBulk {
+			return nil, constants.ErrInjected
+		}
+
+		switch aws.ToString(params.Key) {
+		case "glacier":
+			if aws.ToInt32(params.RestoreRequest.Days) != 3 {
+				return nil, constants.ErrInjected
+			}
+		case "archive-access":
+			if params.RestoreRequest.Days != nil {
+				return nil, constants.ErrInjected
+			}
+		}
+
+		return &s3.RestoreObjectOutput{}, nil
+	}
+
+	cases := []struct {
+		caseName          string
+		restoreObjectFunc func(ctx context.Context, params *s3.RestoreObjectInput, optFns ...func(*s3.Options)) (*s3.RestoreObjectOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+		shouldPass  bool
+		results     int
+	}{
+		{"Success", restoreObjectFunc, prompt.PromptMock{Msg: "y"}, false, false, true, 2},
+		{"Success with dry run", nil, nil, true, false, true, 0},
+		{"Failure caused by restore error of a single object",
+			func(ctx context.Context, params *s3.RestoreObjectInput, optFns ...func(*s3.Options)) (*s3.RestoreObjectOutput, error) {
+				if aws.ToString(params.Key) == "glacier" {
+					return nil, constants.ErrInjected
+				}
+
+				return &s3.RestoreObjectOutput{}, nil
+			}, nil, false, true, false, 2,
+		},
+		{"Failure caused by user terminated the process", nil, prompt.PromptMock{Msg: "n", Err: constants.ErrInjected},
+			false, false, false, 0},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.RestoreObjectAPI = tc.restoreObjectFunc
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+		opts := &restoreoptions.RestoreOptions{RootOptions: rootOpts, Tier: "bulk", Days: 3}
+
+		results, err := RequestRestore(mockS3, opts, requests, tc.PromptRunner, logging.GetLogger(rootOpts))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		assert.Len(t, results, tc.results)
+	}
+}
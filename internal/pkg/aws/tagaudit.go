@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	tagoptions "github.com/bilalcaliskan/s3-manager/cmd/tags/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/bucketinfo"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/tagging"
+	"github.com/pkg/errors"
+)
+
+// AuditTags checks the tags of the buckets against the required tags of the Policy.
+//
+// It accepts an S3API interface, TagOptions, the Policy and the names of the buckets as arguments. Each bucket is
+// audited in its own region, if the 'Objects' option is set the objects of the bucket under the 'Prefix' are also
+// audited. A bucket whose tags could not be read is left out of the results, a bucket whose objects could not be
+// listed or tagged is kept with the violations found so far. Their errors are returned alongside the results.
+func AuditTags(svc internalawstypes.S3ClientAPI, opts *tagoptions.TagOptions, policy *tagging.Policy, buckets []string) ([]tagging.BucketResult, []error) {
+	var (
+		results []tagging.BucketResult
+		errs    []error
+	)
+
+	for _, bucket := range buckets {
+		result, objectErrs, err := auditBucketTags(svc, opts, policy, bucket)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		results = append(results, result)
+		errs = append(errs, objectErrs...)
+	}
+
+	return results, errs
+}
+
+// auditBucketTags audits the tags of a single bucket, and of its objects if the 'Objects' option is set. The errors
+// of the objects do not discard the result of the bucket, they are returned separately.
+func auditBucketTags(svc internalawstypes.S3ClientAPI, opts *tagoptions.TagOptions, policy *tagging.Policy, bucket string) (tagging.BucketResult, []error, error) {
+	result := tagging.BucketResult{Bucket: bucket}
+	location, err := svc.GetBucketLocation(context.Background(), &s3.GetBucketLocationInput{Bucket: aws.String(bucket)})
+	if err != nil {
+		return result, nil, errors.Wrapf(err, "an error occurred while getting location of %s", bucket)
+	}
+
+	result.Region = bucketinfo.RegionOf(location.LocationConstraint)
+	inRegion := InRegion(result.Region)
+
+	tags, err := svc.GetBucketTagging(context.Background(), &s3.GetBucketTaggingInput{Bucket: aws.String(bucket)}, inRegion)
+	if err != nil && !isErrorCode(err, "NoSuchTagSet") {
+		return result, nil, errors.Wrapf(err, "an error occurred while getting tags of %s", bucket)
+	}
+
+	result.Tags = make(map[string]string)
+	if err == nil {
+		result.Tags = tagging.FromTagSet(tags.TagSet)
+	}
+
+	for _, violation := range policy.Audit(result.Tags) {
+		violation.Bucket = bucket
+		result.Violations = append(result.Violations, violation)
+	}
+
+	if !opts.Objects {
+		return result, nil, nil
+	}
+
+	objects, err := ListObjectsWithPrefix(svc, bucket, opts.Prefix, 0, inRegion)
+	if err != nil {
+		return result, []error{errors.Wrapf(err, "an error occurred while listing objects of %s", bucket)}, nil
+	}
+
+	var objectErrs []error
+	for _, object := range objects {
+		res, err := svc.GetObjectTagging(context.Background(), &s3.GetObjectTaggingInput{
+			Bucket: aws.String(bucket),
+			Key:    object.Key,
+		}, inRegion)
+		if err != nil {
+			objectErrs = append(objectErrs, errors.Wrapf(err, "an error occurred while getting tags of %s/%s", bucket,
+				aws.ToString(object.Key)))
+			continue
+		}
+
+		for _, violation := range policy.Audit(tagging.FromTagSet(res.TagSet)) {
+			violation.Bucket = bucket
+			violation.Object = aws.ToString(object.Key)
+			result.Violations = append(result.Violations, violation)
+		}
+	}
+
+	return result, objectErrs, nil
+}
@@ -0,0 +1,100 @@
+//go:build unit
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	tagoptions "github.com/bilalcaliskan/s3-manager/cmd/tags/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/tagging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditTags(t *testing.T) {
+	policy, err := tagging.ReadPolicyFile("../../../testdata/required-tags.yaml")
+	assert.Nil(t, err)
+
+	getBucketLocationFunc := func(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+		if aws.ToString(params.Bucket) == "missing" {
+			return nil, constants.ErrInjected
+		}
+
+		return &s3.GetBucketLocationOutput{LocationConstraint: types.BucketLocationConstraintEu}, nil
+	}
+	getBucketTaggingFunc := func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+		if aws.ToString(params.Bucket) == "untagged" {
+			return nil, &smithy.GenericAPIError{Code: "NoSuchTagSet"}
+		}
+
+		return &s3.GetBucketTaggingOutput{TagSet: []types.Tag{
+			{Key: aws.String("cost-center"), Value: aws.String("1234")},
+			{Key: aws.String("owner"), Value: aws.String("jane@example.com")},
+			{Key: aws.String("env"), Value: aws.String("test")},
+		}}, nil
+	}
+	listObjectsV2Func := func(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+		return &s3.ListObjectsV2Output{Contents: []types.Object{{Key: aws.String("logs/a.txt")}}, IsTruncated: aws.Bool(false)}, nil
+	}
+	getObjectTaggingFunc := func(ctx context.Context, params *s3.GetObjectTaggingInput, optFns ...func(*s3.Options)) (*s3.GetObjectTaggingOutput, error) {
+		return &s3.GetObjectTaggingOutput{TagSet: []types.Tag{
+			{Key: aws.String("cost-center"), Value: aws.String("1234")},
+			{Key: aws.String("owner"), Value: aws.String("jane@example.com")},
+		}}, nil
+	}
+
+	cases := []struct {
+		caseName             string
+		buckets              []string
+		objects              bool
+		results              int
+		violations           int
+		errs                 int
+		getBucketTaggingFunc func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error)
+		getObjectTaggingFunc func(ctx context.Context, params *s3.GetObjectTaggingInput, optFns ...func(*s3.Options)) (*s3.GetObjectTaggingOutput, error)
+	}{
+		{"Success", []string{"tagged", "untagged"}, false, 2, 4, 0, getBucketTaggingFunc, nil},
+		{"Success with objects", []string{"tagged"}, true, 1, 2, 0, getBucketTaggingFunc, getObjectTaggingFunc},
+		{"Success with location error", []string{"missing", "tagged"}, false, 1, 1, 1, getBucketTaggingFunc, nil},
+		{"Failure caused by get bucket tagging error", []string{"tagged"}, false, 0, 0, 1,
+			func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+				return nil, constants.ErrInjected
+			}, nil,
+		},
+		{"Failure caused by get object tagging error", []string{"tagged", "untagged"}, true, 2, 4, 2, getBucketTaggingFunc,
+			func(ctx context.Context, params *s3.GetObjectTaggingInput, optFns ...func(*s3.Options)) (*s3.GetObjectTaggingOutput, error) {
+				return nil, constants.ErrInjected
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.GetBucketLocationAPI = getBucketLocationFunc
+		mockS3.GetBucketTaggingAPI = tc.getBucketTaggingFunc
+		mockS3.ListObjectsV2API = listObjectsV2Func
+		mockS3.GetObjectTaggingAPI = tc.getObjectTaggingFunc
+
+		opts := &tagoptions.TagOptions{RootOptions: options.GetMockedRootOptions(), Objects: tc.objects}
+		results, errs := AuditTags(mockS3, opts, policy, tc.buckets)
+		assert.Len(t, results, tc.results)
+		assert.Len(t, errs, tc.errs)
+
+		violations := 0
+		for _, result := range results {
+			assert.Equal(t, "eu-west-1", result.Region)
+			violations += len(result.Violations)
+		}
+
+		assert.Equal(t, tc.violations, violations)
+	}
+}
@@ -0,0 +1,85 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	transitionoptions "github.com/bilalcaliskan/s3-manager/cmd/transition/options"
+	transitionutils "github.com/bilalcaliskan/s3-manager/cmd/transition/utils"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/storageclass"
+	"github.com/rs/zerolog"
+)
+
+// TransitionObjects moves the given objects of an S3 bucket to the 'To' storage class of TransitionOptions by
+// copying them onto themselves.
+//
+// It accepts an S3API interface, TransitionOptions, the planned transitions, a PromptRunner, and a Logger as
+// arguments, and follows the same 'DryRun' and 'AutoApprove' semantics with DeleteObjectsInBatches. The metadata and
+// the tags of the objects are copied, their server-side encryption is carried over from HeadObject since CopyObject
+// would otherwise apply the default encryption of the bucket. Unlike the other operations it does not stop at the
+// first error, the outcome of each object is returned and the failed ones are reported with a single error.
+func TransitionObjects(svc internalawstypes.S3ClientAPI, opts *transitionoptions.TransitionOptions, transitions []storageclass.Transition, runner prompt.PromptRunner, logger zerolog.Logger) ([]storageclass.Result, error) {
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return nil, nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return nil, err
+		}
+	}
+
+	var failed int
+	results := make([]storageclass.Result, 0, len(transitions))
+	for _, transition := range transitions {
+		result := storageclass.Result{Key: transition.Key, From: transition.From, To: opts.To}
+		result.Err = transitionObject(svc, opts, transition.Key)
+		if result.Err != nil {
+			failed++
+		}
+
+		results = append(results, result)
+	}
+
+	if failed > 0 {
+		return results, fmt.Errorf(transitionutils.ErrObjectsNotMoved, failed)
+	}
+
+	return results, nil
+}
+
+// transitionObject copies a single object onto itself in the 'To' storage class of TransitionOptions.
+func transitionObject(svc internalawstypes.S3ClientAPI, opts *transitionoptions.TransitionOptions, key string) error {
+	head, err := svc.HeadObject(context.Background(), &s3.HeadObjectInput{
+		Bucket: aws.String(opts.BucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return err
+	}
+
+	input := &s3.CopyObjectInput{
+		Bucket:       aws.String(opts.BucketName),
+		Key:          aws.String(key),
+		CopySource:   aws.String(url.PathEscape(opts.BucketName + "/" + key)),
+		StorageClass: types.StorageClass(opts.To),
+	}
+
+	if head.ServerSideEncryption != "" {
+		input.ServerSideEncryption = head.ServerSideEncryption
+		input.SSEKMSKeyId = head.SSEKMSKeyId
+		input.BucketKeyEnabled = head.BucketKeyEnabled
+	}
+
+	_, err = svc.CopyObject(context.Background(), input)
+
+	return err
+}
@@ -0,0 +1,117 @@
+//go:build unit
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	transitionoptions "github.com/bilalcaliskan/s3-manager/cmd/transition/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/logging"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/storageclass"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransitionObjects(t *testing.T) {
+	transitions := []storageclass.Transition{
+		{Key: "logs/app 1.log", From: storageclass.Standard},
+		{Key: "logs/app2.log", From: storageclass.StandardIA},
+	}
+
+	headObjectFunc := func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+		if aws.ToString(params.Key) == "logs/app 1.log" {
+			return &s3.HeadObjectOutput{ServerSideEncryption: types.ServerSideEncryptionAwsKms, SSEKMSKeyId: aws.String("key-1")}, nil
+		}
+
+		return &s3.HeadObjectOutput{}, nil
+	}
+
+	copyObjectFunc := func(ctx context.Context, params *s3.CopyObjectInput, optFns ...func(*s3.Options)) (*s3.CopyObjectOutput, error) {
+		if params.StorageClass != types.StorageClassGlacierIr {
+			return nil, constants.ErrInjected
+		}
+
+		switch aws.ToString(params.Key) {
+		case "logs/app 1.log":
+			if aws.ToString(params.CopySource) != "thisisbucketname%2Flogs%2Fapp%201.log" ||
+				aws.ToString(params.SSEKMSKeyId) != "key-1" {
+				return nil, constants.ErrInjected
+			}
+		case "logs/app2.log":
+			if params.ServerSideEncryption != "" {
+				return nil, constants.ErrInjected
+			}
+		}
+
+		return &s3.CopyObjectOutput{}, nil
+	}
+
+	cases := []struct {
+		caseName       string
+		headObjectFunc func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+		copyObjectFunc func(ctx context.Context, params *s3.CopyObjectInput, optFns ...func(*s3.Options)) (*s3.CopyObjectOutput, error)
+		prompt.PromptRunner
+		dryRun      bool
+		autoApprove bool
+		shouldPass  bool
+		results     int
+		failed      int
+	}{
+		{"Success", headObjectFunc, copyObjectFunc, prompt.PromptMock{Msg: "y"}, false, false, true, 2, 0},
+		{"Success with dry run", nil, nil, nil, true, false, true, 0, 0},
+		{"Failure caused by copy error of a single object", headObjectFunc,
+			func(ctx context.Context, params *s3.CopyObjectInput, optFns ...func(*s3.Options)) (*s3.CopyObjectOutput, error) {
+				if aws.ToString(params.Key) == "logs/app2.log" {
+					return nil, constants.ErrInjected
+				}
+
+				return &s3.CopyObjectOutput{}, nil
+			}, nil, false, true, false, 2, 1,
+		},
+		{"Failure caused by head error",
+			func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+				return nil, constants.ErrInjected
+			}, nil, nil, false, true, false, 2, 2,
+		},
+		{"Failure caused by user terminated the process", nil, nil, prompt.PromptMock{Msg: "n", Err: constants.ErrInjected},
+			false, false, false, 0, 0},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.HeadObjectAPI = tc.headObjectFunc
+		mockS3.CopyObjectAPI = tc.copyObjectFunc
+
+		rootOpts := options.GetMockedRootOptions()
+		rootOpts.DryRun = tc.dryRun
+		rootOpts.AutoApprove = tc.autoApprove
+		opts := &transitionoptions.TransitionOptions{RootOptions: rootOpts, To: storageclass.GlacierIR}
+
+		results, err := TransitionObjects(mockS3, opts, transitions, tc.PromptRunner, logging.GetLogger(rootOpts))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+
+		assert.Len(t, results, tc.results)
+
+		var failed int
+		for _, result := range results {
+			if result.Err != nil {
+				failed++
+			}
+		}
+
+		assert.Equal(t, tc.failed, failed)
+	}
+}
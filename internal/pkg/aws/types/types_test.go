@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/stretchr/testify/assert"
 )
@@ -34,6 +35,19 @@ func TestMockS3Client_GetObject(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestMockS3Client_ListObjectsV2(t *testing.T) {
+	f := func(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+		return &s3.ListObjectsV2Output{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.ListObjectsV2API = f
+
+	res, err := mock.ListObjectsV2(context.Background(), &s3.ListObjectsV2Input{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
 func TestMockS3Client_ListObjects(t *testing.T) {
 	f := func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
 		return &s3.ListObjectsOutput{}, nil
@@ -152,3 +166,588 @@ func TestMockS3Client_DeleteBucketTagging(t *testing.T) {
 	assert.NotNil(t, res)
 	assert.Nil(t, err)
 }
+
+func TestMockS3Client_GetBucketEncryption(t *testing.T) {
+	f := func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+		return &s3.GetBucketEncryptionOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.GetBucketEncryptionAPI = f
+
+	res, err := mock.GetBucketEncryption(context.Background(), &s3.GetBucketEncryptionInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_PutBucketEncryption(t *testing.T) {
+	f := func(ctx context.Context, params *s3.PutBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error) {
+		return &s3.PutBucketEncryptionOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.PutBucketEncryptionAPI = f
+
+	res, err := mock.PutBucketEncryption(context.Background(), &s3.PutBucketEncryptionInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_DeleteBucketEncryption(t *testing.T) {
+	f := func(ctx context.Context, params *s3.DeleteBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketEncryptionOutput, error) {
+		return &s3.DeleteBucketEncryptionOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.DeleteBucketEncryptionAPI = f
+
+	res, err := mock.DeleteBucketEncryption(context.Background(), &s3.DeleteBucketEncryptionInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_GetPublicAccessBlock(t *testing.T) {
+	f := func(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+		return &s3.GetPublicAccessBlockOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.GetPublicAccessBlockAPI = f
+
+	res, err := mock.GetPublicAccessBlock(context.Background(), &s3.GetPublicAccessBlockInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_PutPublicAccessBlock(t *testing.T) {
+	f := func(ctx context.Context, params *s3.PutPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error) {
+		return &s3.PutPublicAccessBlockOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.PutPublicAccessBlockAPI = f
+
+	res, err := mock.PutPublicAccessBlock(context.Background(), &s3.PutPublicAccessBlockInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_GetBucketAcl(t *testing.T) {
+	f := func(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+		return &s3.GetBucketAclOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.GetBucketAclAPI = f
+
+	res, err := mock.GetBucketAcl(context.Background(), &s3.GetBucketAclInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_GetObjectAcl(t *testing.T) {
+	f := func(ctx context.Context, params *s3.GetObjectAclInput, optFns ...func(*s3.Options)) (*s3.GetObjectAclOutput, error) {
+		return &s3.GetObjectAclOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.GetObjectAclAPI = f
+
+	res, err := mock.GetObjectAcl(context.Background(), &s3.GetObjectAclInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_GetObjectTagging(t *testing.T) {
+	f := func(ctx context.Context, params *s3.GetObjectTaggingInput, optFns ...func(*s3.Options)) (*s3.GetObjectTaggingOutput, error) {
+		return &s3.GetObjectTaggingOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.GetObjectTaggingAPI = f
+
+	res, err := mock.GetObjectTagging(context.Background(), &s3.GetObjectTaggingInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_GetBucketCors(t *testing.T) {
+	f := func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+		return &s3.GetBucketCorsOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.GetBucketCorsAPI = f
+
+	res, err := mock.GetBucketCors(context.Background(), &s3.GetBucketCorsInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_PutBucketCors(t *testing.T) {
+	f := func(ctx context.Context, params *s3.PutBucketCorsInput, optFns ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error) {
+		return &s3.PutBucketCorsOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.PutBucketCorsAPI = f
+
+	res, err := mock.PutBucketCors(context.Background(), &s3.PutBucketCorsInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_DeleteBucketCors(t *testing.T) {
+	f := func(ctx context.Context, params *s3.DeleteBucketCorsInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketCorsOutput, error) {
+		return &s3.DeleteBucketCorsOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.DeleteBucketCorsAPI = f
+
+	res, err := mock.DeleteBucketCors(context.Background(), &s3.DeleteBucketCorsInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_GetBucketWebsite(t *testing.T) {
+	f := func(ctx context.Context, params *s3.GetBucketWebsiteInput, optFns ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error) {
+		return &s3.GetBucketWebsiteOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.GetBucketWebsiteAPI = f
+
+	res, err := mock.GetBucketWebsite(context.Background(), &s3.GetBucketWebsiteInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_PutBucketWebsite(t *testing.T) {
+	f := func(ctx context.Context, params *s3.PutBucketWebsiteInput, optFns ...func(*s3.Options)) (*s3.PutBucketWebsiteOutput, error) {
+		return &s3.PutBucketWebsiteOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.PutBucketWebsiteAPI = f
+
+	res, err := mock.PutBucketWebsite(context.Background(), &s3.PutBucketWebsiteInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_DeleteBucketWebsite(t *testing.T) {
+	f := func(ctx context.Context, params *s3.DeleteBucketWebsiteInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketWebsiteOutput, error) {
+		return &s3.DeleteBucketWebsiteOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.DeleteBucketWebsiteAPI = f
+
+	res, err := mock.DeleteBucketWebsite(context.Background(), &s3.DeleteBucketWebsiteInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_GetBucketReplication(t *testing.T) {
+	f := func(ctx context.Context, params *s3.GetBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
+		return &s3.GetBucketReplicationOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.GetBucketReplicationAPI = f
+
+	res, err := mock.GetBucketReplication(context.Background(), &s3.GetBucketReplicationInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_PutBucketReplication(t *testing.T) {
+	f := func(ctx context.Context, params *s3.PutBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.PutBucketReplicationOutput, error) {
+		return &s3.PutBucketReplicationOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.PutBucketReplicationAPI = f
+
+	res, err := mock.PutBucketReplication(context.Background(), &s3.PutBucketReplicationInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_DeleteBucketReplication(t *testing.T) {
+	f := func(ctx context.Context, params *s3.DeleteBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketReplicationOutput, error) {
+		return &s3.DeleteBucketReplicationOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.DeleteBucketReplicationAPI = f
+
+	res, err := mock.DeleteBucketReplication(context.Background(), &s3.DeleteBucketReplicationInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_HeadObject(t *testing.T) {
+	f := func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+		return &s3.HeadObjectOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.HeadObjectAPI = f
+
+	res, err := mock.HeadObject(context.Background(), &s3.HeadObjectInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_CopyObject(t *testing.T) {
+	f := func(ctx context.Context, params *s3.CopyObjectInput, optFns ...func(*s3.Options)) (*s3.CopyObjectOutput, error) {
+		return &s3.CopyObjectOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.CopyObjectAPI = f
+
+	res, err := mock.CopyObject(context.Background(), &s3.CopyObjectInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_RestoreObject(t *testing.T) {
+	f := func(ctx context.Context, params *s3.RestoreObjectInput, optFns ...func(*s3.Options)) (*s3.RestoreObjectOutput, error) {
+		return &s3.RestoreObjectOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.RestoreObjectAPI = f
+
+	res, err := mock.RestoreObject(context.Background(), &s3.RestoreObjectInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_GetObjectLockConfiguration(t *testing.T) {
+	f := func(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+		return &s3.GetObjectLockConfigurationOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.GetObjectLockConfigurationAPI = f
+
+	res, err := mock.GetObjectLockConfiguration(context.Background(), &s3.GetObjectLockConfigurationInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_PutObjectLockConfiguration(t *testing.T) {
+	f := func(ctx context.Context, params *s3.PutObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
+		return &s3.PutObjectLockConfigurationOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.PutObjectLockConfigurationAPI = f
+
+	res, err := mock.PutObjectLockConfiguration(context.Background(), &s3.PutObjectLockConfigurationInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_GetObjectRetention(t *testing.T) {
+	f := func(ctx context.Context, params *s3.GetObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.GetObjectRetentionOutput, error) {
+		return &s3.GetObjectRetentionOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.GetObjectRetentionAPI = f
+
+	res, err := mock.GetObjectRetention(context.Background(), &s3.GetObjectRetentionInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_PutObjectRetention(t *testing.T) {
+	f := func(ctx context.Context, params *s3.PutObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.PutObjectRetentionOutput, error) {
+		return &s3.PutObjectRetentionOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.PutObjectRetentionAPI = f
+
+	res, err := mock.PutObjectRetention(context.Background(), &s3.PutObjectRetentionInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_GetObjectLegalHold(t *testing.T) {
+	f := func(ctx context.Context, params *s3.GetObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.GetObjectLegalHoldOutput, error) {
+		return &s3.GetObjectLegalHoldOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.GetObjectLegalHoldAPI = f
+
+	res, err := mock.GetObjectLegalHold(context.Background(), &s3.GetObjectLegalHoldInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_PutObjectLegalHold(t *testing.T) {
+	f := func(ctx context.Context, params *s3.PutObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.PutObjectLegalHoldOutput, error) {
+		return &s3.PutObjectLegalHoldOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.PutObjectLegalHoldAPI = f
+
+	res, err := mock.PutObjectLegalHold(context.Background(), &s3.PutObjectLegalHoldInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_GetBucketLogging(t *testing.T) {
+	f := func(ctx context.Context, params *s3.GetBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+		return &s3.GetBucketLoggingOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.GetBucketLoggingAPI = f
+
+	res, err := mock.GetBucketLogging(context.Background(), &s3.GetBucketLoggingInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_PutBucketLogging(t *testing.T) {
+	f := func(ctx context.Context, params *s3.PutBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error) {
+		return &s3.PutBucketLoggingOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.PutBucketLoggingAPI = f
+
+	res, err := mock.PutBucketLogging(context.Background(), &s3.PutBucketLoggingInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_ListBucketInventoryConfigurations(t *testing.T) {
+	f := func(ctx context.Context, params *s3.ListBucketInventoryConfigurationsInput, optFns ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+		return &s3.ListBucketInventoryConfigurationsOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.ListBucketInventoryConfigurationsAPI = f
+
+	res, err := mock.ListBucketInventoryConfigurations(context.Background(), &s3.ListBucketInventoryConfigurationsInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_GetBucketInventoryConfiguration(t *testing.T) {
+	f := func(ctx context.Context, params *s3.GetBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketInventoryConfigurationOutput, error) {
+		return &s3.GetBucketInventoryConfigurationOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.GetBucketInventoryConfigurationAPI = f
+
+	res, err := mock.GetBucketInventoryConfiguration(context.Background(), &s3.GetBucketInventoryConfigurationInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_PutBucketInventoryConfiguration(t *testing.T) {
+	f := func(ctx context.Context, params *s3.PutBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error) {
+		return &s3.PutBucketInventoryConfigurationOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.PutBucketInventoryConfigurationAPI = f
+
+	res, err := mock.PutBucketInventoryConfiguration(context.Background(), &s3.PutBucketInventoryConfigurationInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_DeleteBucketInventoryConfiguration(t *testing.T) {
+	f := func(ctx context.Context, params *s3.DeleteBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error) {
+		return &s3.DeleteBucketInventoryConfigurationOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.DeleteBucketInventoryConfigurationAPI = f
+
+	res, err := mock.DeleteBucketInventoryConfiguration(context.Background(), &s3.DeleteBucketInventoryConfigurationInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_GetBucketNotificationConfiguration(t *testing.T) {
+	f := func(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+		return &s3.GetBucketNotificationConfigurationOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.GetBucketNotificationConfigurationAPI = f
+
+	res, err := mock.GetBucketNotificationConfiguration(context.Background(), &s3.GetBucketNotificationConfigurationInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_PutBucketNotificationConfiguration(t *testing.T) {
+	f := func(ctx context.Context, params *s3.PutBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error) {
+		return &s3.PutBucketNotificationConfigurationOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.PutBucketNotificationConfigurationAPI = f
+
+	res, err := mock.PutBucketNotificationConfiguration(context.Background(), &s3.PutBucketNotificationConfigurationInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_CreateBucket(t *testing.T) {
+	f := func(ctx context.Context, params *s3.CreateBucketInput, optFns ...func(*s3.Options)) (*s3.CreateBucketOutput, error) {
+		return &s3.CreateBucketOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.CreateBucketAPI = f
+
+	res, err := mock.CreateBucket(context.Background(), &s3.CreateBucketInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_DeleteBucket(t *testing.T) {
+	f := func(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
+		return &s3.DeleteBucketOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.DeleteBucketAPI = f
+
+	res, err := mock.DeleteBucket(context.Background(), &s3.DeleteBucketInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_ListObjectVersions(t *testing.T) {
+	f := func(ctx context.Context, params *s3.ListObjectVersionsInput, optFns ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error) {
+		return &s3.ListObjectVersionsOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.ListObjectVersionsAPI = f
+
+	res, err := mock.ListObjectVersions(context.Background(), &s3.ListObjectVersionsInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_DeleteObjects(t *testing.T) {
+	f := func(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
+		return &s3.DeleteObjectsOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.DeleteObjectsAPI = f
+
+	res, err := mock.DeleteObjects(context.Background(), &s3.DeleteObjectsInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_ListBuckets(t *testing.T) {
+	f := func(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+		return &s3.ListBucketsOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.ListBucketsAPI = f
+
+	res, err := mock.ListBuckets(context.Background(), &s3.ListBucketsInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_GetBucketLocation(t *testing.T) {
+	f := func(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+		return &s3.GetBucketLocationOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.GetBucketLocationAPI = f
+
+	res, err := mock.GetBucketLocation(context.Background(), &s3.GetBucketLocationInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_GetBucketLifecycleConfiguration(t *testing.T) {
+	f := func(ctx context.Context, params *s3.GetBucketLifecycleConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+		return &s3.GetBucketLifecycleConfigurationOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.GetBucketLifecycleConfigurationAPI = f
+
+	res, err := mock.GetBucketLifecycleConfiguration(context.Background(), &s3.GetBucketLifecycleConfigurationInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_PutBucketLifecycleConfiguration(t *testing.T) {
+	f := func(ctx context.Context, params *s3.PutBucketLifecycleConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error) {
+		return &s3.PutBucketLifecycleConfigurationOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.PutBucketLifecycleConfigurationAPI = f
+
+	res, err := mock.PutBucketLifecycleConfiguration(context.Background(), &s3.PutBucketLifecycleConfigurationInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_DeleteBucketLifecycle(t *testing.T) {
+	f := func(ctx context.Context, params *s3.DeleteBucketLifecycleInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketLifecycleOutput, error) {
+		return &s3.DeleteBucketLifecycleOutput{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.DeleteBucketLifecycleAPI = f
+
+	res, err := mock.DeleteBucketLifecycle(context.Background(), &s3.DeleteBucketLifecycleInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_PresignGetObject(t *testing.T) {
+	f := func(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+		return &v4.PresignedHTTPRequest{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.PresignGetObjectAPI = f
+
+	res, err := mock.PresignGetObject(context.Background(), &s3.GetObjectInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
+
+func TestMockS3Client_PresignPutObject(t *testing.T) {
+	f := func(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+		return &v4.PresignedHTTPRequest{}, nil
+	}
+
+	mock := new(MockS3Client)
+	mock.PresignPutObjectAPI = f
+
+	res, err := mock.PresignPutObject(context.Background(), &s3.PutObjectInput{})
+	assert.NotNil(t, res)
+	assert.Nil(t, err)
+}
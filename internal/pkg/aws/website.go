@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	bucketpolicyoptions "github.com/bilalcaliskan/s3-manager/cmd/bucketpolicy/options"
+	publicaccessoptions "github.com/bilalcaliskan/s3-manager/cmd/publicaccess/options"
+	websiteoptions "github.com/bilalcaliskan/s3-manager/cmd/website/options"
+	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/website"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// GetWebsiteConfiguration retrieves the website configuration of an S3 bucket.
+//
+// It accepts an S3API interface and WebsiteOptions as arguments, and returns the parsed Configuration and any
+// error encountered. A bucket without website hosting is reported with an empty Configuration instead of an error.
+func GetWebsiteConfiguration(svc internalawstypes.S3ClientAPI, opts *websiteoptions.WebsiteOptions) (*website.Configuration, error) {
+	res, err := svc.GetBucketWebsite(context.Background(), &s3.GetBucketWebsiteInput{
+		Bucket: aws.String(opts.BucketName),
+	})
+
+	if err != nil {
+		if isErrorCode(err, "NoSuchWebsiteConfiguration") {
+			return website.FromSDK(nil), nil
+		}
+
+		return nil, err
+	}
+
+	return website.FromSDK(res), nil
+}
+
+// SetBucketWebsite replaces the website configuration of an S3 bucket with the Configuration of WebsiteOptions.
+//
+// It accepts an S3API interface, WebsiteOptions, a PromptRunner, and a Logger as arguments.
+// The Configuration is validated before anything else, then the 'DryRun' and 'AutoApprove' options are handled
+// just like SetBucketPolicy before putting the configuration.
+func SetBucketWebsite(svc internalawstypes.S3ClientAPI, opts *websiteoptions.WebsiteOptions, runner prompt.PromptRunner, logger zerolog.Logger) (res *s3.PutBucketWebsiteOutput, err error) {
+	if err := opts.Configuration.Validate(); err != nil {
+		return res, err
+	}
+
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return res, nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return res, err
+		}
+	}
+
+	return svc.PutBucketWebsite(context.Background(), &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(opts.BucketName),
+		WebsiteConfiguration: opts.Configuration.ToSDK(),
+	})
+}
+
+// DeleteBucketWebsite removes the website configuration of an S3 bucket, which disables website hosting.
+//
+// It accepts an S3API interface, WebsiteOptions, a PromptRunner, and a Logger as arguments, and follows the same
+// 'DryRun' and 'AutoApprove' semantics with SetBucketWebsite.
+func DeleteBucketWebsite(svc internalawstypes.S3ClientAPI, opts *websiteoptions.WebsiteOptions, runner prompt.PromptRunner, logger zerolog.Logger) (res *s3.DeleteBucketWebsiteOutput, err error) {
+	if opts.DryRun {
+		logger.Info().Msg(constants.InfDryRun)
+		return res, nil
+	}
+
+	if !opts.AutoApprove {
+		if err := prompt.AskForApproval(runner); err != nil {
+			return res, err
+		}
+	}
+
+	return svc.DeleteBucketWebsite(context.Background(), &s3.DeleteBucketWebsiteInput{
+		Bucket: aws.String(opts.BucketName),
+	})
+}
+
+// GetWebsiteWarnings fetches the bucket policy and the public access block configuration of an S3 bucket, and
+// returns the reasons that would prevent its website endpoint from serving the objects.
+func GetWebsiteWarnings(svc internalawstypes.S3ClientAPI, opts *websiteoptions.WebsiteOptions) ([]string, error) {
+	doc, err := GetBucketPolicyDocument(svc, &bucketpolicyoptions.BucketPolicyOptions{RootOptions: opts.RootOptions})
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := GetPublicAccessBlock(svc, &publicaccessoptions.PublicAccessOptions{RootOptions: opts.RootOptions})
+	if err != nil {
+		return nil, errors.Wrap(err, "an error occurred while getting public access block")
+	}
+
+	return website.CheckServable(doc, block.PublicAccessBlockConfiguration), nil
+}
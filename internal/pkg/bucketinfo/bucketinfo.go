@@ -0,0 +1,118 @@
+package bucketinfo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+const (
+	// DefaultRegion is the region of the buckets that are reported without a location constraint
+	DefaultRegion = "us-east-1"
+
+	// StatusUnknown is reported for the settings that could not be fetched
+	StatusUnknown = "unknown"
+	StatusNone    = "none"
+	StatusPresent = "present"
+)
+
+// Info is the summary of a bucket reported by the buckets list command.
+type Info struct {
+	Name         string
+	Region       string
+	CreationDate time.Time
+	Versioning   string
+	Encryption   string
+	Acceleration string
+	Policy       string
+}
+
+// String returns a single line representation of the Info, the statuses are only included if details is set.
+func (i Info) String(details bool) string {
+	parts := []string{
+		fmt.Sprintf("name=%s", i.Name),
+		fmt.Sprintf("region=%s", i.Region),
+		fmt.Sprintf("created=%s", i.CreationDate.UTC().Format(time.RFC3339)),
+	}
+
+	if details {
+		parts = append(parts,
+			fmt.Sprintf("versioning=%s", i.Versioning),
+			fmt.Sprintf("encryption=%s", i.Encryption),
+			fmt.Sprintf("acceleration=%s", i.Acceleration),
+			fmt.Sprintf("policy=%s", i.Policy),
+		)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// Setting is a single named setting of a Report.
+type Setting struct {
+	Name  string
+	Value string
+}
+
+// Report is the consolidated list of the settings of a bucket, in the order they are printed.
+type Report []Setting
+
+// Lines returns the settings of the Report as "name: value" lines with the values aligned.
+func (r Report) Lines() []string {
+	width := 0
+	for _, setting := range r {
+		if len(setting.Name) > width {
+			width = len(setting.Name)
+		}
+	}
+
+	lines := make([]string, 0, len(r))
+	for _, setting := range r {
+		lines = append(lines, fmt.Sprintf("%-*s %s", width+1, setting.Name+":", setting.Value))
+	}
+
+	return lines
+}
+
+// RegionOf returns the region of a bucket from its location constraint. Buckets in us-east-1 have an empty
+// location constraint and the buckets created with the legacy "EU" constraint are in eu-west-1.
+func RegionOf(constraint types.BucketLocationConstraint) string {
+	switch constraint {
+	case "":
+		return DefaultRegion
+	case types.BucketLocationConstraintEu:
+		return "eu-west-1"
+	default:
+		return string(constraint)
+	}
+}
+
+// VersioningStatus returns the human-readable versioning status, buckets that have never been versioned are
+// reported as Disabled.
+func VersioningStatus(status types.BucketVersioningStatus) string {
+	if status == "" {
+		return "Disabled"
+	}
+
+	return string(status)
+}
+
+// AccelerationStatus returns the human-readable transfer acceleration status, buckets that have never been
+// accelerated are reported as Disabled.
+func AccelerationStatus(status types.BucketAccelerateStatus) string {
+	if status == "" {
+		return "Disabled"
+	}
+
+	return string(status)
+}
+
+// EncryptionStatus returns the algorithm of the default encryption rule, or none if there is no rule.
+func EncryptionStatus(cfg *types.ServerSideEncryptionConfiguration) string {
+	if cfg == nil || len(cfg.Rules) == 0 || cfg.Rules[0].ApplyServerSideEncryptionByDefault == nil {
+		return StatusNone
+	}
+
+	return string(cfg.Rules[0].ApplyServerSideEncryptionByDefault.SSEAlgorithm)
+}
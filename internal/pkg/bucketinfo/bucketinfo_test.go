@@ -0,0 +1,60 @@
+//go:build unit
+
+package bucketinfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInfo_String(t *testing.T) {
+	info := Info{
+		Name:         "thisisbucketname",
+		Region:       "eu-west-1",
+		CreationDate: time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC),
+		Versioning:   "Enabled",
+		Encryption:   "AES256",
+		Acceleration: "Disabled",
+		Policy:       StatusNone,
+	}
+
+	assert.Equal(t, "name=thisisbucketname, region=eu-west-1, created=2023-05-01T10:00:00Z", info.String(false))
+	assert.Equal(t, "name=thisisbucketname, region=eu-west-1, created=2023-05-01T10:00:00Z, versioning=Enabled, "+
+		"encryption=AES256, acceleration=Disabled, policy=none", info.String(true))
+}
+
+func TestReport_Lines(t *testing.T) {
+	report := Report{
+		{Name: "region", Value: "eu-west-1"},
+		{Name: "transfer acceleration", Value: "Enabled"},
+	}
+
+	assert.Equal(t, []string{
+		"region:                eu-west-1",
+		"transfer acceleration: Enabled",
+	}, report.Lines())
+	assert.Empty(t, Report{}.Lines())
+}
+
+func TestRegionOf(t *testing.T) {
+	assert.Equal(t, DefaultRegion, RegionOf(""))
+	assert.Equal(t, "eu-west-1", RegionOf(types.BucketLocationConstraintEu))
+	assert.Equal(t, "ap-south-1", RegionOf(types.BucketLocationConstraintApSouth1))
+}
+
+func TestStatuses(t *testing.T) {
+	assert.Equal(t, "Disabled", VersioningStatus(""))
+	assert.Equal(t, "Suspended", VersioningStatus(types.BucketVersioningStatusSuspended))
+	assert.Equal(t, "Disabled", AccelerationStatus(""))
+	assert.Equal(t, "Enabled", AccelerationStatus(types.BucketAccelerateStatusEnabled))
+	assert.Equal(t, StatusNone, EncryptionStatus(nil))
+	assert.Equal(t, StatusNone, EncryptionStatus(&types.ServerSideEncryptionConfiguration{}))
+	assert.Equal(t, "aws:kms", EncryptionStatus(&types.ServerSideEncryptionConfiguration{
+		Rules: []types.ServerSideEncryptionRule{{
+			ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{SSEAlgorithm: types.ServerSideEncryptionAwsKms},
+		}},
+	}))
+}
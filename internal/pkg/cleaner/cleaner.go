@@ -17,7 +17,8 @@ import (
 //
 // The function requires an S3 service, a prompt runner, clean options, and a logger as parameters.
 // The function first retrieves the list of desired objects (files) from the specified AWS S3 bucket that match the
-// provided regular expression. If an error occurs during retrieval, it immediately returns the error.
+// provided regular expression. If an error occurs during retrieval, it immediately returns the error. The retrieved
+// objects are then filtered by the key prefix and minimum age specified in the CleanOptions.
 // The retrieved objects are sorted according to the configuration specified in the CleanOptions.
 //
 // The function then calculates the border index in the sorted array from which deletion should start, which is
@@ -26,7 +27,9 @@ import (
 // the function returns without deleting any files.
 //
 // Next, it prepares a list of target objects (files) to delete based on the border index calculated previously.
-// The file names (keys) of these target objects are extracted and logged for information.
+// If Object Lock is enabled on the bucket, the target objects that are still under retention or legal hold are
+// reported with a warning and excluded from deletion. The file names (keys) of the remaining target objects are
+// extracted and logged for information.
 //
 // If the --dryRun flag is set to true in the CleanOptions, the function skips the actual deletion process,
 // logs a message, and returns. This is a way to simulate a cleaning operation without making actual deletions.
@@ -45,6 +48,7 @@ func StartCleaning(svc types.S3ClientAPI, runner prompt.PromptRunner, cleanOpts
 		return err
 	}
 
+	res = filterObjects(res, cleanOpts)
 	sortObjects(res, cleanOpts)
 
 	border := len(res) - cleanOpts.KeepLastNFiles
@@ -55,7 +59,19 @@ func StartCleaning(svc types.S3ClientAPI, runner prompt.PromptRunner, cleanOpts
 		return nil
 	}
 
-	targetObjects := res[:len(res)-cleanOpts.KeepLastNFiles]
+	targetObjects, locked, err := aws.SplitLockedObjects(svc, cleanOpts.RootOptions, res[:len(res)-cleanOpts.KeepLastNFiles])
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range locked {
+		logger.Warn().Str("key", *obj.Key).Msg("skipping file since it is still under Object Lock retention or legal hold")
+	}
+
+	if len(targetObjects) == 0 {
+		logger.Warn().Int("locked", len(locked)).Msg("no file left to delete, all the target files are locked")
+		return nil
+	}
 
 	keys := utils.GetKeysOnly(targetObjects)
 
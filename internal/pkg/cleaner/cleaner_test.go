@@ -13,7 +13,9 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
 	rootoptions "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/pkg/errors"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/bilalcaliskan/s3-manager/cmd/clean/options"
@@ -835,8 +837,111 @@ func TestStartCleaning(t *testing.T) {
 		mockS3 := new(internalawstypes.MockS3Client)
 		mockS3.ListObjectsAPI = tc.listObjectsFunc
 		mockS3.DeleteObjectAPI = tc.deleteObjectFunc
+		mockS3.GetObjectLockConfigurationAPI = getObjectLockConfigurationNotFoundFunc
 
 		err := StartCleaning(mockS3, tc.PromptRunner, tc.CleanOptions, logging.GetLogger(tc.CleanOptions.RootOptions))
 		assert.Equal(t, tc.expected, err)
 	}
 }
+
+func getObjectLockConfigurationNotFoundFunc(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "ObjectLockConfigurationNotFoundError"}
+}
+
+// TestStartCleaningWithObjectLock tests that the files under Object Lock retention or legal hold are skipped.
+func TestStartCleaningWithObjectLock(t *testing.T) {
+	listObjectsFunc := func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+		return &s3.ListObjectsOutput{
+			Contents: []types.Object{
+				{Key: aws.String("file1.txt"), Size: aws.Int64(500), LastModified: aws.Time(time.Now().Add(-3 * time.Hour))},
+				{Key: aws.String("file2.txt"), Size: aws.Int64(500), LastModified: aws.Time(time.Now().Add(-2 * time.Hour))},
+				{Key: aws.String("file3.txt"), Size: aws.Int64(500), LastModified: aws.Time(time.Now().Add(-1 * time.Hour))},
+			},
+		}, nil
+	}
+
+	getObjectLockConfigurationFunc := func(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+		return &s3.GetObjectLockConfigurationOutput{
+			ObjectLockConfiguration: &types.ObjectLockConfiguration{ObjectLockEnabled: types.ObjectLockEnabledEnabled},
+		}, nil
+	}
+
+	cases := []struct {
+		caseName                       string
+		expected                       error
+		deleted                        []string
+		getObjectLockConfigurationFunc func(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error)
+		getObjectRetentionFunc         func(ctx context.Context, params *s3.GetObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.GetObjectRetentionOutput, error)
+		getObjectLegalHoldFunc         func(ctx context.Context, params *s3.GetObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.GetObjectLegalHoldOutput, error)
+	}{
+		{
+			"Success with locked files skipped",
+			nil,
+			[]string{"file2.txt"},
+			getObjectLockConfigurationFunc,
+			func(ctx context.Context, params *s3.GetObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.GetObjectRetentionOutput, error) {
+				if *params.Key == "file1.txt" {
+					return &s3.GetObjectRetentionOutput{Retention: &types.ObjectLockRetention{
+						Mode:            types.ObjectLockRetentionModeCompliance,
+						RetainUntilDate: aws.Time(time.Now().Add(24 * time.Hour)),
+					}}, nil
+				}
+
+				return nil, &smithy.GenericAPIError{Code: "NoSuchObjectLockConfiguration"}
+			},
+			func(ctx context.Context, params *s3.GetObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.GetObjectLegalHoldOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "NoSuchObjectLockConfiguration"}
+			},
+		},
+		{
+			"Success with all files locked",
+			nil,
+			nil,
+			getObjectLockConfigurationFunc,
+			func(ctx context.Context, params *s3.GetObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.GetObjectRetentionOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "NoSuchObjectLockConfiguration"}
+			},
+			func(ctx context.Context, params *s3.GetObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.GetObjectLegalHoldOutput, error) {
+				return &s3.GetObjectLegalHoldOutput{LegalHold: &types.ObjectLockLegalHold{Status: types.ObjectLockLegalHoldStatusOn}}, nil
+			},
+		},
+		{
+			"Failure caused by get object lock configuration error",
+			constants.ErrInjected,
+			nil,
+			func(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+				return nil, constants.ErrInjected
+			},
+			nil,
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		var deleted []string
+		mockS3 := new(internalawstypes.MockS3Client)
+		mockS3.ListObjectsAPI = listObjectsFunc
+		mockS3.GetObjectLockConfigurationAPI = tc.getObjectLockConfigurationFunc
+		mockS3.GetObjectRetentionAPI = tc.getObjectRetentionFunc
+		mockS3.GetObjectLegalHoldAPI = tc.getObjectLegalHoldFunc
+		mockS3.DeleteObjectAPI = func(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error) {
+			deleted = append(deleted, *params.Key)
+			return &s3.DeleteObjectOutput{}, nil
+		}
+
+		rootOpts := rootoptions.GetMockedRootOptions()
+		rootOpts.AutoApprove = true
+		cleanOpts := &options.CleanOptions{
+			KeepLastNFiles: 1,
+			SortBy:         "lastModificationDate",
+			Order:          "ascending",
+			RootOptions:    rootOpts,
+		}
+
+		err := StartCleaning(mockS3, nil, cleanOpts, logging.GetLogger(rootOpts))
+		assert.Equal(t, tc.expected, errors.Cause(err))
+		assert.Equal(t, tc.deleted, deleted)
+	}
+}
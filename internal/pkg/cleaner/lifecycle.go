@@ -0,0 +1,192 @@
+package cleaner
+
+import (
+	"errors"
+	"regexp/syntax"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/lifecycle"
+
+	start "github.com/bilalcaliskan/s3-manager/cmd/clean/options"
+)
+
+const (
+	bytesInMb = 1024 * 1024
+
+	expirationRuleID   = "s3-manager-clean-expiration"
+	deleteMarkerRuleID = "s3-manager-clean-expired-delete-markers"
+	multipartRuleID    = "s3-manager-clean-incomplete-multipart-uploads"
+
+	noteKeepLastNFiles = "'--keep-last-n-files' can not be expressed in lifecycle rules, every matching object will expire regardless of the '--sort-by' and '--order' flags"
+	noteOlderThanDays  = "'--older-than-days' is not set, expiration of current objects is skipped since lifecycle rules require an age of at least 1 day"
+)
+
+var (
+	ErrNoLifecycleRule = errors.New("no lifecycle rule can be generated with given flags")
+	ErrRegexNotPrefix  = errors.New("'--regex' can not be expressed in lifecycle rules since only key prefixes are " +
+		"supported server-side, use '--prefix' or a regex of a literal prefix such as '^logs/' instead")
+	ErrNoCommonPrefix = errors.New("'--prefix' and the prefix of '--regex' do not match any key together")
+)
+
+// BuildLifecycleConfiguration translates the settings of CleanOptions into an equivalent lifecycle configuration
+// that can be enforced by S3 itself.
+//
+// Key prefix and size limits are translated into the rule filter, '--regex' only if it matches a literal key prefix
+// since a rule covering more objects than the clean command would expire the objects that it keeps, '--older-than-days' into the expiration of current
+// objects and '--noncurrent-version-days' into the expiration of noncurrent versions. Expired delete markers and
+// incomplete multipart uploads are placed into separate prefix-only rules, since S3 does not allow them to be combined
+// with expiration days or object size filters.
+//
+// The function also returns a list of notes that explains which of the given settings could not be expressed
+// server-side, ErrRegexNotPrefix if '--regex' can not be expressed as a key prefix and ErrNoLifecycleRule if none of
+// the given settings could be translated into a rule.
+func BuildLifecycleConfiguration(opts *start.CleanOptions) (*lifecycle.Configuration, []string, error) {
+	var notes []string
+	if opts.Regex != "" {
+		prefix, err := combinePrefixes(opts.Prefix, opts.Regex)
+		if err != nil {
+			return nil, notes, err
+		}
+
+		// the rules are built from a copy of the options whose prefix also covers the regex
+		prefixOpts := *opts
+		prefixOpts.Prefix = prefix
+		opts = &prefixOpts
+	}
+
+	if opts.KeepLastNFiles > 0 {
+		notes = append(notes, noteKeepLastNFiles)
+	}
+
+	if opts.OlderThanDays <= 0 {
+		notes = append(notes, noteOlderThanDays)
+	}
+
+	cfg := &lifecycle.Configuration{}
+	if opts.OlderThanDays > 0 || opts.NoncurrentVersionDays > 0 {
+		rule := lifecycle.Rule{
+			ID:     expirationRuleID,
+			Status: "Enabled",
+			Filter: buildFilter(opts),
+		}
+
+		if opts.OlderThanDays > 0 {
+			rule.Expiration = &lifecycle.Expiration{Days: opts.OlderThanDays}
+		}
+
+		if opts.NoncurrentVersionDays > 0 {
+			rule.NoncurrentVersionExpiration = &lifecycle.NoncurrentVersionExpiration{NoncurrentDays: opts.NoncurrentVersionDays}
+		}
+
+		cfg.Rules = append(cfg.Rules, rule)
+	}
+
+	if opts.ExpiredDeleteMarkers {
+		cfg.Rules = append(cfg.Rules, lifecycle.Rule{
+			ID:         deleteMarkerRuleID,
+			Status:     "Enabled",
+			Filter:     lifecycle.Filter{Prefix: aws.String(opts.Prefix)},
+			Expiration: &lifecycle.Expiration{ExpiredObjectDeleteMarker: true},
+		})
+	}
+
+	if opts.AbortIncompleteMultipartDays > 0 {
+		cfg.Rules = append(cfg.Rules, lifecycle.Rule{
+			ID:                             multipartRuleID,
+			Status:                         "Enabled",
+			Filter:                         lifecycle.Filter{Prefix: aws.String(opts.Prefix)},
+			AbortIncompleteMultipartUpload: &lifecycle.AbortIncompleteMultipartUpload{DaysAfterInitiation: opts.AbortIncompleteMultipartDays},
+		})
+	}
+
+	if len(cfg.Rules) == 0 {
+		return nil, notes, ErrNoLifecycleRule
+	}
+
+	return cfg, notes, nil
+}
+
+// buildFilter creates the lifecycle filter from the prefix and size limits of CleanOptions. Since a filter can only
+// contain a single predicate, multiple predicates are combined with an And operator.
+func buildFilter(opts *start.CleanOptions) lifecycle.Filter {
+	var predicates int
+	if opts.Prefix != "" {
+		predicates++
+	}
+
+	if opts.MinFileSizeInMb > 0 {
+		predicates++
+	}
+
+	if opts.MaxFileSizeInMb > 0 {
+		predicates++
+	}
+
+	switch {
+	case predicates > 1:
+		return lifecycle.Filter{And: &lifecycle.AndFilter{
+			Prefix:                opts.Prefix,
+			ObjectSizeGreaterThan: opts.MinFileSizeInMb * bytesInMb,
+			ObjectSizeLessThan:    opts.MaxFileSizeInMb * bytesInMb,
+		}}
+	case opts.MinFileSizeInMb > 0:
+		return lifecycle.Filter{ObjectSizeGreaterThan: aws.Int64(opts.MinFileSizeInMb * bytesInMb)}
+	case opts.MaxFileSizeInMb > 0:
+		return lifecycle.Filter{ObjectSizeLessThan: aws.Int64(opts.MaxFileSizeInMb * bytesInMb)}
+	default:
+		return lifecycle.Filter{Prefix: aws.String(opts.Prefix)}
+	}
+}
+
+// combinePrefixes returns the key prefix that matches the keys having both the given prefix and the literal prefix
+// that the regex matches.
+func combinePrefixes(prefix, regex string) (string, error) {
+	regexPrefix, ok := literalPrefix(regex)
+	if !ok {
+		return "", ErrRegexNotPrefix
+	}
+
+	switch {
+	case strings.HasPrefix(regexPrefix, prefix):
+		return regexPrefix, nil
+	case strings.HasPrefix(prefix, regexPrefix):
+		return prefix, nil
+	default:
+		return "", ErrNoCommonPrefix
+	}
+}
+
+// literalPrefix returns the literal that the regex matches at the beginning of the keys, if the regex matches every
+// key starting with it and nothing else, such as '^logs/' or '^logs/.*'.
+func literalPrefix(regex string) (string, bool) {
+	re, err := syntax.Parse(regex, syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+
+	re = re.Simplify()
+	subs := []*syntax.Regexp{re}
+	if re.Op == syntax.OpConcat {
+		subs = re.Sub
+	}
+
+	if len(subs) == 0 || subs[0].Op != syntax.OpBeginText {
+		return "", false
+	}
+
+	var prefix string
+	for i, sub := range subs[1:] {
+		switch {
+		case sub.Op == syntax.OpLiteral && sub.Flags&syntax.FoldCase == 0:
+			prefix += string(sub.Rune)
+		case i == len(subs)-2 && sub.Op == syntax.OpStar &&
+			(sub.Sub[0].Op == syntax.OpAnyChar || sub.Sub[0].Op == syntax.OpAnyCharNotNL):
+			// a trailing '.*' matches the rest of the key
+		default:
+			return "", false
+		}
+	}
+
+	return prefix, true
+}
@@ -0,0 +1,165 @@
+//go:build unit
+
+package cleaner
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/bilalcaliskan/s3-manager/cmd/clean/options"
+	rootoptions "github.com/bilalcaliskan/s3-manager/cmd/root/options"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBuildLifecycleConfiguration is a unit test function that tests the BuildLifecycleConfiguration function.
+//
+// It tests the generated rules, filters and notes for various combinations of clean settings.
+func TestBuildLifecycleConfiguration(t *testing.T) {
+	cases := []struct {
+		caseName      string
+		expected      error
+		expectedRules []string
+		expectedNotes int
+		*options.CleanOptions
+	}{
+		{
+			"Success with all settings",
+			nil,
+			[]string{expirationRuleID, deleteMarkerRuleID, multipartRuleID},
+			0,
+			&options.CleanOptions{
+				Prefix:                       "logs/",
+				MinFileSizeInMb:              1,
+				MaxFileSizeInMb:              100,
+				OlderThanDays:                30,
+				NoncurrentVersionDays:        7,
+				ExpiredDeleteMarkers:         true,
+				AbortIncompleteMultipartDays: 3,
+				RootOptions:                  rootoptions.GetMockedRootOptions(),
+			},
+		},
+		{
+			"Success with only noncurrent versions",
+			nil,
+			[]string{expirationRuleID},
+			2,
+			&options.CleanOptions{
+				KeepLastNFiles:        2,
+				NoncurrentVersionDays: 7,
+				RootOptions:           rootoptions.GetMockedRootOptions(),
+			},
+		},
+		{
+			"Success with prefix regex and keep-last-n-files",
+			nil,
+			[]string{expirationRuleID},
+			1,
+			&options.CleanOptions{
+				Regex:          "^logs/.*",
+				KeepLastNFiles: 2,
+				OlderThanDays:  10,
+				RootOptions:    rootoptions.GetMockedRootOptions(),
+			},
+		},
+		{
+			"Failure caused by regex that is not a prefix",
+			ErrRegexNotPrefix,
+			nil,
+			0,
+			&options.CleanOptions{
+				Regex:         ".*.log",
+				OlderThanDays: 10,
+				RootOptions:   rootoptions.GetMockedRootOptions(),
+			},
+		},
+		{
+			"Failure caused by no expressible settings",
+			ErrNoLifecycleRule,
+			nil,
+			1,
+			&options.CleanOptions{
+				Regex:       "^logs/",
+				RootOptions: rootoptions.GetMockedRootOptions(),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		cfg, notes, err := BuildLifecycleConfiguration(tc.CleanOptions)
+		assert.Equal(t, tc.expected, err)
+		assert.Len(t, notes, tc.expectedNotes)
+
+		if err != nil {
+			continue
+		}
+
+		var ids []string
+		for _, rule := range cfg.Rules {
+			ids = append(ids, rule.ID)
+		}
+
+		assert.Equal(t, tc.expectedRules, ids)
+
+		_, err = cfg.String()
+		assert.Nil(t, err)
+	}
+}
+
+// TestBuildLifecycleConfigurationRegexPrefix is a unit test function that tests the filter of the rules that are
+// built with '--regex', the rules must not cover more objects than the clean command deletes.
+func TestBuildLifecycleConfigurationRegexPrefix(t *testing.T) {
+	cases := []struct {
+		caseName string
+		prefix   string
+		regex    string
+		expected string
+		err      error
+	}{
+		{"Success with regex prefix", "", "^logs/", "logs/", nil},
+		{"Success with more specific regex prefix", "logs/", "^logs/2023/.*", "logs/2023/", nil},
+		{"Success with more specific prefix", "logs/2023/", "^logs/", "logs/2023/", nil},
+		{"Failure caused by unanchored regex", "", "logs/", "", ErrRegexNotPrefix},
+		{"Failure caused by regex with a class", "logs/", "^logs/[0-9]+", "", ErrRegexNotPrefix},
+		{"Failure caused by case insensitive regex", "", "(?i)^logs/", "", ErrRegexNotPrefix},
+		{"Failure caused by invalid regex", "", "^logs/(", "", ErrRegexNotPrefix},
+		{"Failure caused by disjoint prefixes", "logs/", "^tmp/", "", ErrNoCommonPrefix},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		cfg, _, err := BuildLifecycleConfiguration(&options.CleanOptions{
+			Prefix:        tc.prefix,
+			Regex:         tc.regex,
+			OlderThanDays: 10,
+			RootOptions:   rootoptions.GetMockedRootOptions(),
+		})
+		assert.Equal(t, tc.err, err)
+		if err != nil {
+			continue
+		}
+
+		assert.Equal(t, tc.expected, aws.ToString(cfg.Rules[0].Filter.Prefix))
+	}
+}
+
+// TestBuildFilter is a unit test function that tests the buildFilter function.
+func TestBuildFilter(t *testing.T) {
+	filter := buildFilter(&options.CleanOptions{Prefix: "logs/"})
+	assert.Equal(t, "logs/", *filter.Prefix)
+	assert.Nil(t, filter.And)
+
+	filter = buildFilter(&options.CleanOptions{MinFileSizeInMb: 1})
+	assert.Equal(t, int64(bytesInMb), *filter.ObjectSizeGreaterThan)
+
+	filter = buildFilter(&options.CleanOptions{MaxFileSizeInMb: 1})
+	assert.Equal(t, int64(bytesInMb), *filter.ObjectSizeLessThan)
+
+	filter = buildFilter(&options.CleanOptions{Prefix: "logs/", MaxFileSizeInMb: 2})
+	assert.NotNil(t, filter.And)
+	assert.Equal(t, "logs/", filter.And.Prefix)
+	assert.Equal(t, int64(2*bytesInMb), filter.And.ObjectSizeLessThan)
+	assert.Nil(t, filter.Prefix)
+}
@@ -2,6 +2,8 @@ package cleaner
 
 import (
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 
@@ -46,3 +48,23 @@ func sortObjects(slice []types.Object, opts *options.CleanOptions) {
 		})
 	}
 }
+
+// filterObjects returns the objects that match with the prefix and age criteria specified in the CleanOptions.
+//
+// An empty prefix matches all keys, and an OlderThanDays value of 0 matches all objects regardless of their age.
+func filterObjects(slice []types.Object, opts *options.CleanOptions) (res []types.Object) {
+	threshold := time.Now().AddDate(0, 0, -opts.OlderThanDays)
+	for _, v := range slice {
+		if !strings.HasPrefix(*v.Key, opts.Prefix) {
+			continue
+		}
+
+		if opts.OlderThanDays > 0 && v.LastModified.After(threshold) {
+			continue
+		}
+
+		res = append(res, v)
+	}
+
+	return res
+}
@@ -0,0 +1,27 @@
+//go:build unit
+
+package cleaner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/cmd/clean/options"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFilterObjects is a unit test function that tests the filterObjects function.
+func TestFilterObjects(t *testing.T) {
+	objects := []types.Object{
+		{Key: aws.String("logs/file1.txt"), LastModified: aws.Time(time.Now().AddDate(0, 0, -40))},
+		{Key: aws.String("logs/file2.txt"), LastModified: aws.Time(time.Now())},
+		{Key: aws.String("data/file3.txt"), LastModified: aws.Time(time.Now().AddDate(0, 0, -40))},
+	}
+
+	assert.Len(t, filterObjects(objects, &options.CleanOptions{}), 3)
+	assert.Len(t, filterObjects(objects, &options.CleanOptions{Prefix: "logs/"}), 2)
+	assert.Len(t, filterObjects(objects, &options.CleanOptions{OlderThanDays: 30}), 2)
+	assert.Len(t, filterObjects(objects, &options.CleanOptions{Prefix: "logs/", OlderThanDays: 30}), 1)
+}
@@ -6,4 +6,6 @@ var (
 	ErrInjected       = errors.New("injected error")
 	ErrUserTerminated = errors.New("user terminated the process")
 	ErrInvalidInput   = errors.New("invalid input")
+	ErrBucketPublic   = errors.New("bucket is publicly accessible")
+	ErrInputMismatch  = errors.New("input does not match the expected value")
 )
@@ -0,0 +1,247 @@
+package cors
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxRules is the maximum number of CORS rules that a bucket can have
+	MaxRules = 100
+	// MaxIDLength is the maximum length of the ID of a CORS rule
+	MaxIDLength = 255
+)
+
+var allowedMethods = map[string]struct{}{
+	"GET":    {},
+	"PUT":    {},
+	"POST":   {},
+	"DELETE": {},
+	"HEAD":   {},
+}
+
+// Configuration is the CORS configuration of a bucket, its JSON representation is identical with the
+// "--cors-configuration" input of "aws s3api put-bucket-cors" command.
+type Configuration struct {
+	CORSRules []Rule `json:"CORSRules" yaml:"CORSRules"`
+}
+
+// Rule is a single CORS rule of a Configuration.
+type Rule struct {
+	ID             string   `json:"ID,omitempty" yaml:"ID,omitempty"`
+	AllowedHeaders []string `json:"AllowedHeaders,omitempty" yaml:"AllowedHeaders,omitempty"`
+	AllowedMethods []string `json:"AllowedMethods" yaml:"AllowedMethods"`
+	AllowedOrigins []string `json:"AllowedOrigins" yaml:"AllowedOrigins"`
+	ExposeHeaders  []string `json:"ExposeHeaders,omitempty" yaml:"ExposeHeaders,omitempty"`
+	MaxAgeSeconds  *int32   `json:"MaxAgeSeconds,omitempty" yaml:"MaxAgeSeconds,omitempty"`
+}
+
+// Parse parses the JSON or YAML content into a Configuration. The content can either be a complete configuration
+// with the "CORSRules" key or a plain list of rules.
+func Parse(content []byte) (*Configuration, error) {
+	trimmed := bytes.TrimSpace(content)
+	if len(trimmed) == 0 {
+		return nil, errors.New("CORS configuration is empty")
+	}
+
+	cfg := &Configuration{}
+	if err := utils.UnmarshalJSONOrYAML(trimmed, cfg); err != nil {
+		var rules []Rule
+		if listErr := utils.UnmarshalJSONOrYAML(trimmed, &rules); listErr != nil {
+			return nil, errors.Wrap(err, "an error occurred while parsing CORS configuration")
+		}
+
+		cfg.CORSRules = rules
+	}
+
+	return cfg, nil
+}
+
+// Validate checks the Configuration against the limits of S3 and returns the first violation it finds.
+func (c *Configuration) Validate() error {
+	if len(c.CORSRules) == 0 {
+		return errors.New("CORS configuration must contain at least one rule")
+	}
+
+	if len(c.CORSRules) > MaxRules {
+		return fmt.Errorf("CORS configuration can contain at most %d rules, got %d", MaxRules, len(c.CORSRules))
+	}
+
+	ids := make(map[string]struct{})
+	for i, rule := range c.CORSRules {
+		if err := rule.Validate(); err != nil {
+			return errors.Wrapf(err, "rule %s is invalid", rule.name(i))
+		}
+
+		if rule.ID == "" {
+			continue
+		}
+
+		if _, ok := ids[rule.ID]; ok {
+			return fmt.Errorf("duplicate rule ID %s", rule.ID)
+		}
+
+		ids[rule.ID] = struct{}{}
+	}
+
+	return nil
+}
+
+// Validate checks a single Rule against the limits of S3.
+func (r Rule) Validate() error {
+	if len(r.ID) > MaxIDLength {
+		return fmt.Errorf("ID can be at most %d characters long", MaxIDLength)
+	}
+
+	if len(r.AllowedMethods) == 0 {
+		return errors.New("at least one allowed method must be specified")
+	}
+
+	for _, method := range r.AllowedMethods {
+		if _, ok := allowedMethods[method]; !ok {
+			return fmt.Errorf("unsupported allowed method %q, supported methods are GET, PUT, POST, DELETE and HEAD", method)
+		}
+	}
+
+	if len(r.AllowedOrigins) == 0 {
+		return errors.New("at least one allowed origin must be specified")
+	}
+
+	for _, origin := range r.AllowedOrigins {
+		if origin == "" {
+			return errors.New("allowed origin can not be empty")
+		}
+
+		if strings.Count(origin, "*") > 1 {
+			return fmt.Errorf("allowed origin %q can contain at most one wildcard", origin)
+		}
+	}
+
+	for _, header := range r.AllowedHeaders {
+		if strings.Count(header, "*") > 1 {
+			return fmt.Errorf("allowed header %q can contain at most one wildcard", header)
+		}
+	}
+
+	for _, header := range r.ExposeHeaders {
+		if strings.Contains(header, "*") {
+			return fmt.Errorf("expose header %q can not contain a wildcard", header)
+		}
+	}
+
+	if r.MaxAgeSeconds != nil && *r.MaxAgeSeconds < 0 {
+		return errors.New("max age seconds can not be negative")
+	}
+
+	return nil
+}
+
+// Add appends the rules to the Configuration, rules with an ID that already exists in the Configuration are
+// rejected.
+func (c *Configuration) Add(rules ...Rule) error {
+	for _, rule := range rules {
+		if rule.ID != "" && c.indexOf(rule.ID) != -1 {
+			return fmt.Errorf("a rule with ID %s already exists", rule.ID)
+		}
+
+		c.CORSRules = append(c.CORSRules, rule)
+	}
+
+	return nil
+}
+
+// RemoveByID removes the rule with the given ID from the Configuration.
+func (c *Configuration) RemoveByID(id string) error {
+	index := c.indexOf(id)
+	if index == -1 {
+		return fmt.Errorf("no rule found with ID %s", id)
+	}
+
+	return c.RemoveByIndex(index)
+}
+
+// RemoveByIndex removes the rule at the given zero based index from the Configuration.
+func (c *Configuration) RemoveByIndex(index int) error {
+	if index < 0 || index >= len(c.CORSRules) {
+		return fmt.Errorf("rule index %d is out of range, configuration has %d rules", index, len(c.CORSRules))
+	}
+
+	c.CORSRules = append(c.CORSRules[:index], c.CORSRules[index+1:]...)
+
+	return nil
+}
+
+// String returns the indented JSON representation of the Configuration.
+func (c *Configuration) String() (string, error) {
+	bytes, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes), nil
+}
+
+// ToSDK converts the Configuration into the CORS configuration of AWS SDK.
+func (c *Configuration) ToSDK() *types.CORSConfiguration {
+	cfg := &types.CORSConfiguration{}
+	for _, rule := range c.CORSRules {
+		sdkRule := types.CORSRule{
+			AllowedHeaders: rule.AllowedHeaders,
+			AllowedMethods: rule.AllowedMethods,
+			AllowedOrigins: rule.AllowedOrigins,
+			ExposeHeaders:  rule.ExposeHeaders,
+			MaxAgeSeconds:  rule.MaxAgeSeconds,
+		}
+
+		if rule.ID != "" {
+			sdkRule.ID = aws.String(rule.ID)
+		}
+
+		cfg.CORSRules = append(cfg.CORSRules, sdkRule)
+	}
+
+	return cfg
+}
+
+// FromSDK converts the CORS rules of AWS SDK into a Configuration.
+func FromSDK(rules []types.CORSRule) *Configuration {
+	cfg := &Configuration{CORSRules: []Rule{}}
+	for _, rule := range rules {
+		cfg.CORSRules = append(cfg.CORSRules, Rule{
+			ID:             aws.ToString(rule.ID),
+			AllowedHeaders: rule.AllowedHeaders,
+			AllowedMethods: rule.AllowedMethods,
+			AllowedOrigins: rule.AllowedOrigins,
+			ExposeHeaders:  rule.ExposeHeaders,
+			MaxAgeSeconds:  rule.MaxAgeSeconds,
+		})
+	}
+
+	return cfg
+}
+
+func (c *Configuration) indexOf(id string) int {
+	for i, rule := range c.CORSRules {
+		if rule.ID == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (r Rule) name(index int) string {
+	if r.ID != "" {
+		return r.ID
+	}
+
+	return "#" + strconv.Itoa(index)
+}
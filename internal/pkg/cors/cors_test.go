@@ -0,0 +1,129 @@
+//go:build unit
+
+package cors
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func validRule(id string) Rule {
+	return Rule{
+		ID:             id,
+		AllowedMethods: []string{"GET"},
+		AllowedOrigins: []string{"https://example.com"},
+	}
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		content    string
+		shouldPass bool
+		rules      int
+	}{
+		{"Success json configuration", `{"CORSRules": [{"AllowedMethods": ["GET"], "AllowedOrigins": ["*"]}]}`, true, 1},
+		{"Success json list", `[{"AllowedMethods": ["GET"], "AllowedOrigins": ["*"]}, {"AllowedMethods": ["PUT"], "AllowedOrigins": ["*"]}]`, true, 2},
+		{"Success yaml configuration", "CORSRules:\n  - AllowedMethods: [GET]\n    AllowedOrigins: ['*']\n", true, 1},
+		{"Success yaml list", "- AllowedMethods: [GET]\n  AllowedOrigins: ['*']\n  MaxAgeSeconds: 10\n", true, 1},
+		{"Failure empty content", "  ", false, 0},
+		{"Failure invalid json", `{"CORSRules": [`, false, 0},
+		{"Failure invalid yaml", "CORSRules: foo: bar", false, 0},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		cfg, err := Parse([]byte(tc.content))
+		if !tc.shouldPass {
+			assert.NotNil(t, err)
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Len(t, cfg.CORSRules, tc.rules)
+	}
+}
+
+func TestConfiguration_Validate(t *testing.T) {
+	tooManyRules := &Configuration{}
+	for i := 0; i <= MaxRules; i++ {
+		tooManyRules.CORSRules = append(tooManyRules.CORSRules, validRule(""))
+	}
+
+	cases := []struct {
+		caseName   string
+		cfg        *Configuration
+		shouldPass bool
+	}{
+		{"Success", &Configuration{CORSRules: []Rule{validRule("foo"), validRule("bar"), validRule("")}}, true},
+		{"Failure no rules", &Configuration{}, false},
+		{"Failure too many rules", tooManyRules, false},
+		{"Failure duplicate id", &Configuration{CORSRules: []Rule{validRule("foo"), validRule("foo")}}, false},
+		{"Failure long id", &Configuration{CORSRules: []Rule{validRule(strings.Repeat("a", MaxIDLength+1))}}, false},
+		{"Failure no methods", &Configuration{CORSRules: []Rule{{AllowedOrigins: []string{"*"}}}}, false},
+		{"Failure unsupported method", &Configuration{CORSRules: []Rule{{AllowedMethods: []string{"PATCH"}, AllowedOrigins: []string{"*"}}}}, false},
+		{"Failure no origins", &Configuration{CORSRules: []Rule{{AllowedMethods: []string{"GET"}}}}, false},
+		{"Failure empty origin", &Configuration{CORSRules: []Rule{{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{""}}}}, false},
+		{"Failure multiple wildcards in origin", &Configuration{CORSRules: []Rule{{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"https://*.*.com"}}}}, false},
+		{"Failure multiple wildcards in header", &Configuration{CORSRules: []Rule{{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}, AllowedHeaders: []string{"x-*-*"}}}}, false},
+		{"Failure wildcard in expose header", &Configuration{CORSRules: []Rule{{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}, ExposeHeaders: []string{"*"}}}}, false},
+		{"Failure negative max age", &Configuration{CORSRules: []Rule{{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}, MaxAgeSeconds: aws.Int32(-1)}}}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		err := tc.cfg.Validate()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestConfiguration_Add(t *testing.T) {
+	cfg := &Configuration{CORSRules: []Rule{validRule("foo")}}
+
+	assert.Nil(t, cfg.Add(validRule("bar"), validRule("")))
+	assert.Len(t, cfg.CORSRules, 3)
+	assert.NotNil(t, cfg.Add(validRule("foo")))
+}
+
+func TestConfiguration_Remove(t *testing.T) {
+	cfg := &Configuration{CORSRules: []Rule{validRule("foo"), validRule("bar"), validRule("baz")}}
+
+	assert.Nil(t, cfg.RemoveByID("bar"))
+	assert.Equal(t, []Rule{validRule("foo"), validRule("baz")}, cfg.CORSRules)
+	assert.NotNil(t, cfg.RemoveByID("bar"))
+
+	assert.Nil(t, cfg.RemoveByIndex(0))
+	assert.Equal(t, []Rule{validRule("baz")}, cfg.CORSRules)
+	assert.NotNil(t, cfg.RemoveByIndex(1))
+	assert.NotNil(t, cfg.RemoveByIndex(-1))
+}
+
+func TestConfiguration_String(t *testing.T) {
+	cfg := &Configuration{CORSRules: []Rule{validRule("foo")}}
+
+	content, err := cfg.String()
+	assert.Nil(t, err)
+	assert.Contains(t, content, `"ID": "foo"`)
+	assert.NotContains(t, content, "ExposeHeaders")
+}
+
+func TestSDKConversion(t *testing.T) {
+	cfg := &Configuration{CORSRules: []Rule{validRule("foo"), validRule("")}}
+	sdk := cfg.ToSDK()
+
+	assert.Len(t, sdk.CORSRules, 2)
+	assert.Equal(t, "foo", *sdk.CORSRules[0].ID)
+	assert.Nil(t, sdk.CORSRules[1].ID)
+	assert.Equal(t, cfg, FromSDK(sdk.CORSRules))
+	assert.Empty(t, FromSDK([]types.CORSRule{}).CORSRules)
+}
@@ -0,0 +1,160 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+)
+
+// NoColor disables the ANSI colors of the rendered diffs, it is enabled when the NO_COLOR environment variable is set
+// or when the standard output is not a terminal, such as a file or a pipe.
+var NoColor = os.Getenv("NO_COLOR") != "" || !isTerminal(os.Stdout)
+
+// isTerminal reports whether the file is a character device, which is the case for terminals
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// Colorize colors the line by its leading marker, '+' is green, '-' is red and '~' is yellow. The other lines are
+// returned as is.
+func Colorize(line string) string {
+	if NoColor || line == "" {
+		return line
+	}
+
+	switch line[0] {
+	case '+':
+		return colorGreen + line + colorReset
+	case '-':
+		return colorRed + line + colorReset
+	case '~':
+		return colorYellow + line + colorReset
+	default:
+		return line
+	}
+}
+
+// Lines returns the unified diff of the lines, the unchanged lines are prefixed with two spaces, the removed ones
+// with "- " and the added ones with "+ ". The lines are colored with Colorize.
+func Lines(current, desired []string) []string {
+	// lcs[i][j] is the length of the longest common subsequence of current[i:] and desired[j:]
+	lcs := make([][]int, len(current)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(desired)+1)
+	}
+
+	for i := len(current) - 1; i >= 0; i-- {
+		for j := len(desired) - 1; j >= 0; j-- {
+			if current[i] == desired[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	res := make([]string, 0, max(len(current), len(desired)))
+	i, j := 0, 0
+	for i < len(current) || j < len(desired) {
+		switch {
+		case i < len(current) && j < len(desired) && current[i] == desired[j]:
+			res = append(res, "  "+current[i])
+			i++
+			j++
+		case i < len(current) && (j == len(desired) || lcs[i+1][j] >= lcs[i][j+1]):
+			res = append(res, Colorize("- "+current[i]))
+			i++
+		default:
+			res = append(res, Colorize("+ "+desired[j]))
+			j++
+		}
+	}
+
+	return res
+}
+
+// JSON returns the unified diff of two JSON documents. Both of them are indented with sorted keys before the
+// comparison, so that only the semantic differences are shown. An empty document is treated as no document at all.
+func JSON(current, desired string) (string, error) {
+	currentLines, err := jsonLines(current)
+	if err != nil {
+		return "", err
+	}
+
+	desiredLines, err := jsonLines(desired)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(Lines(currentLines, desiredLines), "\n"), nil
+}
+
+// Map returns the diff of two maps such as tags, one line per added, removed or changed key sorted by the keys. The
+// unchanged keys are left out.
+func Map(current, desired map[string]string) string {
+	keys := make([]string, 0, len(current)+len(desired))
+	for key := range current {
+		keys = append(keys, key)
+	}
+
+	for key := range desired {
+		if _, ok := current[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	var lines []string
+	for _, key := range keys {
+		currentValue, inCurrent := current[key]
+		desiredValue, inDesired := desired[key]
+		switch {
+		case !inCurrent:
+			lines = append(lines, Colorize(fmt.Sprintf("+ %s=%s", key, desiredValue)))
+		case !inDesired:
+			lines = append(lines, Colorize(fmt.Sprintf("- %s=%s", key, currentValue)))
+		case currentValue != desiredValue:
+			lines = append(lines, Colorize(fmt.Sprintf("~ %s: %s -> %s", key, currentValue, desiredValue)))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// State returns the diff of a single valued setting such as the versioning state.
+func State(name, current, desired string) string {
+	if current == desired {
+		return fmt.Sprintf("  %s: %s", name, current)
+	}
+
+	return Colorize(fmt.Sprintf("~ %s: %s -> %s", name, current, desired))
+}
+
+func jsonLines(content string) ([]string, error) {
+	if strings.TrimSpace(content) == "" {
+		return nil, nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal([]byte(content), &v); err != nil {
+		return nil, err
+	}
+
+	indented, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Split(string(indented), "\n"), nil
+}
@@ -0,0 +1,99 @@
+//go:build unit
+
+package diff
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColorize(t *testing.T) {
+	NoColor = false
+	defer func() {
+		NoColor = true
+	}()
+
+	assert.Equal(t, "\033[32m+ a\033[0m", Colorize("+ a"))
+	assert.Equal(t, "\033[31m- a\033[0m", Colorize("- a"))
+	assert.Equal(t, "\033[33m~ a\033[0m", Colorize("~ a"))
+	assert.Equal(t, "  a", Colorize("  a"))
+	assert.Equal(t, "", Colorize(""))
+
+	NoColor = true
+	assert.Equal(t, "+ a", Colorize("+ a"))
+}
+
+func TestIsTerminal(t *testing.T) {
+	file, err := os.Create(filepath.Join(t.TempDir(), "out"))
+	assert.Nil(t, err)
+	defer file.Close()
+
+	assert.False(t, isTerminal(file))
+
+	assert.Nil(t, file.Close())
+	assert.False(t, isTerminal(file))
+}
+
+func TestLines(t *testing.T) {
+	NoColor = true
+
+	cases := []struct {
+		caseName string
+		current  []string
+		desired  []string
+		expected []string
+	}{
+		{"Same", []string{"a", "b"}, []string{"a", "b"}, []string{"  a", "  b"}},
+		{"Added", nil, []string{"a"}, []string{"+ a"}},
+		{"Removed", []string{"a"}, nil, []string{"- a"}},
+		{"Changed in the middle", []string{"a", "b", "c"}, []string{"a", "x", "c", "d"},
+			[]string{"  a", "- b", "+ x", "  c", "+ d"}},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+		assert.Equal(t, tc.expected, Lines(tc.current, tc.desired))
+	}
+}
+
+func TestJSON(t *testing.T) {
+	NoColor = true
+
+	res, err := JSON(`{"Version": "2012-10-17", "Statement": []}`, `{"Statement": [], "Version": "2012-10-17"}`)
+	assert.Nil(t, err)
+	assert.NotContains(t, res, "+")
+	assert.NotContains(t, res, "-\n")
+
+	res, err = JSON("", `{"Version": "2012-10-17"}`)
+	assert.Nil(t, err)
+	assert.Equal(t, "+ {\n+   \"Version\": \"2012-10-17\"\n+ }", res)
+
+	res, err = JSON(`{"Sid": "A"}`, `{"Sid": "B"}`)
+	assert.Nil(t, err)
+	assert.Equal(t, "  {\n-   \"Sid\": \"A\"\n+   \"Sid\": \"B\"\n  }", res)
+
+	_, err = JSON("{", "")
+	assert.NotNil(t, err)
+
+	_, err = JSON("", "{")
+	assert.NotNil(t, err)
+}
+
+func TestMap(t *testing.T) {
+	NoColor = true
+
+	assert.Equal(t, "~ env: dev -> prod\n- owner=foo\n+ team=platform",
+		Map(map[string]string{"env": "dev", "owner": "foo", "same": "value"},
+			map[string]string{"env": "prod", "same": "value", "team": "platform"}))
+	assert.Empty(t, Map(map[string]string{"a": "b"}, map[string]string{"a": "b"}))
+}
+
+func TestState(t *testing.T) {
+	NoColor = true
+
+	assert.Equal(t, "~ versioning: disabled -> enabled", State("versioning", "disabled", "enabled"))
+	assert.Equal(t, "  versioning: enabled", State("versioning", "enabled", "enabled"))
+}
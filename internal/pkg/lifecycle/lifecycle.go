@@ -0,0 +1,451 @@
+package lifecycle
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	StatusEnabled  = "Enabled"
+	StatusDisabled = "Disabled"
+)
+
+// Configuration is the lifecycle configuration of a bucket, its JSON representation is identical with the
+// "--lifecycle-configuration" input of "aws s3api put-bucket-lifecycle-configuration" command.
+type Configuration struct {
+	Rules []Rule `json:"Rules" yaml:"Rules"`
+}
+
+// Rule is a single lifecycle rule of a Configuration.
+type Rule struct {
+	ID                             string                          `json:"ID" yaml:"ID"`
+	Status                         string                          `json:"Status" yaml:"Status"`
+	Filter                         Filter                          `json:"Filter" yaml:"Filter"`
+	Expiration                     *Expiration                     `json:"Expiration,omitempty" yaml:"Expiration,omitempty"`
+	Transitions                    []Transition                    `json:"Transitions,omitempty" yaml:"Transitions,omitempty"`
+	NoncurrentVersionExpiration    *NoncurrentVersionExpiration    `json:"NoncurrentVersionExpiration,omitempty" yaml:"NoncurrentVersionExpiration,omitempty"`
+	NoncurrentVersionTransitions   []NoncurrentVersionTransition   `json:"NoncurrentVersionTransitions,omitempty" yaml:"NoncurrentVersionTransitions,omitempty"`
+	AbortIncompleteMultipartUpload *AbortIncompleteMultipartUpload `json:"AbortIncompleteMultipartUpload,omitempty" yaml:"AbortIncompleteMultipartUpload,omitempty"`
+}
+
+// Filter identifies the objects that a Rule applies to, only one of its fields must be set at a time.
+type Filter struct {
+	Prefix                *string    `json:"Prefix,omitempty" yaml:"Prefix,omitempty"`
+	ObjectSizeGreaterThan *int64     `json:"ObjectSizeGreaterThan,omitempty" yaml:"ObjectSizeGreaterThan,omitempty"`
+	ObjectSizeLessThan    *int64     `json:"ObjectSizeLessThan,omitempty" yaml:"ObjectSizeLessThan,omitempty"`
+	Tag                   *Tag       `json:"Tag,omitempty" yaml:"Tag,omitempty"`
+	And                   *AndFilter `json:"And,omitempty" yaml:"And,omitempty"`
+}
+
+// AndFilter combines multiple predicates of a Filter with a logical AND.
+type AndFilter struct {
+	Prefix                string `json:"Prefix,omitempty" yaml:"Prefix,omitempty"`
+	ObjectSizeGreaterThan int64  `json:"ObjectSizeGreaterThan,omitempty" yaml:"ObjectSizeGreaterThan,omitempty"`
+	ObjectSizeLessThan    int64  `json:"ObjectSizeLessThan,omitempty" yaml:"ObjectSizeLessThan,omitempty"`
+	Tags                  []Tag  `json:"Tags,omitempty" yaml:"Tags,omitempty"`
+}
+
+// Tag is an object tag that a Filter matches.
+type Tag struct {
+	Key   string `json:"Key" yaml:"Key"`
+	Value string `json:"Value" yaml:"Value"`
+}
+
+// Expiration specifies when the current versions of the objects expire, either after Days or at Date.
+type Expiration struct {
+	Days                      int        `json:"Days,omitempty" yaml:"Days,omitempty"`
+	Date                      *time.Time `json:"Date,omitempty" yaml:"Date,omitempty"`
+	ExpiredObjectDeleteMarker bool       `json:"ExpiredObjectDeleteMarker,omitempty" yaml:"ExpiredObjectDeleteMarker,omitempty"`
+}
+
+// Transition specifies when the current versions of the objects move to another storage class, either after Days or
+// at Date.
+type Transition struct {
+	Days         int        `json:"Days" yaml:"Days"`
+	Date         *time.Time `json:"Date,omitempty" yaml:"Date,omitempty"`
+	StorageClass string     `json:"StorageClass" yaml:"StorageClass"`
+}
+
+// NoncurrentVersionExpiration specifies when the noncurrent versions of the objects expire, NewerNoncurrentVersions
+// is the number of the newest noncurrent versions to keep.
+type NoncurrentVersionExpiration struct {
+	NoncurrentDays          int `json:"NoncurrentDays" yaml:"NoncurrentDays"`
+	NewerNoncurrentVersions int `json:"NewerNoncurrentVersions,omitempty" yaml:"NewerNoncurrentVersions,omitempty"`
+}
+
+// NoncurrentVersionTransition specifies when the noncurrent versions of the objects move to another storage class,
+// NewerNoncurrentVersions is the number of the newest noncurrent versions to keep in their storage class.
+type NoncurrentVersionTransition struct {
+	NoncurrentDays          int    `json:"NoncurrentDays" yaml:"NoncurrentDays"`
+	NewerNoncurrentVersions int    `json:"NewerNoncurrentVersions,omitempty" yaml:"NewerNoncurrentVersions,omitempty"`
+	StorageClass            string `json:"StorageClass" yaml:"StorageClass"`
+}
+
+// AbortIncompleteMultipartUpload specifies when the incomplete multipart uploads are aborted.
+type AbortIncompleteMultipartUpload struct {
+	DaysAfterInitiation int `json:"DaysAfterInitiation" yaml:"DaysAfterInitiation"`
+}
+
+// String returns the indented JSON representation of the Configuration.
+func (c *Configuration) String() (string, error) {
+	bytes, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes), nil
+}
+
+// Validate checks the Configuration against the rules of S3 and returns the first violation it finds.
+func (c *Configuration) Validate() error {
+	ids := make(map[string]struct{})
+	for i, rule := range c.Rules {
+		name := rule.ID
+		if name == "" {
+			name = fmt.Sprintf("#%d", i+1)
+		}
+
+		if err := rule.Validate(); err != nil {
+			return errors.Wrapf(err, "rule %s is invalid", name)
+		}
+
+		if rule.ID == "" {
+			continue
+		}
+
+		if _, ok := ids[rule.ID]; ok {
+			return fmt.Errorf("duplicate rule ID %s", rule.ID)
+		}
+
+		ids[rule.ID] = struct{}{}
+	}
+
+	return nil
+}
+
+// Validate checks a single Rule against the rules of S3.
+func (r Rule) Validate() error {
+	if r.Status != StatusEnabled && r.Status != StatusDisabled {
+		return fmt.Errorf("status must be %s or %s, got %q", StatusEnabled, StatusDisabled, r.Status)
+	}
+
+	if r.Expiration == nil && len(r.Transitions) == 0 && r.NoncurrentVersionExpiration == nil &&
+		len(r.NoncurrentVersionTransitions) == 0 && r.AbortIncompleteMultipartUpload == nil {
+		return errors.New("at least one action must be specified")
+	}
+
+	set := 0
+	for _, v := range []bool{r.Filter.Prefix != nil, r.Filter.ObjectSizeGreaterThan != nil,
+		r.Filter.ObjectSizeLessThan != nil, r.Filter.Tag != nil, r.Filter.And != nil} {
+		if v {
+			set++
+		}
+	}
+
+	if set > 1 {
+		return errors.New("only one of the fields of the filter can be set, use 'And' to combine them")
+	}
+
+	if r.Expiration != nil {
+		if r.Expiration.Date != nil && r.Expiration.Days != 0 {
+			return errors.New("only one of expiration days and date can be set")
+		}
+
+		if r.Expiration.Date == nil && r.Expiration.Days <= 0 && !r.Expiration.ExpiredObjectDeleteMarker {
+			return errors.New("expiration days must be positive")
+		}
+	}
+
+	for _, transition := range r.Transitions {
+		if transition.Date != nil && transition.Days != 0 {
+			return errors.New("only one of transition days and date can be set")
+		}
+
+		if transition.Days < 0 {
+			return errors.New("transition days can not be negative")
+		}
+
+		if !isStorageClass(transition.StorageClass) {
+			return fmt.Errorf("unsupported transition storage class %q", transition.StorageClass)
+		}
+	}
+
+	for _, transition := range r.NoncurrentVersionTransitions {
+		if transition.NoncurrentDays <= 0 {
+			return errors.New("noncurrent version transition days must be positive")
+		}
+
+		if !isStorageClass(transition.StorageClass) {
+			return fmt.Errorf("unsupported noncurrent version transition storage class %q", transition.StorageClass)
+		}
+	}
+
+	return nil
+}
+
+// ToSDK converts the Configuration into the lifecycle configuration of AWS SDK.
+func (c *Configuration) ToSDK() *types.BucketLifecycleConfiguration {
+	cfg := &types.BucketLifecycleConfiguration{Rules: make([]types.LifecycleRule, 0, len(c.Rules))}
+	for _, rule := range c.Rules {
+		sdkRule := types.LifecycleRule{
+			Status: types.ExpirationStatus(rule.Status),
+			Filter: rule.Filter.toSDK(),
+		}
+
+		if rule.ID != "" {
+			sdkRule.ID = aws.String(rule.ID)
+		}
+
+		if rule.Expiration != nil {
+			sdkRule.Expiration = &types.LifecycleExpiration{}
+			if rule.Expiration.Days > 0 {
+				sdkRule.Expiration.Days = aws.Int32(int32(rule.Expiration.Days))
+			}
+
+			if rule.Expiration.Date != nil {
+				sdkRule.Expiration.Date = aws.Time(*rule.Expiration.Date)
+			}
+
+			if rule.Expiration.ExpiredObjectDeleteMarker {
+				sdkRule.Expiration.ExpiredObjectDeleteMarker = aws.Bool(true)
+			}
+		}
+
+		for _, transition := range rule.Transitions {
+			sdkTransition := types.Transition{StorageClass: types.TransitionStorageClass(transition.StorageClass)}
+			if transition.Date != nil {
+				sdkTransition.Date = aws.Time(*transition.Date)
+			} else {
+				sdkTransition.Days = aws.Int32(int32(transition.Days))
+			}
+
+			sdkRule.Transitions = append(sdkRule.Transitions, sdkTransition)
+		}
+
+		if rule.NoncurrentVersionExpiration != nil {
+			sdkRule.NoncurrentVersionExpiration = &types.NoncurrentVersionExpiration{
+				NoncurrentDays: aws.Int32(int32(rule.NoncurrentVersionExpiration.NoncurrentDays)),
+			}
+
+			if rule.NoncurrentVersionExpiration.NewerNoncurrentVersions > 0 {
+				sdkRule.NoncurrentVersionExpiration.NewerNoncurrentVersions =
+					aws.Int32(int32(rule.NoncurrentVersionExpiration.NewerNoncurrentVersions))
+			}
+		}
+
+		for _, transition := range rule.NoncurrentVersionTransitions {
+			sdkTransition := types.NoncurrentVersionTransition{
+				NoncurrentDays: aws.Int32(int32(transition.NoncurrentDays)),
+				StorageClass:   types.TransitionStorageClass(transition.StorageClass),
+			}
+
+			if transition.NewerNoncurrentVersions > 0 {
+				sdkTransition.NewerNoncurrentVersions = aws.Int32(int32(transition.NewerNoncurrentVersions))
+			}
+
+			sdkRule.NoncurrentVersionTransitions = append(sdkRule.NoncurrentVersionTransitions, sdkTransition)
+		}
+
+		if rule.AbortIncompleteMultipartUpload != nil {
+			sdkRule.AbortIncompleteMultipartUpload = &types.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int32(int32(rule.AbortIncompleteMultipartUpload.DaysAfterInitiation)),
+			}
+		}
+
+		cfg.Rules = append(cfg.Rules, sdkRule)
+	}
+
+	return cfg
+}
+
+// FromSDK converts the lifecycle rules of AWS SDK into a Configuration. It returns an error if a rule has a filter
+// that a Rule can not express, instead of widening the rule to the whole bucket.
+func FromSDK(rules []types.LifecycleRule) (*Configuration, error) {
+	cfg := &Configuration{Rules: make([]Rule, 0, len(rules))}
+	for _, sdkRule := range rules {
+		rule := Rule{
+			ID:     aws.ToString(sdkRule.ID),
+			Status: string(sdkRule.Status),
+		}
+
+		// the deprecated prefix of the rule is used by the rules that are created without a filter, a rule without
+		// both applies to the whole bucket
+		switch {
+		case sdkRule.Filter != nil:
+			filter, err := filterFromSDK(sdkRule.Filter)
+			if err != nil {
+				return nil, errors.Wrapf(err, "lifecycle rule %s can not be converted", aws.ToString(sdkRule.ID))
+			}
+
+			rule.Filter = filter
+		default:
+			rule.Filter = Filter{Prefix: aws.String(aws.ToString(sdkRule.Prefix))}
+		}
+
+		if expiration := sdkRule.Expiration; expiration != nil &&
+			(expiration.Days != nil || expiration.Date != nil || expiration.ExpiredObjectDeleteMarker != nil) {
+			rule.Expiration = &Expiration{
+				Days:                      int(aws.ToInt32(expiration.Days)),
+				Date:                      expiration.Date,
+				ExpiredObjectDeleteMarker: aws.ToBool(expiration.ExpiredObjectDeleteMarker),
+			}
+		}
+
+		for _, transition := range sdkRule.Transitions {
+			rule.Transitions = append(rule.Transitions, Transition{
+				Days:         int(aws.ToInt32(transition.Days)),
+				Date:         transition.Date,
+				StorageClass: string(transition.StorageClass),
+			})
+		}
+
+		if sdkRule.NoncurrentVersionExpiration != nil {
+			rule.NoncurrentVersionExpiration = &NoncurrentVersionExpiration{
+				NoncurrentDays:          int(aws.ToInt32(sdkRule.NoncurrentVersionExpiration.NoncurrentDays)),
+				NewerNoncurrentVersions: int(aws.ToInt32(sdkRule.NoncurrentVersionExpiration.NewerNoncurrentVersions)),
+			}
+		}
+
+		for _, transition := range sdkRule.NoncurrentVersionTransitions {
+			rule.NoncurrentVersionTransitions = append(rule.NoncurrentVersionTransitions, NoncurrentVersionTransition{
+				NoncurrentDays:          int(aws.ToInt32(transition.NoncurrentDays)),
+				NewerNoncurrentVersions: int(aws.ToInt32(transition.NewerNoncurrentVersions)),
+				StorageClass:            string(transition.StorageClass),
+			})
+		}
+
+		if sdkRule.AbortIncompleteMultipartUpload != nil {
+			rule.AbortIncompleteMultipartUpload = &AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: int(aws.ToInt32(sdkRule.AbortIncompleteMultipartUpload.DaysAfterInitiation)),
+			}
+		}
+
+		cfg.Rules = append(cfg.Rules, rule)
+	}
+
+	return cfg, nil
+}
+
+// Equal reports whether two Configurations have the same rules in the same order. A missing prefix filter and an
+// empty one are considered equal since S3 reports the rules without a filter with an empty prefix, the dates are
+// compared regardless of their time zones.
+func (c *Configuration) Equal(other *Configuration) bool {
+	return reflect.DeepEqual(c.normalized(), other.normalized())
+}
+
+func (c *Configuration) normalized() []Rule {
+	rules := make([]Rule, 0, len(c.Rules))
+	for _, rule := range c.Rules {
+		if rule.Filter.Prefix != nil && *rule.Filter.Prefix == "" {
+			rule.Filter.Prefix = nil
+		}
+
+		if rule.Expiration != nil && rule.Expiration.Date != nil {
+			expiration := *rule.Expiration
+			expiration.Date = aws.Time(expiration.Date.UTC())
+			rule.Expiration = &expiration
+		}
+
+		var transitions []Transition
+		for _, transition := range rule.Transitions {
+			if transition.Date != nil {
+				transition.Date = aws.Time(transition.Date.UTC())
+			}
+
+			transitions = append(transitions, transition)
+		}
+
+		rule.Transitions = transitions
+		if len(rule.NoncurrentVersionTransitions) == 0 {
+			rule.NoncurrentVersionTransitions = nil
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func (f Filter) toSDK() types.LifecycleRuleFilter {
+	switch {
+	case f.And != nil:
+		and := types.LifecycleRuleAndOperator{}
+		if f.And.Prefix != "" {
+			and.Prefix = aws.String(f.And.Prefix)
+		}
+
+		if f.And.ObjectSizeGreaterThan != 0 {
+			and.ObjectSizeGreaterThan = aws.Int64(f.And.ObjectSizeGreaterThan)
+		}
+
+		if f.And.ObjectSizeLessThan != 0 {
+			and.ObjectSizeLessThan = aws.Int64(f.And.ObjectSizeLessThan)
+		}
+
+		for _, tag := range f.And.Tags {
+			and.Tags = append(and.Tags, tag.toSDK())
+		}
+
+		return &types.LifecycleRuleFilterMemberAnd{Value: and}
+	case f.Tag != nil:
+		return &types.LifecycleRuleFilterMemberTag{Value: f.Tag.toSDK()}
+	case f.ObjectSizeGreaterThan != nil:
+		return &types.LifecycleRuleFilterMemberObjectSizeGreaterThan{Value: *f.ObjectSizeGreaterThan}
+	case f.ObjectSizeLessThan != nil:
+		return &types.LifecycleRuleFilterMemberObjectSizeLessThan{Value: *f.ObjectSizeLessThan}
+	default:
+		return &types.LifecycleRuleFilterMemberPrefix{Value: aws.ToString(f.Prefix)}
+	}
+}
+
+func filterFromSDK(filter types.LifecycleRuleFilter) (Filter, error) {
+	switch v := filter.(type) {
+	case *types.LifecycleRuleFilterMemberPrefix:
+		return Filter{Prefix: aws.String(v.Value)}, nil
+	case *types.LifecycleRuleFilterMemberObjectSizeGreaterThan:
+		return Filter{ObjectSizeGreaterThan: aws.Int64(v.Value)}, nil
+	case *types.LifecycleRuleFilterMemberObjectSizeLessThan:
+		return Filter{ObjectSizeLessThan: aws.Int64(v.Value)}, nil
+	case *types.LifecycleRuleFilterMemberTag:
+		tag := tagFromSDK(v.Value)
+		return Filter{Tag: &tag}, nil
+	case *types.LifecycleRuleFilterMemberAnd:
+		and := &AndFilter{
+			Prefix:                aws.ToString(v.Value.Prefix),
+			ObjectSizeGreaterThan: aws.ToInt64(v.Value.ObjectSizeGreaterThan),
+			ObjectSizeLessThan:    aws.ToInt64(v.Value.ObjectSizeLessThan),
+		}
+
+		for _, tag := range v.Value.Tags {
+			and.Tags = append(and.Tags, tagFromSDK(tag))
+		}
+
+		return Filter{And: and}, nil
+	default:
+		return Filter{}, fmt.Errorf("unsupported filter %T", filter)
+	}
+}
+
+func (t Tag) toSDK() types.Tag {
+	return types.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+}
+
+func tagFromSDK(tag types.Tag) Tag {
+	return Tag{Key: aws.ToString(tag.Key), Value: aws.ToString(tag.Value)}
+}
+
+func isStorageClass(storageClass string) bool {
+	for _, v := range types.TransitionStorageClass("").Values() {
+		if string(v) == storageClass {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,155 @@
+//go:build unit
+
+package lifecycle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfiguration_Validate(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		cfg        *Configuration
+		shouldPass bool
+	}{
+		{"Success empty configuration", &Configuration{}, true},
+		{"Success", &Configuration{Rules: []Rule{
+			{ID: "logs", Status: StatusEnabled, Filter: Filter{Prefix: aws.String("logs/")},
+				Expiration:  &Expiration{Days: 90},
+				Transitions: []Transition{{Days: 30, StorageClass: "GLACIER"}}},
+			{Status: StatusDisabled, AbortIncompleteMultipartUpload: &AbortIncompleteMultipartUpload{DaysAfterInitiation: 7}},
+		}}, true},
+		{"Failure invalid status", &Configuration{Rules: []Rule{
+			{ID: "foo", Status: "enabled", Expiration: &Expiration{Days: 1}},
+		}}, false},
+		{"Failure no action", &Configuration{Rules: []Rule{{ID: "foo", Status: StatusEnabled}}}, false},
+		{"Failure duplicate id", &Configuration{Rules: []Rule{
+			{ID: "foo", Status: StatusEnabled, Expiration: &Expiration{Days: 1}},
+			{ID: "foo", Status: StatusEnabled, Expiration: &Expiration{Days: 2}},
+		}}, false},
+		{"Failure multiple filters", &Configuration{Rules: []Rule{
+			{ID: "foo", Status: StatusEnabled, Expiration: &Expiration{Days: 1},
+				Filter: Filter{Prefix: aws.String("a/"), ObjectSizeLessThan: aws.Int64(10)}},
+		}}, false},
+		{"Failure zero expiration days", &Configuration{Rules: []Rule{
+			{ID: "foo", Status: StatusEnabled, Expiration: &Expiration{}},
+		}}, false},
+		{"Failure unsupported storage class", &Configuration{Rules: []Rule{
+			{ID: "foo", Status: StatusEnabled, Transitions: []Transition{{Days: 30, StorageClass: "COLD"}}},
+		}}, false},
+		{"Success expiration date", &Configuration{Rules: []Rule{
+			{ID: "foo", Status: StatusEnabled, Expiration: &Expiration{Date: aws.Time(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))}},
+		}}, true},
+		{"Failure expiration days and date", &Configuration{Rules: []Rule{
+			{ID: "foo", Status: StatusEnabled, Expiration: &Expiration{Days: 1,
+				Date: aws.Time(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))}},
+		}}, false},
+		{"Success noncurrent version transition", &Configuration{Rules: []Rule{
+			{ID: "foo", Status: StatusEnabled, NoncurrentVersionTransitions: []NoncurrentVersionTransition{
+				{NoncurrentDays: 30, StorageClass: "GLACIER"}}},
+		}}, true},
+		{"Failure unsupported noncurrent version transition storage class", &Configuration{Rules: []Rule{
+			{ID: "foo", Status: StatusEnabled, NoncurrentVersionTransitions: []NoncurrentVersionTransition{
+				{NoncurrentDays: 30, StorageClass: "COLD"}}},
+		}}, false},
+		{"Failure tag and prefix filters", &Configuration{Rules: []Rule{
+			{ID: "foo", Status: StatusEnabled, Expiration: &Expiration{Days: 1},
+				Filter: Filter{Prefix: aws.String("a/"), Tag: &Tag{Key: "k", Value: "v"}}},
+		}}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		err := tc.cfg.Validate()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestSDKConversion(t *testing.T) {
+	cfg := &Configuration{Rules: []Rule{
+		{ID: "logs", Status: StatusEnabled, Filter: Filter{Prefix: aws.String("logs/")},
+			Expiration:                  &Expiration{Days: 90},
+			Transitions:                 []Transition{{Days: 30, StorageClass: "STANDARD_IA"}},
+			NoncurrentVersionExpiration: &NoncurrentVersionExpiration{NoncurrentDays: 7}},
+		{ID: "big", Status: StatusEnabled, Filter: Filter{ObjectSizeGreaterThan: aws.Int64(1024)},
+			Expiration: &Expiration{ExpiredObjectDeleteMarker: true}},
+		{ID: "small", Status: StatusEnabled, Filter: Filter{ObjectSizeLessThan: aws.Int64(10)},
+			Expiration: &Expiration{Days: 1}},
+		{ID: "combined", Status: StatusDisabled, Filter: Filter{And: &AndFilter{Prefix: "tmp/", ObjectSizeGreaterThan: 1,
+			Tags: []Tag{{Key: "team", Value: "data"}}}},
+			AbortIncompleteMultipartUpload: &AbortIncompleteMultipartUpload{DaysAfterInitiation: 3}},
+		{ID: "tagged", Status: StatusEnabled, Filter: Filter{Tag: &Tag{Key: "temporary", Value: "true"}},
+			Expiration:  &Expiration{Date: aws.Time(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))},
+			Transitions: []Transition{{Date: aws.Time(time.Date(2029, 1, 1, 0, 0, 0, 0, time.UTC)), StorageClass: "GLACIER"}}},
+		{ID: "versions", Status: StatusEnabled, Filter: Filter{Prefix: aws.String("")},
+			NoncurrentVersionExpiration: &NoncurrentVersionExpiration{NoncurrentDays: 90, NewerNoncurrentVersions: 3},
+			NoncurrentVersionTransitions: []NoncurrentVersionTransition{
+				{NoncurrentDays: 30, NewerNoncurrentVersions: 1, StorageClass: "GLACIER"}}},
+	}}
+
+	sdk := cfg.ToSDK()
+	assert.Len(t, sdk.Rules, 6)
+	assert.Equal(t, &types.LifecycleRuleFilterMemberPrefix{Value: "logs/"}, sdk.Rules[0].Filter)
+	assert.Equal(t, types.TransitionStorageClassStandardIa, sdk.Rules[0].Transitions[0].StorageClass)
+	assert.Nil(t, sdk.Rules[1].Expiration.Days)
+	assert.Equal(t, &types.LifecycleRuleFilterMemberTag{Value: types.Tag{Key: aws.String("temporary"),
+		Value: aws.String("true")}}, sdk.Rules[4].Filter)
+	assert.Nil(t, sdk.Rules[4].Transitions[0].Days)
+
+	converted, err := FromSDK(sdk.Rules)
+	assert.Nil(t, err)
+	assert.Equal(t, cfg, converted)
+	assert.True(t, cfg.Equal(converted))
+
+	converted, err = FromSDK(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, &Configuration{Rules: []Rule{}}, converted)
+
+	// a rule that can not be expressed must not be widened to the whole bucket
+	_, err = FromSDK([]types.LifecycleRule{{ID: aws.String("unknown"), Status: types.ExpirationStatusEnabled,
+		Filter: &types.UnknownUnionMember{Tag: "Foo"}, Expiration: &types.LifecycleExpiration{Days: aws.Int32(1)}}})
+	assert.NotNil(t, err)
+}
+
+func TestFromSDK_DeprecatedPrefix(t *testing.T) {
+	cfg, err := FromSDK([]types.LifecycleRule{{
+		ID:         aws.String("legacy"),
+		Status:     types.ExpirationStatusEnabled,
+		Prefix:     aws.String("old/"),
+		Expiration: &types.LifecycleExpiration{Days: aws.Int32(1)},
+	}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "old/", aws.ToString(cfg.Rules[0].Filter.Prefix))
+}
+
+func TestConfiguration_Equal(t *testing.T) {
+	withoutFilter := &Configuration{Rules: []Rule{{ID: "foo", Status: StatusEnabled, Expiration: &Expiration{Days: 1}}}}
+	withEmptyPrefix := &Configuration{Rules: []Rule{{ID: "foo", Status: StatusEnabled, Expiration: &Expiration{Days: 1},
+		Filter: Filter{Prefix: aws.String("")}, Transitions: []Transition{}}}}
+	other := &Configuration{Rules: []Rule{{ID: "foo", Status: StatusEnabled, Expiration: &Expiration{Days: 2}}}}
+
+	assert.True(t, withoutFilter.Equal(withEmptyPrefix))
+	assert.False(t, withoutFilter.Equal(other))
+	assert.True(t, (&Configuration{}).Equal(&Configuration{Rules: []Rule{}}))
+
+	date := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	withDate := &Configuration{Rules: []Rule{{ID: "foo", Status: StatusEnabled, Expiration: &Expiration{Date: &date}}}}
+	inOtherZone := &Configuration{Rules: []Rule{{ID: "foo", Status: StatusEnabled,
+		Expiration: &Expiration{Date: aws.Time(date.In(time.FixedZone("UTC+3", 3*60*60)))}}}}
+	assert.True(t, withDate.Equal(inOtherZone))
+
+	tagged := &Configuration{Rules: []Rule{{ID: "foo", Status: StatusEnabled, Expiration: &Expiration{Days: 1},
+		Filter: Filter{Tag: &Tag{Key: "temporary", Value: "true"}}}}}
+	assert.False(t, withoutFilter.Equal(tagged))
+}
@@ -1,6 +1,7 @@
 package logging
 
 import (
+	"io"
 	"os"
 
 	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
@@ -28,6 +29,11 @@ func GetLogger(opts *options.RootOptions) zerolog.Logger {
 	return logger
 }
 
+// SetOutput makes the logger write to the given writer instead of the standard output.
+func SetOutput(w io.Writer) {
+	logger = logger.Output(zerolog.ConsoleWriter{Out: w})
+}
+
 func EnableDebugLogging() {
 	logger = logger.Level(zerolog.DebugLevel)
 }
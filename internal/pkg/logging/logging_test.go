@@ -3,6 +3,7 @@
 package logging
 
 import (
+	"os"
 	"testing"
 
 	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
@@ -19,3 +20,8 @@ func TestGetLogger(t *testing.T) {
 func TestEnableDebugLogging(t *testing.T) {
 	EnableDebugLogging()
 }
+
+func TestSetOutput(t *testing.T) {
+	SetOutput(os.Stderr)
+	SetOutput(os.Stdout)
+}
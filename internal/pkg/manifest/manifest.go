@@ -0,0 +1,338 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	bucketutils "github.com/bilalcaliskan/s3-manager/cmd/bucket/utils"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/lifecycle"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/policy"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	SettingTags                 = "tags"
+	SettingVersioning           = "versioning"
+	SettingTransferAcceleration = "transferAcceleration"
+	SettingPolicy               = "policy"
+	SettingLifecycle            = "lifecycle"
+	SettingEncryption           = "encryption"
+
+	ActionAdd    = "add"
+	ActionChange = "change"
+	ActionRemove = "remove"
+
+	StatusEnabled   = "Enabled"
+	StatusSuspended = "Suspended"
+
+	FormatYAML = "yaml"
+	FormatJSON = "json"
+)
+
+// Manifest is the declarative configuration of a bucket. A setting that is left out of the Manifest is not managed,
+// it is neither compared with nor applied on the bucket. Tags, Policy and Lifecycle can be declared empty to remove
+// them from the bucket.
+type Manifest struct {
+	Tags                 map[string]string        `json:"Tags,omitempty" yaml:"Tags,omitempty"`
+	Versioning           string                   `json:"Versioning,omitempty" yaml:"Versioning,omitempty"`
+	TransferAcceleration string                   `json:"TransferAcceleration,omitempty" yaml:"TransferAcceleration,omitempty"`
+	Policy               map[string]interface{}   `json:"Policy,omitempty" yaml:"Policy,omitempty"`
+	Lifecycle            *lifecycle.Configuration `json:"Lifecycle,omitempty" yaml:"Lifecycle,omitempty"`
+	Encryption           *Encryption              `json:"Encryption,omitempty" yaml:"Encryption,omitempty"`
+}
+
+// Encryption is the default server-side encryption of a bucket.
+type Encryption struct {
+	Algorithm        string `json:"Algorithm" yaml:"Algorithm"`
+	KmsKeyID         string `json:"KmsKeyID,omitempty" yaml:"KmsKeyID,omitempty"`
+	BucketKeyEnabled bool   `json:"BucketKeyEnabled,omitempty" yaml:"BucketKeyEnabled,omitempty"`
+}
+
+// Change is a setting level difference between the current state of a bucket and its Manifest.
+type Change struct {
+	Setting string
+	Action  string
+	Current string
+	Desired string
+}
+
+// Parse parses the JSON or YAML content into a Manifest.
+func Parse(content []byte) (*Manifest, error) {
+	m := &Manifest{}
+	if err := utils.UnmarshalJSONOrYAML(content, m); err != nil {
+		return nil, errors.Wrap(err, "an error occurred while parsing manifest")
+	}
+
+	return m, nil
+}
+
+// ReadFile reads the JSON or YAML manifest file at the given path, parses and validates it.
+func ReadFile(path string) (*Manifest, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "an error occurred while reading manifest file")
+	}
+
+	m, err := Parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Validate(); err != nil {
+		return nil, errors.Wrap(err, "manifest is invalid")
+	}
+
+	return m, nil
+}
+
+// Validate checks the settings of the Manifest that can be checked without calling S3. The tags and the default
+// encryption are checked with the same rules as the bucket create command.
+func (m *Manifest) Validate() error {
+	if err := bucketutils.ValidateTags(m.Tags); err != nil {
+		return err
+	}
+
+	if err := validateStatus(SettingVersioning, m.Versioning); err != nil {
+		return err
+	}
+
+	if err := validateStatus(SettingTransferAcceleration, m.TransferAcceleration); err != nil {
+		return err
+	}
+
+	if m.Encryption != nil {
+		if m.Encryption.Algorithm == "" {
+			return errors.New("encryption algorithm must be specified, default encryption can not be removed from a bucket")
+		}
+
+		if err := bucketutils.ValidateEncryption(m.Encryption.Algorithm, m.Encryption.KmsKeyID); err != nil {
+			return err
+		}
+	}
+
+	if m.Lifecycle != nil {
+		if err := m.Lifecycle.Validate(); err != nil {
+			return errors.Wrap(err, "lifecycle is invalid")
+		}
+	}
+
+	if len(m.Policy) != 0 {
+		if _, ok := m.Policy["Statement"]; !ok {
+			return errors.New("policy must have at least one statement")
+		}
+	}
+
+	return nil
+}
+
+// PolicyJSON returns the compact JSON representation of the Policy.
+func (m *Manifest) PolicyJSON() (string, error) {
+	bytes, err := json.Marshal(m.Policy)
+	if err != nil {
+		return "", errors.Wrap(err, "an error occurred while marshaling policy")
+	}
+
+	return string(bytes), nil
+}
+
+// Marshal returns the representation of the Manifest in the given format, which is FormatYAML or FormatJSON. The
+// output can be parsed back with Parse.
+func (m *Manifest) Marshal(format string) ([]byte, error) {
+	switch format {
+	case FormatYAML:
+		var buf bytes.Buffer
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(m); err != nil {
+			return nil, errors.Wrap(err, "an error occurred while marshaling manifest")
+		}
+
+		return buf.Bytes(), nil
+	case FormatJSON:
+		content, err := json.MarshalIndent(m, "", "  ")
+		if err != nil {
+			return nil, errors.Wrap(err, "an error occurred while marshaling manifest")
+		}
+
+		return append(content, '\n'), nil
+	default:
+		return nil, fmt.Errorf("manifest format must be %s or %s, got %q", FormatYAML, FormatJSON, format)
+	}
+}
+
+// Diff returns the setting level changes that turn the current state of a bucket into the desired one. Only the
+// settings that are declared in the desired Manifest are compared, the current Manifest is expected to have all the
+// settings that the bucket has.
+func Diff(current, desired *Manifest) ([]Change, error) {
+	var changes []Change
+	if desired.Tags != nil && !tagsEqual(current.Tags, desired.Tags) {
+		changes = append(changes, newChange(SettingTags, len(current.Tags) != 0, len(desired.Tags) != 0,
+			FormatTags(current.Tags), FormatTags(desired.Tags)))
+	}
+
+	if desired.Versioning != "" && desired.Versioning != current.Versioning {
+		changes = append(changes, newChange(SettingVersioning, current.Versioning != "", true,
+			current.Versioning, desired.Versioning))
+	}
+
+	if desired.TransferAcceleration != "" && desired.TransferAcceleration != current.TransferAcceleration {
+		changes = append(changes, newChange(SettingTransferAcceleration, current.TransferAcceleration != "", true,
+			current.TransferAcceleration, desired.TransferAcceleration))
+	}
+
+	if desired.Policy != nil {
+		currentPolicy, err := normalizePolicy(current.Policy)
+		if err != nil {
+			return nil, err
+		}
+
+		desiredPolicy, err := normalizePolicy(desired.Policy)
+		if err != nil {
+			return nil, err
+		}
+
+		if !policiesEqual(currentPolicy, desiredPolicy) {
+			changes = append(changes, newChange(SettingPolicy, len(current.Policy) != 0, len(desired.Policy) != 0,
+				formatJSON(current.Policy), formatJSON(desired.Policy)))
+		}
+	}
+
+	if desired.Lifecycle != nil {
+		currentLifecycle := current.Lifecycle
+		if currentLifecycle == nil {
+			currentLifecycle = &lifecycle.Configuration{}
+		}
+
+		if !currentLifecycle.Equal(desired.Lifecycle) {
+			changes = append(changes, newChange(SettingLifecycle, len(currentLifecycle.Rules) != 0,
+				len(desired.Lifecycle.Rules) != 0, formatLifecycle(currentLifecycle), formatLifecycle(desired.Lifecycle)))
+		}
+	}
+
+	if desired.Encryption != nil && (current.Encryption == nil || *current.Encryption != *desired.Encryption) {
+		changes = append(changes, newChange(SettingEncryption, current.Encryption != nil, true,
+			FormatEncryption(current.Encryption), FormatEncryption(desired.Encryption)))
+	}
+
+	return changes, nil
+}
+
+// String returns the Terraform style single line representation of the Change.
+func (c Change) String() string {
+	switch c.Action {
+	case ActionAdd:
+		return fmt.Sprintf("+ %s: %s", c.Setting, c.Desired)
+	case ActionRemove:
+		return fmt.Sprintf("- %s: %s", c.Setting, c.Current)
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", c.Setting, c.Current, c.Desired)
+	}
+}
+
+// FormatTags returns the tags as comma separated key=value pairs sorted by their keys.
+func FormatTags(tags map[string]string) string {
+	pairs := make([]string, 0, len(tags))
+	for key, value := range tags {
+		pairs = append(pairs, key+"="+value)
+	}
+
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ", ")
+}
+
+// FormatEncryption returns the human-readable representation of a default encryption.
+func FormatEncryption(encryption *Encryption) string {
+	if encryption == nil {
+		return ""
+	}
+
+	if encryption.KmsKeyID == "" && !encryption.BucketKeyEnabled {
+		return encryption.Algorithm
+	}
+
+	return fmt.Sprintf("%s (kmsKeyId=%s, bucketKeyEnabled=%t)", encryption.Algorithm, encryption.KmsKeyID,
+		encryption.BucketKeyEnabled)
+}
+
+func newChange(setting string, hasCurrent, hasDesired bool, current, desired string) Change {
+	action := ActionChange
+	if !hasCurrent {
+		action = ActionAdd
+	} else if !hasDesired {
+		action = ActionRemove
+	}
+
+	return Change{Setting: setting, Action: action, Current: current, Desired: desired}
+}
+
+func validateStatus(setting, status string) error {
+	if status != "" && status != StatusEnabled && status != StatusSuspended {
+		return fmt.Errorf("%s must be %s or %s, got %q", setting, StatusEnabled, StatusSuspended, status)
+	}
+
+	return nil
+}
+
+func tagsEqual(current, desired map[string]string) bool {
+	if len(current) == 0 && len(desired) == 0 {
+		return true
+	}
+
+	return reflect.DeepEqual(current, desired)
+}
+
+// normalizePolicy parses the policy into a Document, so that it can be compared with the normalized form that S3
+// returns the policies in
+func normalizePolicy(content map[string]interface{}) (*policy.Document, error) {
+	if len(content) == 0 {
+		return nil, nil
+	}
+
+	bytes, err := json.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrap(err, "an error occurred while marshaling policy")
+	}
+
+	doc, err := policy.Parse(string(bytes))
+	if err != nil {
+		return nil, errors.Wrap(err, "an error occurred while parsing policy")
+	}
+
+	return doc, nil
+}
+
+func policiesEqual(current, desired *policy.Document) bool {
+	if current == nil || desired == nil {
+		return current == desired
+	}
+
+	return current.Equal(desired)
+}
+
+func formatJSON(v map[string]interface{}) string {
+	if len(v) == 0 {
+		return ""
+	}
+
+	bytes, _ := json.Marshal(v)
+
+	return string(bytes)
+}
+
+func formatLifecycle(cfg *lifecycle.Configuration) string {
+	if len(cfg.Rules) == 0 {
+		return ""
+	}
+
+	bytes, _ := json.Marshal(cfg.Rules)
+
+	return string(bytes)
+}
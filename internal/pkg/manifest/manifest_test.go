@@ -0,0 +1,221 @@
+//go:build unit
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/lifecycle"
+	"github.com/stretchr/testify/assert"
+)
+
+const yamlManifest = `
+Tags:
+  team: platform
+  env: prod
+Versioning: Enabled
+TransferAcceleration: Suspended
+Policy:
+  Version: "2012-10-17"
+  Statement:
+    - Sid: DenyInsecureTransport
+      Effect: Deny
+      Principal: "*"
+      Action: "s3:*"
+      Resource: "arn:aws:s3:::thisisbucketname/*"
+      Condition:
+        Bool:
+          aws:SecureTransport: "false"
+Lifecycle:
+  Rules:
+    - ID: logs
+      Status: Enabled
+      Filter:
+        Prefix: logs/
+      Expiration:
+        Days: 90
+Encryption:
+  Algorithm: aws:kms
+  KmsKeyID: alias/s3
+  BucketKeyEnabled: true
+`
+
+const jsonPolicy = `{"Version":"2012-10-17","Statement":[{"Sid":"DenyInsecureTransport","Effect":"Deny","Principal":"*",` +
+	`"Action":"s3:*","Resource":"arn:aws:s3:::thisisbucketname/*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`
+
+func TestParse(t *testing.T) {
+	m, err := Parse([]byte(yamlManifest))
+	assert.Nil(t, err)
+	assert.Nil(t, m.Validate())
+	assert.Equal(t, map[string]string{"team": "platform", "env": "prod"}, m.Tags)
+	assert.Equal(t, StatusEnabled, m.Versioning)
+	assert.Equal(t, "logs/", aws.ToString(m.Lifecycle.Rules[0].Filter.Prefix))
+	assert.Equal(t, &Encryption{Algorithm: "aws:kms", KmsKeyID: "alias/s3", BucketKeyEnabled: true}, m.Encryption)
+
+	policy, err := m.PolicyJSON()
+	assert.Nil(t, err)
+	assert.JSONEq(t, jsonPolicy, policy)
+
+	m, err = Parse([]byte(`{"Versioning": "Enabled"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, &Manifest{Versioning: StatusEnabled}, m)
+
+	_, err = Parse([]byte("Tags: [foo"))
+	assert.NotNil(t, err)
+}
+
+func TestManifest_Validate(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		manifest   *Manifest
+		shouldPass bool
+	}{
+		{"Success empty manifest", &Manifest{}, true},
+		{"Success with removals", &Manifest{Tags: map[string]string{}, Policy: map[string]interface{}{},
+			Lifecycle: &lifecycle.Configuration{}}, true},
+		{"Failure invalid versioning", &Manifest{Versioning: "Disabled"}, false},
+		{"Failure invalid transfer acceleration", &Manifest{TransferAcceleration: "enabled"}, false},
+		{"Failure reserved tag key", &Manifest{Tags: map[string]string{"aws:foo": "bar"}}, false},
+		{"Failure empty encryption", &Manifest{Encryption: &Encryption{}}, false},
+		{"Failure kms key without kms", &Manifest{Encryption: &Encryption{Algorithm: "AES256", KmsKeyID: "foo"}}, false},
+		{"Failure invalid lifecycle", &Manifest{Lifecycle: &lifecycle.Configuration{Rules: []lifecycle.Rule{{ID: "foo"}}}}, false},
+		{"Failure policy without statements", &Manifest{Policy: map[string]interface{}{"Version": "2012-10-17"}}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		err := tc.manifest.Validate()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	desired, err := Parse([]byte(yamlManifest))
+	assert.Nil(t, err)
+
+	current, err := Parse([]byte(`{"Tags":{"team":"platform","env":"prod"},"Versioning":"Suspended","Policy":` +
+		jsonPolicy + `,"Encryption":{"Algorithm":"AES256"}}`))
+	assert.Nil(t, err)
+
+	changes, err := Diff(current, desired)
+	assert.Nil(t, err)
+	assert.Equal(t, []Change{
+		{Setting: SettingVersioning, Action: ActionChange, Current: "Suspended", Desired: "Enabled"},
+		{Setting: SettingTransferAcceleration, Action: ActionAdd, Current: "", Desired: "Suspended"},
+		{Setting: SettingLifecycle, Action: ActionAdd, Current: "",
+			Desired: `[{"ID":"logs","Status":"Enabled","Filter":{"Prefix":"logs/"},"Expiration":{"Days":90}}]`},
+		{Setting: SettingEncryption, Action: ActionChange, Current: "AES256",
+			Desired: "aws:kms (kmsKeyId=alias/s3, bucketKeyEnabled=true)"},
+	}, changes)
+
+	// settings that are left out of the desired manifest are not compared
+	changes, err = Diff(current, &Manifest{})
+	assert.Nil(t, err)
+	assert.Empty(t, changes)
+
+	changes, err = Diff(current, &Manifest{Tags: map[string]string{}, Policy: map[string]interface{}{},
+		Lifecycle: &lifecycle.Configuration{}})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{ActionRemove, ActionRemove}, []string{changes[0].Action, changes[1].Action})
+	assert.Equal(t, []string{SettingTags, SettingPolicy}, []string{changes[0].Setting, changes[1].Setting})
+}
+
+func TestDiffPolicy(t *testing.T) {
+	desired, err := Parse([]byte(`{"Policy":{"Version":"2012-10-17","Statement":[{"Effect":"Allow",` +
+		`"Principal":{"AWS":"123456789012"},"Action":["s3:GetObject"],"Resource":["arn:aws:s3:::thisisbucketname/*"]}]}}`))
+	assert.Nil(t, err)
+
+	// the same policy in the form that S3 returns it
+	current, err := Parse([]byte(`{"Policy":{"Statement":[{"Effect":"Allow",` +
+		`"Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:GetObject",` +
+		`"Resource":"arn:aws:s3:::thisisbucketname/*"}],"Version":"2012-10-17"}}`))
+	assert.Nil(t, err)
+
+	changes, err := Diff(current, desired)
+	assert.Nil(t, err)
+	assert.Empty(t, changes)
+
+	desired.Policy["Statement"].([]interface{})[0].(map[string]interface{})["Action"] = []interface{}{"s3:GetObject",
+		"s3:PutObject"}
+	changes, err = Diff(current, desired)
+	assert.Nil(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, ActionChange, changes[0].Action)
+
+	changes, err = Diff(&Manifest{}, desired)
+	assert.Nil(t, err)
+	assert.Equal(t, ActionAdd, changes[0].Action)
+
+	_, err = Diff(current, &Manifest{Policy: map[string]interface{}{"Statement": "foo"}})
+	assert.NotNil(t, err)
+}
+
+func TestChange_String(t *testing.T) {
+	assert.Equal(t, "+ tags: a=b", Change{Setting: SettingTags, Action: ActionAdd, Desired: "a=b"}.String())
+	assert.Equal(t, "- tags: a=b", Change{Setting: SettingTags, Action: ActionRemove, Current: "a=b"}.String())
+	assert.Equal(t, "~ versioning: Suspended -> Enabled", Change{Setting: SettingVersioning, Action: ActionChange,
+		Current: "Suspended", Desired: "Enabled"}.String())
+}
+
+func TestFormatters(t *testing.T) {
+	assert.Equal(t, "a=1, b=2", FormatTags(map[string]string{"b": "2", "a": "1"}))
+	assert.Equal(t, "", FormatTags(nil))
+	assert.Equal(t, "", FormatEncryption(nil))
+	assert.Equal(t, "AES256", FormatEncryption(&Encryption{Algorithm: "AES256"}))
+}
+
+func TestManifest_Marshal(t *testing.T) {
+	m, err := Parse([]byte(yamlManifest))
+	assert.Nil(t, err)
+
+	for _, format := range []string{FormatYAML, FormatJSON} {
+		t.Logf("starting case %s", format)
+
+		content, err := m.Marshal(format)
+		assert.Nil(t, err)
+
+		parsed, err := Parse(content)
+		assert.Nil(t, err)
+
+		changes, err := Diff(parsed, m)
+		assert.Nil(t, err)
+		assert.Empty(t, changes)
+	}
+
+	_, err = m.Marshal("toml")
+	assert.NotNil(t, err)
+}
+
+func TestReadFile(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		path       string
+		shouldPass bool
+		versioning string
+	}{
+		{"Success json", "../../../testdata/manifest.json", true, "Enabled"},
+		{"Success yaml", "../../../testdata/manifest.yaml", true, "Enabled"},
+		{"Failure file not found", "../../../testdata/manifest.jsonnnn", false, ""},
+		{"Failure invalid content", "../../../testdata/file1.txt", false, ""},
+		{"Failure invalid manifest", "../../../testdata/manifest_invalid.yaml", false, ""},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		m, err := ReadFile(tc.path)
+		if !tc.shouldPass {
+			assert.NotNil(t, err)
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, tc.versioning, m.Versioning)
+	}
+}
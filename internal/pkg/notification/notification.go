@@ -0,0 +1,364 @@
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	TypeQueue  = "queue"
+	TypeTopic  = "topic"
+	TypeLambda = "lambda"
+
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeUpdated = "updated"
+)
+
+var (
+	// Types are the supported destination types of a Rule
+	Types = []string{TypeQueue, TypeTopic, TypeLambda}
+
+	arnRegexes = map[string]*regexp.Regexp{
+		TypeQueue:  regexp.MustCompile(`^arn:aws[a-z-]*:sqs:[a-z0-9-]+:\d{12}:[A-Za-z0-9_-]+(\.fifo)?$`),
+		TypeTopic:  regexp.MustCompile(`^arn:aws[a-z-]*:sns:[a-z0-9-]+:\d{12}:[A-Za-z0-9_-]+(\.fifo)?$`),
+		TypeLambda: regexp.MustCompile(`^arn:aws[a-z-]*:lambda:[a-z0-9-]+:\d{12}:function:[A-Za-z0-9_-]+(:[A-Za-z0-9_$-]+)?$`),
+	}
+)
+
+// Configuration is the event notification configuration of a bucket. The destinations of all types are kept in a
+// single list of rules so that they can be validated and diffed together.
+type Configuration struct {
+	Rules []Rule `json:"Rules" yaml:"Rules"`
+	// EventBridge is not managed by s3-manager, it is only preserved while putting the configuration back
+	EventBridge bool `json:"EventBridge,omitempty" yaml:"EventBridge,omitempty"`
+}
+
+// Rule is a single notification of a Configuration that sends the matching events to a queue, topic or lambda
+// function.
+type Rule struct {
+	ID     string   `json:"ID" yaml:"ID"`
+	Type   string   `json:"Type" yaml:"Type"`
+	Arn    string   `json:"Arn" yaml:"Arn"`
+	Events []string `json:"Events" yaml:"Events"`
+	Prefix string   `json:"Prefix,omitempty" yaml:"Prefix,omitempty"`
+	Suffix string   `json:"Suffix,omitempty" yaml:"Suffix,omitempty"`
+}
+
+// Change is a rule level difference between two Configurations.
+type Change struct {
+	Action string
+	Rule   Rule
+}
+
+// Validate checks the Configuration against the rules of S3 and returns the first violation it finds. An empty
+// Configuration is valid, it removes all the notifications of the bucket.
+func (c *Configuration) Validate() error {
+	ids := make(map[string]struct{})
+	for _, rule := range c.Rules {
+		if err := rule.Validate(); err != nil {
+			return errors.Wrapf(err, "rule %s is invalid", rule.ID)
+		}
+
+		if _, ok := ids[rule.ID]; ok {
+			return fmt.Errorf("duplicate rule ID %s", rule.ID)
+		}
+
+		ids[rule.ID] = struct{}{}
+	}
+
+	for i := range c.Rules {
+		for j := i + 1; j < len(c.Rules); j++ {
+			if event, ok := c.Rules[i].Overlaps(c.Rules[j]); ok {
+				return fmt.Errorf("rules %s and %s have overlapping filters for the event %s", c.Rules[i].ID,
+					c.Rules[j].ID, event)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate checks a single Rule against the rules of S3.
+func (r Rule) Validate() error {
+	if r.ID == "" {
+		return errors.New("ID must be specified")
+	}
+
+	regex, ok := arnRegexes[r.Type]
+	if !ok {
+		return fmt.Errorf("unsupported type %q, supported types are %s", r.Type, strings.Join(Types, ", "))
+	}
+
+	if !regex.MatchString(r.Arn) {
+		return fmt.Errorf("%q is not a valid %s ARN", r.Arn, r.Type)
+	}
+
+	if len(r.Events) == 0 {
+		return errors.New("at least one event must be specified")
+	}
+
+	for _, event := range r.Events {
+		if !isEvent(event) {
+			return fmt.Errorf("unsupported event %q", event)
+		}
+	}
+
+	return nil
+}
+
+// Overlaps reports whether S3 would reject the two rules together. Rules overlap when they share an event type,
+// including the wildcard ones, and an object key can match the filters of both rules. It returns the first shared
+// event it finds.
+func (r Rule) Overlaps(other Rule) (string, bool) {
+	if !strings.HasPrefix(r.Prefix, other.Prefix) && !strings.HasPrefix(other.Prefix, r.Prefix) {
+		return "", false
+	}
+
+	if !strings.HasSuffix(r.Suffix, other.Suffix) && !strings.HasSuffix(other.Suffix, r.Suffix) {
+		return "", false
+	}
+
+	for _, event := range r.Events {
+		for _, otherEvent := range other.Events {
+			if eventsOverlap(event, otherEvent) {
+				return event, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// String returns a single line representation of the Rule.
+func (r Rule) String() string {
+	return fmt.Sprintf("id=%s, type=%s, arn=%s, events=%s, prefix=%s, suffix=%s", r.ID, r.Type, r.Arn,
+		strings.Join(r.Events, ","), r.Prefix, r.Suffix)
+}
+
+// Put adds the rule to the Configuration, a rule with the same ID is replaced in place.
+func (c *Configuration) Put(rule Rule) {
+	if index := c.indexOf(rule.ID); index != -1 {
+		c.Rules[index] = rule
+		return
+	}
+
+	c.Rules = append(c.Rules, rule)
+}
+
+// RemoveByID removes the rule with the given ID from the Configuration.
+func (c *Configuration) RemoveByID(id string) error {
+	index := c.indexOf(id)
+	if index == -1 {
+		return fmt.Errorf("no rule found with ID %s", id)
+	}
+
+	c.Rules = append(c.Rules[:index], c.Rules[index+1:]...)
+
+	return nil
+}
+
+// Clone returns a copy of the Configuration that can be modified without touching the original one.
+func (c *Configuration) Clone() *Configuration {
+	clone := &Configuration{Rules: make([]Rule, 0, len(c.Rules)), EventBridge: c.EventBridge}
+	for _, rule := range c.Rules {
+		rule.Events = append([]string{}, rule.Events...)
+		clone.Rules = append(clone.Rules, rule)
+	}
+
+	return clone
+}
+
+// Diff returns the rule level changes that turn the current Configuration into the desired one. Rules are matched
+// by their IDs, the order of the events of a rule is not significant.
+func Diff(current, desired *Configuration) []Change {
+	var changes []Change
+	for _, rule := range current.Rules {
+		if desired.indexOf(rule.ID) == -1 {
+			changes = append(changes, Change{Action: ChangeRemoved, Rule: rule})
+		}
+	}
+
+	for _, rule := range desired.Rules {
+		index := current.indexOf(rule.ID)
+		if index == -1 {
+			changes = append(changes, Change{Action: ChangeAdded, Rule: rule})
+			continue
+		}
+
+		if !reflect.DeepEqual(current.Rules[index].normalized(), rule.normalized()) {
+			changes = append(changes, Change{Action: ChangeUpdated, Rule: rule})
+		}
+	}
+
+	return changes
+}
+
+// String returns the indented JSON representation of the Configuration.
+func (c *Configuration) String() (string, error) {
+	bytes, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes), nil
+}
+
+// ToSDK converts the Configuration into the notification configuration of AWS SDK.
+func (c *Configuration) ToSDK() *types.NotificationConfiguration {
+	cfg := &types.NotificationConfiguration{}
+	if c.EventBridge {
+		cfg.EventBridgeConfiguration = &types.EventBridgeConfiguration{}
+	}
+
+	for _, rule := range c.Rules {
+		events := make([]types.Event, 0, len(rule.Events))
+		for _, event := range rule.Events {
+			events = append(events, types.Event(event))
+		}
+
+		switch rule.Type {
+		case TypeQueue:
+			cfg.QueueConfigurations = append(cfg.QueueConfigurations, types.QueueConfiguration{
+				Id:       aws.String(rule.ID),
+				QueueArn: aws.String(rule.Arn),
+				Events:   events,
+				Filter:   rule.filter(),
+			})
+		case TypeTopic:
+			cfg.TopicConfigurations = append(cfg.TopicConfigurations, types.TopicConfiguration{
+				Id:       aws.String(rule.ID),
+				TopicArn: aws.String(rule.Arn),
+				Events:   events,
+				Filter:   rule.filter(),
+			})
+		case TypeLambda:
+			cfg.LambdaFunctionConfigurations = append(cfg.LambdaFunctionConfigurations, types.LambdaFunctionConfiguration{
+				Id:                aws.String(rule.ID),
+				LambdaFunctionArn: aws.String(rule.Arn),
+				Events:            events,
+				Filter:            rule.filter(),
+			})
+		}
+	}
+
+	return cfg
+}
+
+// FromSDK converts the notification configuration of AWS SDK into a Configuration.
+func FromSDK(queues []types.QueueConfiguration, topics []types.TopicConfiguration,
+	lambdas []types.LambdaFunctionConfiguration, eventBridge *types.EventBridgeConfiguration) *Configuration {
+	cfg := &Configuration{Rules: []Rule{}, EventBridge: eventBridge != nil}
+	for _, queue := range queues {
+		cfg.Rules = append(cfg.Rules, newRule(queue.Id, TypeQueue, queue.QueueArn, queue.Events, queue.Filter))
+	}
+
+	for _, topic := range topics {
+		cfg.Rules = append(cfg.Rules, newRule(topic.Id, TypeTopic, topic.TopicArn, topic.Events, topic.Filter))
+	}
+
+	for _, lambda := range lambdas {
+		cfg.Rules = append(cfg.Rules, newRule(lambda.Id, TypeLambda, lambda.LambdaFunctionArn, lambda.Events,
+			lambda.Filter))
+	}
+
+	return cfg
+}
+
+func newRule(id *string, ruleType string, arn *string, events []types.Event, filter *types.NotificationConfigurationFilter) Rule {
+	rule := Rule{
+		ID:     aws.ToString(id),
+		Type:   ruleType,
+		Arn:    aws.ToString(arn),
+		Events: make([]string, 0, len(events)),
+	}
+
+	for _, event := range events {
+		rule.Events = append(rule.Events, string(event))
+	}
+
+	if filter == nil || filter.Key == nil {
+		return rule
+	}
+
+	for _, filterRule := range filter.Key.FilterRules {
+		switch strings.ToLower(string(filterRule.Name)) {
+		case string(types.FilterRuleNamePrefix):
+			rule.Prefix = aws.ToString(filterRule.Value)
+		case string(types.FilterRuleNameSuffix):
+			rule.Suffix = aws.ToString(filterRule.Value)
+		}
+	}
+
+	return rule
+}
+
+func (r Rule) filter() *types.NotificationConfigurationFilter {
+	if r.Prefix == "" && r.Suffix == "" {
+		return nil
+	}
+
+	key := &types.S3KeyFilter{}
+	if r.Prefix != "" {
+		key.FilterRules = append(key.FilterRules, types.FilterRule{Name: types.FilterRuleNamePrefix, Value: aws.String(r.Prefix)})
+	}
+
+	if r.Suffix != "" {
+		key.FilterRules = append(key.FilterRules, types.FilterRule{Name: types.FilterRuleNameSuffix, Value: aws.String(r.Suffix)})
+	}
+
+	return &types.NotificationConfigurationFilter{Key: key}
+}
+
+func (r Rule) normalized() Rule {
+	r.Events = append([]string{}, r.Events...)
+	sort.Strings(r.Events)
+
+	return r
+}
+
+func (c *Configuration) indexOf(id string) int {
+	for i, rule := range c.Rules {
+		if rule.ID == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func isEvent(event string) bool {
+	for _, v := range types.Event("").Values() {
+		if string(v) == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+// eventsOverlap reports whether two events match a common event, "s3:ObjectCreated:*" overlaps with all the
+// "s3:ObjectCreated:..." events.
+func eventsOverlap(first, second string) bool {
+	if first == second {
+		return true
+	}
+
+	return matchesWildcard(first, second) || matchesWildcard(second, first)
+}
+
+func matchesWildcard(wildcard, event string) bool {
+	if !strings.HasSuffix(wildcard, ":*") {
+		return false
+	}
+
+	return strings.HasPrefix(event, strings.TrimSuffix(wildcard, "*"))
+}
@@ -0,0 +1,204 @@
+//go:build unit
+
+package notification
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	queueArn  = "arn:aws:sqs:us-east-1:123456789012:ingestion"
+	topicArn  = "arn:aws:sns:us-east-1:123456789012:uploads"
+	lambdaArn = "arn:aws:lambda:us-east-1:123456789012:function:thumbnail"
+)
+
+func validRule(id, prefix, suffix string, events ...string) Rule {
+	return Rule{
+		ID:     id,
+		Type:   TypeQueue,
+		Arn:    queueArn,
+		Events: events,
+		Prefix: prefix,
+		Suffix: suffix,
+	}
+}
+
+func TestConfiguration_Validate(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		cfg        *Configuration
+		shouldPass bool
+	}{
+		{"Success empty configuration", &Configuration{}, true},
+		{"Success", &Configuration{Rules: []Rule{
+			validRule("images", "images/", ".jpg", "s3:ObjectCreated:*"),
+			{ID: "videos", Type: TypeTopic, Arn: topicArn, Events: []string{"s3:ObjectCreated:Put"}, Prefix: "videos/"},
+			{ID: "thumbnail", Type: TypeLambda, Arn: lambdaArn, Events: []string{"s3:ObjectRemoved:*"}, Suffix: ".png"},
+		}}, true},
+		{"Success same filters with different events", &Configuration{Rules: []Rule{
+			validRule("created", "logs/", "", "s3:ObjectCreated:*"),
+			validRule("removed", "logs/", "", "s3:ObjectRemoved:*"),
+		}}, true},
+		{"Success same event with disjoint suffixes", &Configuration{Rules: []Rule{
+			validRule("jpg", "", ".jpg", "s3:ObjectCreated:Put"),
+			validRule("png", "", ".png", "s3:ObjectCreated:Put"),
+		}}, true},
+		{"Failure duplicate id", &Configuration{Rules: []Rule{
+			validRule("foo", "a/", "", "s3:ObjectCreated:*"),
+			validRule("foo", "b/", "", "s3:ObjectCreated:*"),
+		}}, false},
+		{"Failure missing id", &Configuration{Rules: []Rule{validRule("", "", "", "s3:ObjectCreated:*")}}, false},
+		{"Failure unsupported type", &Configuration{Rules: []Rule{{ID: "foo", Type: "email", Arn: queueArn,
+			Events: []string{"s3:ObjectCreated:*"}}}}, false},
+		{"Failure arn of another type", &Configuration{Rules: []Rule{{ID: "foo", Type: TypeLambda, Arn: queueArn,
+			Events: []string{"s3:ObjectCreated:*"}}}}, false},
+		{"Failure no events", &Configuration{Rules: []Rule{validRule("foo", "", "")}}, false},
+		{"Failure unsupported event", &Configuration{Rules: []Rule{validRule("foo", "", "", "s3:ObjectCreated")}}, false},
+		{"Failure overlapping prefixes", &Configuration{Rules: []Rule{
+			validRule("all", "", "", "s3:ObjectCreated:Put"),
+			validRule("images", "images/", "", "s3:ObjectCreated:Put"),
+		}}, false},
+		{"Failure overlapping wildcard event", &Configuration{Rules: []Rule{
+			validRule("all", "images/", ".jpg", "s3:ObjectCreated:*"),
+			validRule("copies", "images/2023/", "", "s3:ObjectCreated:Copy"),
+		}}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		err := tc.cfg.Validate()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestRule_Overlaps(t *testing.T) {
+	cases := []struct {
+		caseName string
+		first    Rule
+		second   Rule
+		event    string
+		overlaps bool
+	}{
+		{"Same event and filters", validRule("a", "x/", ".csv", "s3:ObjectCreated:Put"),
+			validRule("b", "x/", ".csv", "s3:ObjectCreated:Put"), "s3:ObjectCreated:Put", true},
+		{"Wildcard event on the other rule", validRule("a", "", "", "s3:ObjectRemoved:Delete"),
+			validRule("b", "", "", "s3:ObjectRemoved:*"), "s3:ObjectRemoved:Delete", true},
+		{"Nested suffixes", validRule("a", "", ".tar.gz", "s3:ObjectCreated:*"),
+			validRule("b", "", ".gz", "s3:ObjectCreated:*"), "s3:ObjectCreated:*", true},
+		{"Disjoint prefixes", validRule("a", "x/", "", "s3:ObjectCreated:*"),
+			validRule("b", "y/", "", "s3:ObjectCreated:*"), "", false},
+		{"Different events", validRule("a", "", "", "s3:ObjectCreated:*"),
+			validRule("b", "", "", "s3:ObjectRestore:Post"), "", false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		event, overlaps := tc.first.Overlaps(tc.second)
+		assert.Equal(t, tc.overlaps, overlaps)
+		assert.Equal(t, tc.event, event)
+	}
+}
+
+func TestConfiguration_PutAndRemove(t *testing.T) {
+	cfg := &Configuration{}
+	cfg.Put(validRule("foo", "", "", "s3:ObjectCreated:*"))
+	cfg.Put(validRule("bar", "", "", "s3:ObjectRemoved:*"))
+	cfg.Put(validRule("foo", "images/", "", "s3:ObjectCreated:*"))
+
+	assert.Len(t, cfg.Rules, 2)
+	assert.Equal(t, "images/", cfg.Rules[0].Prefix)
+
+	assert.Nil(t, cfg.RemoveByID("foo"))
+	assert.NotNil(t, cfg.RemoveByID("foo"))
+	assert.Len(t, cfg.Rules, 1)
+	assert.Equal(t, "bar", cfg.Rules[0].ID)
+}
+
+func TestConfiguration_Clone(t *testing.T) {
+	cfg := &Configuration{Rules: []Rule{validRule("foo", "", "", "s3:ObjectCreated:*")}, EventBridge: true}
+	clone := cfg.Clone()
+	clone.Rules[0].Events[0] = "s3:ObjectRemoved:*"
+	clone.Put(validRule("bar", "", "", "s3:ObjectRemoved:*"))
+
+	assert.True(t, clone.EventBridge)
+	assert.Len(t, cfg.Rules, 1)
+	assert.Equal(t, "s3:ObjectCreated:*", cfg.Rules[0].Events[0])
+}
+
+func TestDiff(t *testing.T) {
+	current := &Configuration{Rules: []Rule{
+		validRule("kept", "", "", "s3:ObjectCreated:Put", "s3:ObjectCreated:Copy"),
+		validRule("updated", "logs/", "", "s3:ObjectRemoved:*"),
+		validRule("removed", "tmp/", "", "s3:ObjectRemoved:*"),
+	}}
+	desired := &Configuration{Rules: []Rule{
+		validRule("kept", "", "", "s3:ObjectCreated:Copy", "s3:ObjectCreated:Put"),
+		validRule("updated", "logs/", ".gz", "s3:ObjectRemoved:*"),
+		validRule("added", "images/", "", "s3:ObjectRestore:*"),
+	}}
+
+	changes := Diff(current, desired)
+	assert.Equal(t, []Change{
+		{Action: ChangeRemoved, Rule: current.Rules[2]},
+		{Action: ChangeUpdated, Rule: desired.Rules[1]},
+		{Action: ChangeAdded, Rule: desired.Rules[2]},
+	}, changes)
+
+	assert.Empty(t, Diff(current, current.Clone()))
+}
+
+func TestSDKConversion(t *testing.T) {
+	cfg := &Configuration{Rules: []Rule{
+		validRule("images", "images/", ".jpg", "s3:ObjectCreated:*"),
+		{ID: "videos", Type: TypeTopic, Arn: topicArn, Events: []string{"s3:ObjectCreated:Put"}, Prefix: "videos/"},
+		{ID: "thumbnail", Type: TypeLambda, Arn: lambdaArn, Events: []string{"s3:ObjectRemoved:*"}},
+	}, EventBridge: true}
+
+	sdk := cfg.ToSDK()
+	assert.Len(t, sdk.QueueConfigurations, 1)
+	assert.Len(t, sdk.TopicConfigurations, 1)
+	assert.Len(t, sdk.LambdaFunctionConfigurations, 1)
+	assert.NotNil(t, sdk.EventBridgeConfiguration)
+	assert.Len(t, sdk.QueueConfigurations[0].Filter.Key.FilterRules, 2)
+	assert.Nil(t, sdk.LambdaFunctionConfigurations[0].Filter)
+
+	converted := FromSDK(sdk.QueueConfigurations, sdk.TopicConfigurations, sdk.LambdaFunctionConfigurations,
+		sdk.EventBridgeConfiguration)
+	assert.Equal(t, cfg, converted)
+
+	// S3 reports the filter rule names capitalized
+	converted = FromSDK([]types.QueueConfiguration{{
+		Id:       aws.String("upper"),
+		QueueArn: aws.String(queueArn),
+		Events:   []types.Event{types.EventS3ObjectCreated},
+		Filter: &types.NotificationConfigurationFilter{Key: &types.S3KeyFilter{FilterRules: []types.FilterRule{
+			{Name: "Prefix", Value: aws.String("a/")},
+			{Name: "Suffix", Value: aws.String(".b")},
+		}}},
+	}}, nil, nil, nil)
+	assert.Equal(t, "a/", converted.Rules[0].Prefix)
+	assert.Equal(t, ".b", converted.Rules[0].Suffix)
+	assert.False(t, converted.EventBridge)
+}
+
+func TestRule_String(t *testing.T) {
+	rule := validRule("images", "images/", ".jpg", "s3:ObjectCreated:Put", "s3:ObjectCreated:Copy")
+	assert.Equal(t, "id=images, type=queue, arn="+queueArn+", events=s3:ObjectCreated:Put,s3:ObjectCreated:Copy, "+
+		"prefix=images/, suffix=.jpg", rule.String())
+}
+
+func TestConfiguration_String(t *testing.T) {
+	content, err := (&Configuration{Rules: []Rule{validRule("foo", "", "", "s3:ObjectCreated:*")}}).String()
+	assert.Nil(t, err)
+	assert.Contains(t, content, `"ID": "foo"`)
+}
@@ -0,0 +1,227 @@
+package objectinfo
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/bucketinfo"
+)
+
+const (
+	HeaderContentType        = "Content-Type"
+	HeaderCacheControl       = "Cache-Control"
+	HeaderContentDisposition = "Content-Disposition"
+	HeaderContentEncoding    = "Content-Encoding"
+	HeaderContentLanguage    = "Content-Language"
+
+	// MetadataPrefix is the prefix of the user metadata keys in the flat representation of Metadata, it is the
+	// prefix of their HTTP headers
+	MetadataPrefix = "x-amz-meta-"
+
+	// MaxCopySize is the size of the largest object in bytes that can be copied in place with a single CopyObject call
+	MaxCopySize = 5 * 1024 * 1024 * 1024
+
+	// DefaultStorageClass is the storage class of the objects that HeadObject reports without a storage class
+	DefaultStorageClass = string(types.StorageClassStandard)
+)
+
+// Change is the change of the headers and the user metadata of an object. Headers and Metadata are set, the user
+// metadata keys in Remove are removed. The user metadata keys are case-insensitive and stored in lowercase by S3.
+type Change struct {
+	Headers  map[string]string
+	Metadata map[string]string
+	Remove   []string
+}
+
+// Update is the desired metadata of a single object, Head is its current state.
+type Update struct {
+	Key     string
+	Head    *s3.HeadObjectOutput
+	Desired map[string]string
+}
+
+// IsEmpty reports whether the Change does not change anything.
+func (c Change) IsEmpty() bool {
+	return len(c.Headers) == 0 && len(c.Metadata) == 0 && len(c.Remove) == 0
+}
+
+// Apply returns a copy of the flat metadata, as returned by Metadata, with the Change applied on it.
+func (c Change) Apply(current map[string]string) map[string]string {
+	desired := make(map[string]string, len(current))
+	for key, value := range current {
+		desired[key] = value
+	}
+
+	for header, value := range c.Headers {
+		desired[header] = value
+	}
+
+	for key, value := range c.Metadata {
+		desired[MetadataPrefix+strings.ToLower(key)] = value
+	}
+
+	for _, key := range c.Remove {
+		delete(desired, MetadataPrefix+strings.ToLower(key))
+	}
+
+	return desired
+}
+
+// Metadata returns the editable headers and the user metadata of an object as a flat map, the headers are keyed by
+// their names and the user metadata keys are prefixed with MetadataPrefix. The headers that are not set are left out.
+func Metadata(head *s3.HeadObjectOutput) map[string]string {
+	metadata := make(map[string]string)
+	for header, value := range map[string]*string{
+		HeaderContentType:        head.ContentType,
+		HeaderCacheControl:       head.CacheControl,
+		HeaderContentDisposition: head.ContentDisposition,
+		HeaderContentEncoding:    head.ContentEncoding,
+		HeaderContentLanguage:    head.ContentLanguage,
+	} {
+		if aws.ToString(value) != "" {
+			metadata[header] = aws.ToString(value)
+		}
+	}
+
+	for key, value := range head.Metadata {
+		metadata[MetadataPrefix+strings.ToLower(key)] = value
+	}
+
+	return metadata
+}
+
+// UserMetadata returns the user metadata of the flat metadata, as returned by Metadata, without their prefixes.
+func UserMetadata(metadata map[string]string) map[string]string {
+	user := make(map[string]string)
+	for key, value := range metadata {
+		if strings.HasPrefix(key, MetadataPrefix) {
+			user[strings.TrimPrefix(key, MetadataPrefix)] = value
+		}
+	}
+
+	return user
+}
+
+// StorageClass returns the storage class of an object, the objects in the STANDARD storage class are reported
+// without a storage class by HeadObject.
+func StorageClass(head *s3.HeadObjectOutput) string {
+	if head.StorageClass == "" {
+		return DefaultStorageClass
+	}
+
+	return string(head.StorageClass)
+}
+
+// Report returns the HeadObject output of the object with the given key as a Report, the settings that are not set
+// are reported as none.
+func Report(key string, head *s3.HeadObjectOutput) bucketinfo.Report {
+	return bucketinfo.Report{
+		{Name: "key", Value: key},
+		{Name: "content type", Value: valueOrNone(aws.ToString(head.ContentType))},
+		{Name: "content length", Value: fmt.Sprintf("%d bytes", aws.ToInt64(head.ContentLength))},
+		{Name: "last modified", Value: formatTime(head.LastModified)},
+		{Name: "etag", Value: valueOrNone(aws.ToString(head.ETag))},
+		{Name: "storage class", Value: StorageClass(head)},
+		{Name: "cache control", Value: valueOrNone(aws.ToString(head.CacheControl))},
+		{Name: "content encoding", Value: valueOrNone(aws.ToString(head.ContentEncoding))},
+		{Name: "content disposition", Value: valueOrNone(aws.ToString(head.ContentDisposition))},
+		{Name: "content language", Value: valueOrNone(aws.ToString(head.ContentLanguage))},
+		{Name: "expires", Value: formatTime(head.Expires)},
+		{Name: "encryption", Value: formatEncryption(head)},
+		{Name: "checksum", Value: formatChecksum(head)},
+		{Name: "version", Value: valueOrNone(aws.ToString(head.VersionId))},
+		{Name: "lock", Value: formatLock(head)},
+		{Name: "replication", Value: valueOrNone(string(head.ReplicationStatus))},
+		{Name: "metadata", Value: formatMetadata(head.Metadata)},
+	}
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return bucketinfo.StatusNone
+	}
+
+	return value
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return bucketinfo.StatusNone
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatEncryption(head *s3.HeadObjectOutput) string {
+	switch {
+	case aws.ToString(head.SSECustomerAlgorithm) != "":
+		return fmt.Sprintf("SSE-C %s", aws.ToString(head.SSECustomerAlgorithm))
+	case head.ServerSideEncryption == "":
+		return bucketinfo.StatusNone
+	case aws.ToString(head.SSEKMSKeyId) != "":
+		return fmt.Sprintf("%s with key %s, bucket key %t", head.ServerSideEncryption, aws.ToString(head.SSEKMSKeyId),
+			aws.ToBool(head.BucketKeyEnabled))
+	default:
+		return string(head.ServerSideEncryption)
+	}
+}
+
+func formatChecksum(head *s3.HeadObjectOutput) string {
+	var checksums []string
+	for _, checksum := range []struct {
+		algorithm types.ChecksumAlgorithm
+		value     *string
+	}{
+		{types.ChecksumAlgorithmCrc32, head.ChecksumCRC32},
+		{types.ChecksumAlgorithmCrc32c, head.ChecksumCRC32C},
+		{types.ChecksumAlgorithmSha1, head.ChecksumSHA1},
+		{types.ChecksumAlgorithmSha256, head.ChecksumSHA256},
+	} {
+		if aws.ToString(checksum.value) != "" {
+			checksums = append(checksums, fmt.Sprintf("%s %s", checksum.algorithm, aws.ToString(checksum.value)))
+		}
+	}
+
+	if len(checksums) == 0 {
+		return bucketinfo.StatusNone
+	}
+
+	return strings.Join(checksums, ", ")
+}
+
+func formatLock(head *s3.HeadObjectOutput) string {
+	var parts []string
+	if head.ObjectLockMode != "" {
+		parts = append(parts, fmt.Sprintf("%s until %s", head.ObjectLockMode, formatTime(head.ObjectLockRetainUntilDate)))
+	}
+
+	if head.ObjectLockLegalHoldStatus != "" {
+		parts = append(parts, fmt.Sprintf("legal hold %s", head.ObjectLockLegalHoldStatus))
+	}
+
+	if len(parts) == 0 {
+		return bucketinfo.StatusNone
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func formatMetadata(metadata map[string]string) string {
+	if len(metadata) == 0 {
+		return bucketinfo.StatusNone
+	}
+
+	pairs := make([]string, 0, len(metadata))
+	for key, value := range metadata {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, value))
+	}
+
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ", ")
+}
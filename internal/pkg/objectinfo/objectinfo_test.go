@@ -0,0 +1,89 @@
+//go:build unit
+
+package objectinfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/bucketinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChange_Apply(t *testing.T) {
+	current := map[string]string{HeaderContentType: "text/plain", MetadataPrefix + "owner": "web", MetadataPrefix + "legacy": "true"}
+	change := Change{
+		Headers:  map[string]string{HeaderCacheControl: "no-cache"},
+		Metadata: map[string]string{"Owner": "data"},
+		Remove:   []string{"LEGACY"},
+	}
+
+	assert.Equal(t, map[string]string{
+		HeaderContentType:        "text/plain",
+		HeaderCacheControl:       "no-cache",
+		MetadataPrefix + "owner": "data",
+	}, change.Apply(current))
+	assert.Len(t, current, 3)
+	assert.False(t, change.IsEmpty())
+	assert.True(t, Change{}.IsEmpty())
+}
+
+func TestMetadata(t *testing.T) {
+	metadata := Metadata(&s3.HeadObjectOutput{
+		ContentType:  aws.String("text/csv"),
+		CacheControl: aws.String(""),
+		Metadata:     map[string]string{"Owner": "data"},
+	})
+
+	assert.Equal(t, map[string]string{HeaderContentType: "text/csv", MetadataPrefix + "owner": "data"}, metadata)
+	assert.Equal(t, map[string]string{"owner": "data"}, UserMetadata(metadata))
+}
+
+func TestStorageClass(t *testing.T) {
+	assert.Equal(t, DefaultStorageClass, StorageClass(&s3.HeadObjectOutput{}))
+	assert.Equal(t, "GLACIER", StorageClass(&s3.HeadObjectOutput{StorageClass: types.StorageClassGlacier}))
+}
+
+func TestReport(t *testing.T) {
+	modified := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	report := Report("logs/app.log", &s3.HeadObjectOutput{
+		ContentType:               aws.String("text/plain"),
+		ContentLength:             aws.Int64(1024),
+		LastModified:              aws.Time(modified),
+		ETag:                      aws.String(`"abc"`),
+		ServerSideEncryption:      types.ServerSideEncryptionAwsKms,
+		SSEKMSKeyId:               aws.String("key-1"),
+		BucketKeyEnabled:          aws.Bool(true),
+		ChecksumSHA256:            aws.String("c2hhMjU2"),
+		VersionId:                 aws.String("v1"),
+		ObjectLockMode:            types.ObjectLockModeGovernance,
+		ObjectLockRetainUntilDate: aws.Time(modified),
+		ObjectLockLegalHoldStatus: types.ObjectLockLegalHoldStatusOn,
+		Metadata:                  map[string]string{"owner": "data", "env": "prod"},
+	})
+
+	values := make(map[string]string)
+	for _, setting := range report {
+		values[setting.Name] = setting.Value
+	}
+
+	assert.Equal(t, "logs/app.log", values["key"])
+	assert.Equal(t, "1024 bytes", values["content length"])
+	assert.Equal(t, "2023-05-01T10:00:00Z", values["last modified"])
+	assert.Equal(t, DefaultStorageClass, values["storage class"])
+	assert.Equal(t, bucketinfo.StatusNone, values["cache control"])
+	assert.Equal(t, bucketinfo.StatusNone, values["expires"])
+	assert.Equal(t, "aws:kms with key key-1, bucket key true", values["encryption"])
+	assert.Equal(t, "SHA256 c2hhMjU2", values["checksum"])
+	assert.Equal(t, "GOVERNANCE until 2023-05-01T10:00:00Z, legal hold ON", values["lock"])
+	assert.Equal(t, "env=prod, owner=data", values["metadata"])
+
+	report = Report("foo", &s3.HeadObjectOutput{SSECustomerAlgorithm: aws.String("AES256")})
+	assert.Equal(t, "SSE-C AES256", report[11].Value)
+	assert.Equal(t, bucketinfo.StatusNone, report[12].Value)
+	assert.Equal(t, bucketinfo.StatusNone, report[14].Value)
+	assert.Equal(t, bucketinfo.StatusNone, report[16].Value)
+}
@@ -0,0 +1,282 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+var (
+	documentKeys  = []string{"Version", "Id", "Statement"}
+	statementKeys = []string{"Sid", "Effect", "Principal", "NotPrincipal", "Action", "NotAction", "Resource",
+		"NotResource", "Condition"}
+	versions = []string{"2012-10-17", "2008-10-17"}
+
+	// conditionOperators are the base condition operators, they can be suffixed with "IfExists" and prefixed with
+	// the "ForAnyValue:" and "ForAllValues:" set operators
+	conditionOperators = []string{
+		"StringEquals", "StringNotEquals", "StringEqualsIgnoreCase", "StringNotEqualsIgnoreCase", "StringLike",
+		"StringNotLike", "NumericEquals", "NumericNotEquals", "NumericLessThan", "NumericLessThanEquals",
+		"NumericGreaterThan", "NumericGreaterThanEquals", "DateEquals", "DateNotEquals", "DateLessThan",
+		"DateLessThanEquals", "DateGreaterThan", "DateGreaterThanEquals", "Bool", "BinaryEquals", "IpAddress",
+		"NotIpAddress", "ArnEquals", "ArnLike", "ArnNotEquals", "ArnNotLike", "Null",
+	}
+
+	sidRegex      = regexp.MustCompile(`^[A-Za-z0-9]*$`)
+	actionRegex   = regexp.MustCompile(`^[A-Za-z0-9-]+:[A-Za-z0-9*?]+$`)
+	s3ArnRegex    = regexp.MustCompile(`^arn:aws[a-z-]*:s3:::([^/]+)(/.*)?$`)
+	publicActions = Value{"*", "s3:*"}
+)
+
+// Finding is a single problem found in a bucket policy. Findings with SeverityError are rejected by S3, the ones with
+// SeverityWarning are accepted but most probably not intended.
+type Finding struct {
+	Severity string
+	// Statement is the Sid of the statement that the finding belongs to, or its 1-based index prefixed with '#' if it
+	// has no Sid. It is empty for the findings about the whole document.
+	Statement string
+	Message   string
+}
+
+func (f Finding) String() string {
+	if f.Statement == "" {
+		return fmt.Sprintf("%s: %s", f.Severity, f.Message)
+	}
+
+	return fmt.Sprintf("%s: statement %s: %s", f.Severity, f.Statement, f.Message)
+}
+
+// HasErrors reports whether any of the findings has SeverityError.
+func HasErrors(findings []Finding) bool {
+	for _, finding := range findings {
+		if finding.Severity == SeverityError {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Lint validates the bucket policy content offline, without calling S3. It checks the JSON structure, the policy
+// grammar, the resources against the target bucket and flags the risky statements. The findings are returned in
+// the order of the statements.
+func Lint(content, bucketName string) []Finding {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(strings.TrimSpace(content)), &raw); err != nil {
+		return []Finding{{Severity: SeverityError, Message: fmt.Sprintf("policy is not a valid JSON object: %s", err)}}
+	}
+
+	var findings []Finding
+	for _, key := range unknownKeys(raw, documentKeys) {
+		findings = append(findings, Finding{Severity: SeverityError, Message: fmt.Sprintf("unknown element %s", key)})
+	}
+
+	doc, err := Parse(content)
+	if err != nil {
+		return append(findings, Finding{Severity: SeverityError, Message: fmt.Sprintf("policy grammar is invalid: %s", err)})
+	}
+
+	switch {
+	case doc.Version == "":
+		findings = append(findings, Finding{Severity: SeverityWarning, Message: "Version is missing, it defaults to " +
+			"2008-10-17 which does not support policy variables"})
+	case !Value(versions).Contains(doc.Version):
+		findings = append(findings, Finding{Severity: SeverityError, Message: fmt.Sprintf("Version must be one of %s, "+
+			"got %s", strings.Join(versions, ", "), doc.Version)})
+	}
+
+	if len(doc.Statement) == 0 {
+		return append(findings, Finding{Severity: SeverityError, Message: "policy must have at least one statement"})
+	}
+
+	for _, sid := range doc.DuplicateSids() {
+		findings = append(findings, Finding{Severity: SeverityError, Statement: sid, Message: "Sid is used by more " +
+			"than one statement"})
+	}
+
+	rawStatements := rawStatementsOf(raw["Statement"])
+	for i, statement := range doc.Statement {
+		label := statementLabel(i, statement)
+		var messages, warnings []string
+		if i < len(rawStatements) {
+			for _, key := range unknownKeys(rawStatements[i], statementKeys) {
+				messages = append(messages, fmt.Sprintf("unknown element %s", key))
+			}
+		}
+
+		messages = append(messages, lintStatement(statement, bucketName)...)
+		warnings = append(warnings, riskyPatterns(statement)...)
+
+		for _, message := range messages {
+			findings = append(findings, Finding{Severity: SeverityError, Statement: label, Message: message})
+		}
+
+		for _, warning := range warnings {
+			findings = append(findings, Finding{Severity: SeverityWarning, Statement: label, Message: warning})
+		}
+	}
+
+	return findings
+}
+
+// statementLabel returns the Sid of the statement, or its 1-based index prefixed with '#' if it has no Sid
+func statementLabel(i int, s Statement) string {
+	if s.Sid == "" {
+		return fmt.Sprintf("#%d", i+1)
+	}
+
+	return s.Sid
+}
+
+// lintStatement returns the grammar errors of a statement
+func lintStatement(s Statement, bucketName string) (messages []string) {
+	if !sidRegex.MatchString(s.Sid) {
+		messages = append(messages, "Sid must only contain alphanumeric characters")
+	}
+
+	if s.Effect != "Allow" && s.Effect != "Deny" {
+		messages = append(messages, fmt.Sprintf("Effect must be Allow or Deny, got %q", s.Effect))
+	}
+
+	messages = append(messages, exclusive("Principal", s.Principal != nil, "NotPrincipal", s.NotPrincipal != nil)...)
+	messages = append(messages, exclusive("Action", s.Action != nil, "NotAction", s.NotAction != nil)...)
+	messages = append(messages, exclusive("Resource", s.Resource != nil, "NotResource", s.NotResource != nil)...)
+
+	for _, action := range append(append(Value{}, s.Action...), s.NotAction...) {
+		if action != wildcard && !actionRegex.MatchString(action) {
+			messages = append(messages, fmt.Sprintf("action %s must be in service:action format", action))
+		}
+	}
+
+	for _, resource := range append(append(Value{}, s.Resource...), s.NotResource...) {
+		if resource == wildcard {
+			continue
+		}
+
+		match := s3ArnRegex.FindStringSubmatch(resource)
+		if match == nil {
+			messages = append(messages, fmt.Sprintf("resource %s is not an S3 ARN", resource))
+			continue
+		}
+
+		if bucketName != "" && !WildcardMatch(match[1], bucketName) {
+			messages = append(messages, fmt.Sprintf("resource %s does not belong to bucket %s", resource, bucketName))
+		}
+	}
+
+	// the operators and the keys are sorted since the order of the map iteration is random
+	operators := make([]string, 0, len(s.Condition))
+	for operator := range s.Condition {
+		operators = append(operators, operator)
+	}
+
+	sort.Strings(operators)
+	for _, operator := range operators {
+		if !isConditionOperator(operator) {
+			messages = append(messages, fmt.Sprintf("unknown condition operator %s", operator))
+		}
+
+		keys := make([]string, 0, len(s.Condition[operator]))
+		for key := range s.Condition[operator] {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+		for _, key := range keys {
+			if len(s.Condition[operator][key]) == 0 {
+				messages = append(messages, fmt.Sprintf("condition key %s of %s has no values", key, operator))
+			}
+		}
+	}
+
+	return messages
+}
+
+// riskyPatterns returns the warnings of a statement that S3 accepts but most probably are not intended
+func riskyPatterns(s Statement) (warnings []string) {
+	if s.Effect != "Allow" {
+		return nil
+	}
+
+	if s.Principal.IsWildcard() && len(s.Condition) == 0 {
+		warnings = append(warnings, "allows anyone without any conditions, the bucket is public")
+	}
+
+	if s.NotPrincipal != nil {
+		warnings = append(warnings, "NotPrincipal with Allow grants access to everyone except the listed principals")
+	}
+
+	for _, action := range s.Action {
+		if publicActions.Contains(action) {
+			warnings = append(warnings, fmt.Sprintf("allows all the actions with %s", action))
+		}
+	}
+
+	if s.NotAction != nil {
+		warnings = append(warnings, "NotAction with Allow grants all the actions except the listed ones")
+	}
+
+	return warnings
+}
+
+func exclusive(name string, has bool, notName string, hasNot bool) []string {
+	switch {
+	case has && hasNot:
+		return []string{fmt.Sprintf("only one of %s and %s can be specified", name, notName)}
+	case !has && !hasNot:
+		return []string{fmt.Sprintf("one of %s and %s must be specified", name, notName)}
+	default:
+		return nil
+	}
+}
+
+func isConditionOperator(operator string) bool {
+	if i := strings.Index(operator, ":"); i != -1 {
+		setOperator := operator[:i]
+		if setOperator != "ForAnyValue" && setOperator != "ForAllValues" {
+			return false
+		}
+
+		operator = operator[i+1:]
+	}
+
+	if operator != "Null" {
+		operator = strings.TrimSuffix(operator, "IfExists")
+	}
+
+	return Value(conditionOperators).Contains(operator)
+}
+
+func unknownKeys(raw map[string]json.RawMessage, known []string) (unknown []string) {
+	for key := range raw {
+		if !Value(known).Contains(key) {
+			unknown = append(unknown, key)
+		}
+	}
+
+	sort.Strings(unknown)
+
+	return unknown
+}
+
+// rawStatementsOf returns the statements as raw JSON objects, a single statement object is also accepted
+func rawStatementsOf(raw json.RawMessage) []map[string]json.RawMessage {
+	var list []map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &list); err == nil {
+		return list
+	}
+
+	var single map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return []map[string]json.RawMessage{single}
+	}
+
+	return nil
+}
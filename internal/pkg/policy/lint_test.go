@@ -0,0 +1,105 @@
+//go:build unit
+
+package policy
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLint(t *testing.T) {
+	content, err := os.ReadFile("../../../testdata/bucketpolicy.json")
+	assert.Nil(t, err)
+
+	cases := []struct {
+		caseName   string
+		content    string
+		bucketName string
+		errors     int
+		warnings   int
+	}{
+		{"Success with valid policy", string(content), "thevpnbeast-releases-1", 0, 0},
+		{"Success without bucket name", string(content), "", 0, 0},
+		{"Success with wildcard bucket", `{"Version": "2012-10-17", "Statement": {"Sid": "Deny", "Effect": "Deny",
+			"Principal": {"AWS": "arn:aws:iam::111122223333:root"}, "Action": ["s3:Get*", "s3:List?ucket"],
+			"Resource": "arn:aws-cn:s3:::thevpnbeast-*/*",
+			"Condition": {"ForAnyValue:StringLikeIfExists": {"aws:PrincipalTag/team": ["data", "ml"]}, "Null": {"aws:TokenIssueTime": true}}}}`,
+			"thevpnbeast-releases-1", 0, 0},
+		{"Warning caused by missing version", `{"Statement": [{"Effect": "Deny", "Principal": "*", "Action": "s3:*",
+			"Resource": "*"}]}`, "thisisbucketname", 0, 1},
+		{"Warning caused by public statement", `{"Version": "2012-10-17", "Statement": [{"Sid": "Public", "Effect": "Allow",
+			"Principal": "*", "Action": "s3:*", "Resource": "arn:aws:s3:::thisisbucketname/*"}]}`, "thisisbucketname", 0, 2},
+		{"Warning caused by NotPrincipal and NotAction with Allow", `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow",
+			"NotPrincipal": {"AWS": "arn:aws:iam::111122223333:root"}, "NotAction": "s3:DeleteObject",
+			"Resource": "arn:aws:s3:::thisisbucketname/*", "Condition": {"IpAddress": {"aws:SourceIp": "10.0.0.0/8"}}}]}`,
+			"thisisbucketname", 0, 2},
+		{"Failure caused by invalid json", `{"Version": "2012-10-17",`, "thisisbucketname", 1, 0},
+		{"Failure caused by invalid grammar", `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": {"s3": 1}}]}`,
+			"thisisbucketname", 1, 0},
+		{"Failure caused by unknown elements", `{"Version": "2012-10-17", "Foo": "bar", "Statement": [{"Effect": "Deny",
+			"Principal": "*", "Action": "s3:*", "Resource": "*", "Bar": "baz"}]}`, "thisisbucketname", 2, 0},
+		{"Failure caused by invalid version and no statements", `{"Version": "2020-01-01", "Statement": []}`,
+			"thisisbucketname", 2, 0},
+		{"Failure caused by invalid statement", `{"Version": "2012-10-17", "Statement": [{"Sid": "not valid",
+			"Effect": "allow", "Principal": "*", "NotPrincipal": "*", "NotAction": "s3:GetObject", "Action": "GetObject",
+			"Condition": {"StringFoo": {"aws:UserAgent": []}}}]}`, "thisisbucketname", 8, 0},
+		{"Failure caused by resources", `{"Version": "2012-10-17", "Statement": [{"Sid": "A", "Effect": "Deny",
+			"Principal": "*", "Action": "s3:*", "Resource": ["arn:aws:s3:::otherbucket/*", "arn:aws:iam::111122223333:root"]},
+			{"Sid": "A", "Effect": "Deny", "Principal": "*", "Action": "s3:*", "NotResource": "arn:aws:s3:::thisisbucketname"}]}`,
+			"thisisbucketname", 3, 0},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		var errors, warnings int
+		findings := Lint(tc.content, tc.bucketName)
+		for _, finding := range findings {
+			t.Log(finding.String())
+			if finding.Severity == SeverityError {
+				errors++
+			} else {
+				warnings++
+			}
+		}
+
+		assert.Equal(t, tc.errors, errors)
+		assert.Equal(t, tc.warnings, warnings)
+		assert.Equal(t, tc.errors > 0, HasErrors(findings))
+	}
+}
+
+func TestLintConditionOrder(t *testing.T) {
+	content := `{"Version": "2012-10-17", "Statement": [{"Effect": "Deny", "Principal": "*", "Action": "s3:*",
+		"Resource": "arn:aws:s3:::thisisbucketname/*", "Condition": {"StringFoo": {"b": [], "a": []},
+		"BarEquals": {"c": [], "a": []}, "Bool": {"aws:SecureTransport": "false", "aws:ViaAWSService": []}}}]}`
+
+	expected := []string{
+		"unknown condition operator BarEquals",
+		"condition key a of BarEquals has no values",
+		"condition key c of BarEquals has no values",
+		"condition key aws:ViaAWSService of Bool has no values",
+		"unknown condition operator StringFoo",
+		"condition key a of StringFoo has no values",
+		"condition key b of StringFoo has no values",
+	}
+
+	// the findings of the conditions come from maps, they must be in the same order on every run
+	for i := 0; i < 10; i++ {
+		var messages []string
+		for _, finding := range Lint(content, "thisisbucketname") {
+			messages = append(messages, finding.Message)
+		}
+
+		assert.Equal(t, expected, messages)
+	}
+}
+
+func TestFinding_String(t *testing.T) {
+	assert.Equal(t, "error: policy must have at least one statement",
+		Finding{Severity: SeverityError, Message: "policy must have at least one statement"}.String())
+	assert.Equal(t, "warning: statement #2: allows all the actions with *",
+		Finding{Severity: SeverityWarning, Statement: "#2", Message: "allows all the actions with *"}.String())
+}
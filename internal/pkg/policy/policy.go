@@ -0,0 +1,477 @@
+package policy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	wildcard = "*"
+
+	// DefaultVersion is the current version of the policy language, it is used for the documents created from scratch
+	DefaultVersion = "2012-10-17"
+)
+
+// Value is a policy element that can be either a single string or a list of strings, such as Action or Resource.
+// Non-string scalars like booleans in condition values are kept in their string representation.
+type Value []string
+
+func (v *Value) UnmarshalJSON(b []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	switch t := raw.(type) {
+	case nil:
+		*v = nil
+	case []interface{}:
+		res := make(Value, 0, len(t))
+		for _, item := range t {
+			str, err := scalarToString(item)
+			if err != nil {
+				return err
+			}
+
+			res = append(res, str)
+		}
+
+		*v = res
+	default:
+		str, err := scalarToString(t)
+		if err != nil {
+			return err
+		}
+
+		*v = Value{str}
+	}
+
+	return nil
+}
+
+func (v Value) MarshalJSON() ([]byte, error) {
+	if len(v) == 1 {
+		return json.Marshal(v[0])
+	}
+
+	return json.Marshal([]string(v))
+}
+
+// Contains reports whether the Value contains the given string.
+func (v Value) Contains(s string) bool {
+	for _, item := range v {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func scalarToString(raw interface{}) (string, error) {
+	switch t := raw.(type) {
+	case string:
+		return t, nil
+	case bool:
+		return strconv.FormatBool(t), nil
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("unexpected value %v, only strings, booleans and numbers are allowed", raw)
+	}
+}
+
+// Principal is the principal element of a statement. It maps principal types such as "AWS", "Service", "Federated"
+// and "CanonicalUser" to their identifiers, the anonymous principal "*" is stored with the "*" key.
+type Principal map[string]Value
+
+func (p *Principal) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err == nil {
+		if str != wildcard {
+			return fmt.Errorf("principal must be either \"%s\" or an object, got \"%s\"", wildcard, str)
+		}
+
+		*p = Principal{wildcard: Value{wildcard}}
+		return nil
+	}
+
+	var m map[string]Value
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	*p = m
+
+	return nil
+}
+
+func (p Principal) MarshalJSON() ([]byte, error) {
+	if _, ok := p[wildcard]; ok && len(p) == 1 {
+		return json.Marshal(wildcard)
+	}
+
+	return json.Marshal(map[string]Value(p))
+}
+
+// IsWildcard reports whether the principal matches everyone, either as "*" or as {"AWS": "*"}.
+func (p Principal) IsWildcard() bool {
+	for _, v := range p {
+		if v.Contains(wildcard) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Condition maps condition operators such as "StringEquals" to the condition keys and their values.
+type Condition map[string]map[string]Value
+
+// Statement is a single statement of a bucket policy Document.
+type Statement struct {
+	Sid          string    `json:"Sid,omitempty"`
+	Effect       string    `json:"Effect"`
+	Principal    Principal `json:"Principal,omitempty"`
+	NotPrincipal Principal `json:"NotPrincipal,omitempty"`
+	Action       Value     `json:"Action,omitempty"`
+	NotAction    Value     `json:"NotAction,omitempty"`
+	Resource     Value     `json:"Resource,omitempty"`
+	NotResource  Value     `json:"NotResource,omitempty"`
+	Condition    Condition `json:"Condition,omitempty"`
+}
+
+// restrictingConditionKeys are the condition keys that limit a statement to a known set of principals, accounts or
+// networks. Statements that use any of them are not considered as public.
+var restrictingConditionKeys = []string{
+	"aws:sourceip", "aws:sourcevpc", "aws:sourcevpce", "aws:sourcearn", "aws:sourceaccount", "aws:sourceowner",
+	"aws:principalaccount", "aws:principalarn", "aws:principalorgid", "aws:principalorgpaths", "aws:userid",
+	"s3:dataaccesspointarn", "s3:dataaccesspointaccount",
+}
+
+// IsPublic reports whether the statement grants access to anyone. That is an Allow statement with a wildcard
+// principal or with a NotPrincipal element, which is not limited by any of the restricting condition keys.
+func (s Statement) IsPublic() bool {
+	if !strings.EqualFold(s.Effect, "Allow") {
+		return false
+	}
+
+	if !s.Principal.IsWildcard() && s.NotPrincipal == nil {
+		return false
+	}
+
+	for _, keys := range s.Condition {
+		for key := range keys {
+			for _, restricting := range restrictingConditionKeys {
+				if strings.EqualFold(key, restricting) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// MatchesAction reports whether the action is covered by the statement, by its Action element or by not being
+// excluded by its NotAction element. Action names are compared case-insensitively.
+func (s Statement) MatchesAction(action string) bool {
+	if s.NotAction != nil {
+		for _, pattern := range s.NotAction {
+			if WildcardMatch(strings.ToLower(pattern), strings.ToLower(action)) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	for _, pattern := range s.Action {
+		if WildcardMatch(strings.ToLower(pattern), strings.ToLower(action)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WildcardMatch reports whether the value matches the pattern, where '*' matches any sequence of characters and
+// '?' matches any single character, just like the wildcards in policy elements.
+func WildcardMatch(pattern, value string) bool {
+	p, v := 0, 0
+	star, match := -1, 0
+	for v < len(value) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == value[v]):
+			p++
+			v++
+		case p < len(pattern) && pattern[p] == '*':
+			star, match = p, v
+			p++
+		case star != -1:
+			p = star + 1
+			match++
+			v = match
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
+
+// Statements is the list of statements of a Document, it also accepts a single statement object while unmarshalling.
+type Statements []Statement
+
+func (s *Statements) UnmarshalJSON(b []byte) error {
+	var single Statement
+	if len(b) > 0 && b[0] == '{' {
+		if err := json.Unmarshal(b, &single); err != nil {
+			return err
+		}
+
+		*s = Statements{single}
+		return nil
+	}
+
+	var list []Statement
+	if err := json.Unmarshal(b, &list); err != nil {
+		return err
+	}
+
+	*s = list
+
+	return nil
+}
+
+// Document is a bucket policy document.
+type Document struct {
+	Version   string     `json:"Version,omitempty"`
+	ID        string     `json:"Id,omitempty"`
+	Statement Statements `json:"Statement"`
+}
+
+// Parse parses the given bucket policy content into a Document.
+func Parse(content string) (*Document, error) {
+	doc := &Document{}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(content)), doc); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// ParseStatements parses the given content into Statements, the content can be a single statement object, a list of
+// statements or a whole Document whose statements are returned.
+func ParseStatements(content string) (Statements, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(strings.TrimSpace(content)), &raw); err == nil {
+		if _, ok := raw["Statement"]; ok {
+			doc, err := Parse(content)
+			if err != nil {
+				return nil, err
+			}
+
+			return doc.Statement, nil
+		}
+	}
+
+	var statements Statements
+	if err := json.Unmarshal([]byte(strings.TrimSpace(content)), &statements); err != nil {
+		return nil, err
+	}
+
+	return statements, nil
+}
+
+// Clone returns a copy of the Document whose statements can be added or removed without changing the original.
+func (d *Document) Clone() *Document {
+	clone := *d
+	clone.Statement = append(Statements{}, d.Statement...)
+
+	return &clone
+}
+
+// DuplicateSids returns the Sids that are used by more than one statement of the Document, in the order of their
+// first occurrence.
+func (d *Document) DuplicateSids() []string {
+	seen := make(map[string]int)
+	var duplicates []string
+	for _, statement := range d.Statement {
+		if statement.Sid == "" {
+			continue
+		}
+
+		seen[statement.Sid]++
+		if seen[statement.Sid] == 2 {
+			duplicates = append(duplicates, statement.Sid)
+		}
+	}
+
+	return duplicates
+}
+
+// AddStatements appends the statements to the Document. Every statement must have a Sid that is not used by the
+// Document or by the other statements, so that the statements can be removed individually later on.
+func (d *Document) AddStatements(statements Statements) error {
+	if duplicates := d.DuplicateSids(); len(duplicates) > 0 {
+		return fmt.Errorf("policy already has duplicate Sids %s, fix them before adding statements",
+			strings.Join(duplicates, ", "))
+	}
+
+	for _, statement := range statements {
+		if statement.Sid == "" {
+			return errors.New("statement must have a Sid to be managed individually")
+		}
+
+		if d.indexOf(statement.Sid) != -1 {
+			return fmt.Errorf("a statement with Sid %s already exists", statement.Sid)
+		}
+
+		d.Statement = append(d.Statement, statement)
+	}
+
+	return nil
+}
+
+// RemoveStatement removes the statement with the given Sid from the Document.
+func (d *Document) RemoveStatement(sid string) error {
+	if duplicates := d.DuplicateSids(); Value(duplicates).Contains(sid) {
+		return fmt.Errorf("more than one statement has Sid %s, remove them manually", sid)
+	}
+
+	index := d.indexOf(sid)
+	if index == -1 {
+		return fmt.Errorf("no statement found with Sid %s", sid)
+	}
+
+	d.Statement = append(d.Statement[:index], d.Statement[index+1:]...)
+
+	return nil
+}
+
+// Equal reports whether the Documents grant the same permissions, regardless of the form that S3 returns the
+// policies in. The elements are compared as sorted lists, a single string equals the list with that string, and the
+// account ID principals equal the root user ARNs of the accounts. The order of the statements is not significant
+// either, since S3 may return them reordered.
+func (d *Document) Equal(other *Document) bool {
+	return reflect.DeepEqual(d.normalize(), other.normalize())
+}
+
+func (d *Document) normalize() *Document {
+	normalized := &Document{Version: d.Version, ID: d.ID}
+	for _, statement := range d.Statement {
+		statement.Principal = statement.Principal.normalize()
+		statement.NotPrincipal = statement.NotPrincipal.normalize()
+		statement.Action = statement.Action.normalize()
+		statement.NotAction = statement.NotAction.normalize()
+		statement.Resource = statement.Resource.normalize()
+		statement.NotResource = statement.NotResource.normalize()
+
+		var condition Condition
+		for operator, keys := range statement.Condition {
+			if condition == nil {
+				condition = make(Condition)
+			}
+
+			condition[operator] = make(map[string]Value)
+			for key, values := range keys {
+				condition[operator][key] = values.normalize()
+			}
+		}
+
+		statement.Condition = condition
+		normalized.Statement = append(normalized.Statement, statement)
+	}
+
+	// the statements are ordered by their JSON representations, which are stable since the maps are marshaled
+	// with sorted keys
+	keys := make(map[int]string, len(normalized.Statement))
+	for i, statement := range normalized.Statement {
+		bytes, _ := json.Marshal(statement)
+		keys[i] = string(bytes)
+	}
+
+	order := make([]int, len(normalized.Statement))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return keys[order[i]] < keys[order[j]]
+	})
+
+	statements := make(Statements, 0, len(order))
+	for _, i := range order {
+		statements = append(statements, normalized.Statement[i])
+	}
+
+	normalized.Statement = statements
+
+	return normalized
+}
+
+// normalize returns a sorted copy of the Value, or nil if it is empty
+func (v Value) normalize() Value {
+	if len(v) == 0 {
+		return nil
+	}
+
+	normalized := append(Value{}, v...)
+	sort.Strings(normalized)
+
+	return normalized
+}
+
+// normalize returns a copy of the Principal with sorted identifiers whose account IDs are replaced with the root user
+// ARNs of the accounts, or nil if it is empty
+func (p Principal) normalize() Principal {
+	if len(p) == 0 {
+		return nil
+	}
+
+	normalized := make(Principal, len(p))
+	for principalType, ids := range p {
+		var values Value
+		for _, id := range ids {
+			if principalType == "AWS" && accountIDRegex.MatchString(id) {
+				id = fmt.Sprintf("arn:aws:iam::%s:root", id)
+			}
+
+			values = append(values, id)
+		}
+
+		normalized[principalType] = values.normalize()
+	}
+
+	return normalized
+}
+
+func (d *Document) indexOf(sid string) int {
+	for i, statement := range d.Statement {
+		if statement.Sid == sid {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// String returns the indented JSON representation of the Document.
+func (d *Document) String() (string, error) {
+	bytes, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes), nil
+}
@@ -0,0 +1,249 @@
+//go:build unit
+
+package policy
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	content, err := os.ReadFile("../../../testdata/bucketpolicy.json")
+	assert.Nil(t, err)
+
+	doc, err := Parse(string(content))
+	assert.Nil(t, err)
+	assert.Equal(t, "2012-10-17", doc.Version)
+	assert.Len(t, doc.Statement, 1)
+	assert.Equal(t, "RestrictToTLSRequestsOnly", doc.Statement[0].Sid)
+	assert.True(t, doc.Statement[0].Principal.IsWildcard())
+	assert.Equal(t, Value{"s3:*"}, doc.Statement[0].Action)
+	assert.Len(t, doc.Statement[0].Resource, 2)
+	assert.Equal(t, Value{"false"}, doc.Statement[0].Condition["Bool"]["aws:SecureTransport"])
+
+	out, err := doc.String()
+	assert.Nil(t, err)
+
+	reparsed, err := Parse(out)
+	assert.Nil(t, err)
+	assert.Equal(t, doc, reparsed)
+}
+
+func TestParseVariants(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		content    string
+		shouldPass bool
+	}{
+		{"Success with single statement object", `{"Statement": {"Effect": "Allow", "Principal": {"AWS": ["arn:aws:iam::111122223333:root"]}, "Action": "s3:GetObject", "Resource": "*"}}`, true},
+		{"Success with boolean condition value", `{"Statement": [{"Effect": "Deny", "Principal": "*", "Action": "s3:*", "Resource": "*", "Condition": {"Bool": {"aws:SecureTransport": false}}}]}`, true},
+		{"Failure caused by invalid principal string", `{"Statement": [{"Effect": "Allow", "Principal": "foo"}]}`, false},
+		{"Failure caused by nested values", `{"Statement": [{"Effect": "Allow", "Action": [["s3:GetObject"]]}]}`, false},
+		{"Failure caused by invalid json", `{"Statement": [`, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		_, err := Parse(tc.content)
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestValue_MarshalJSON(t *testing.T) {
+	out, err := Value{"s3:GetObject"}.MarshalJSON()
+	assert.Nil(t, err)
+	assert.Equal(t, `"s3:GetObject"`, string(out))
+
+	out, err = Value{"s3:GetObject", "s3:PutObject"}.MarshalJSON()
+	assert.Nil(t, err)
+	assert.Equal(t, `["s3:GetObject","s3:PutObject"]`, string(out))
+}
+
+func TestStatement_IsPublic(t *testing.T) {
+	cases := []struct {
+		caseName  string
+		statement Statement
+		expected  bool
+	}{
+		{"Public with wildcard principal", Statement{Effect: "Allow", Principal: Principal{"*": Value{"*"}}}, true},
+		{"Public with wildcard AWS principal", Statement{Effect: "Allow", Principal: Principal{"AWS": Value{"*"}}}, true},
+		{"Public with NotPrincipal", Statement{Effect: "Allow", NotPrincipal: Principal{"AWS": Value{"arn:aws:iam::111122223333:root"}}}, true},
+		{"Public with non-restricting condition", Statement{Effect: "Allow", Principal: Principal{"*": Value{"*"}},
+			Condition: Condition{"Bool": {"aws:SecureTransport": Value{"true"}}}}, true},
+		{"Not public with deny effect", Statement{Effect: "Deny", Principal: Principal{"*": Value{"*"}}}, false},
+		{"Not public with specific principal", Statement{Effect: "Allow", Principal: Principal{"AWS": Value{"arn:aws:iam::111122223333:root"}}}, false},
+		{"Not public with restricting condition", Statement{Effect: "Allow", Principal: Principal{"*": Value{"*"}},
+			Condition: Condition{"StringEquals": {"aws:SourceVpce": Value{"vpce-1a2b3c4d"}}}}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+		assert.Equal(t, tc.expected, tc.statement.IsPublic())
+	}
+}
+
+func TestWildcardMatch(t *testing.T) {
+	cases := []struct {
+		pattern  string
+		value    string
+		expected bool
+	}{
+		{"*", "s3:GetObject", true},
+		{"s3:*", "s3:GetObject", true},
+		{"s3:Get*", "s3:GetObject", true},
+		{"s3:Get*", "s3:PutObject", false},
+		{"s3:GetObjec?", "s3:GetObject", true},
+		{"arn:aws:s3:::bucket/*", "arn:aws:s3:::bucket/foo/bar.txt", true},
+		{"arn:aws:s3:::bucket/*.txt", "arn:aws:s3:::bucket/foo/bar.json", false},
+		{"arn:aws:s3:::bucket", "arn:aws:s3:::bucket/foo", false},
+		{"", "", true},
+		{"**", "", true},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, WildcardMatch(tc.pattern, tc.value), "%s ~ %s", tc.pattern, tc.value)
+	}
+}
+
+func TestStatement_MatchesAction(t *testing.T) {
+	assert.True(t, Statement{Action: Value{"s3:get*"}}.MatchesAction("s3:GetObject"))
+	assert.True(t, Statement{Action: Value{"s3:ListBucket", "s3:GetObject"}}.MatchesAction("s3:getobject"))
+	assert.False(t, Statement{Action: Value{"s3:PutObject"}}.MatchesAction("s3:GetObject"))
+	assert.False(t, Statement{NotAction: Value{"s3:Get*"}}.MatchesAction("s3:GetObject"))
+	assert.True(t, Statement{NotAction: Value{"s3:Delete*"}}.MatchesAction("s3:GetObject"))
+}
+
+func TestParseStatements(t *testing.T) {
+	statements, err := ParseStatements(`{"Sid": "ReadOnly", "Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "*"}`)
+	assert.Nil(t, err)
+	assert.Len(t, statements, 1)
+
+	statements, err = ParseStatements(`[{"Sid": "A", "Effect": "Allow"}, {"Sid": "B", "Effect": "Deny"}]`)
+	assert.Nil(t, err)
+	assert.Len(t, statements, 2)
+
+	statements, err = ParseStatements(`{"Version": "2012-10-17", "Statement": [{"Sid": "A", "Effect": "Allow"}]}`)
+	assert.Nil(t, err)
+	assert.Equal(t, "A", statements[0].Sid)
+
+	_, err = ParseStatements(`{"Version": "2012-10-17", "Statement": "A"}`)
+	assert.NotNil(t, err)
+
+	_, err = ParseStatements(`"Statement"`)
+	assert.NotNil(t, err)
+}
+
+func TestDocument_DuplicateSids(t *testing.T) {
+	doc := &Document{Statement: Statements{{Sid: "A"}, {Sid: "B"}, {Sid: "A"}, {}, {}, {Sid: "A"}}}
+	assert.Equal(t, []string{"A"}, doc.DuplicateSids())
+
+	doc = &Document{Statement: Statements{{Sid: "A"}, {Sid: "B"}}}
+	assert.Empty(t, doc.DuplicateSids())
+}
+
+func TestDocument_AddStatements(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		current    Statements
+		statements Statements
+		shouldPass bool
+	}{
+		{"Success", Statements{{Sid: "A"}}, Statements{{Sid: "B"}, {Sid: "C"}}, true},
+		{"Success on empty document", nil, Statements{{Sid: "A"}}, true},
+		{"Failure caused by existing Sid", Statements{{Sid: "A"}}, Statements{{Sid: "B"}, {Sid: "A"}}, false},
+		{"Failure caused by duplicate Sids in statements", nil, Statements{{Sid: "A"}, {Sid: "A"}}, false},
+		{"Failure caused by missing Sid", Statements{{Sid: "A"}}, Statements{{Effect: "Allow"}}, false},
+		{"Failure caused by duplicate Sids in document", Statements{{Sid: "A"}, {Sid: "A"}}, Statements{{Sid: "B"}}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		doc := &Document{Statement: tc.current}
+		err := doc.AddStatements(tc.statements)
+		if !tc.shouldPass {
+			assert.NotNil(t, err)
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Len(t, doc.Statement, len(tc.current)+len(tc.statements))
+	}
+}
+
+func TestDocument_RemoveStatement(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		current    Statements
+		sid        string
+		shouldPass bool
+		expected   Statements
+	}{
+		{"Success", Statements{{Sid: "A"}, {Sid: "B"}, {Sid: "C"}}, "B", true, Statements{{Sid: "A"}, {Sid: "C"}}},
+		{"Success with other duplicates", Statements{{Sid: "A"}, {Sid: "B"}, {Sid: "B"}}, "A", true, Statements{{Sid: "B"}, {Sid: "B"}}},
+		{"Failure caused by statement not found", Statements{{Sid: "A"}}, "B", false, nil},
+		{"Failure caused by duplicate Sid", Statements{{Sid: "A"}, {Sid: "A"}}, "A", false, nil},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		doc := &Document{Statement: tc.current}
+		err := doc.RemoveStatement(tc.sid)
+		if !tc.shouldPass {
+			assert.NotNil(t, err)
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, tc.expected, doc.Statement)
+	}
+}
+
+func TestDocument_Clone(t *testing.T) {
+	doc := &Document{Version: DefaultVersion, Statement: Statements{{Sid: "A"}, {Sid: "B"}}}
+	clone := doc.Clone()
+	assert.Equal(t, doc, clone)
+
+	assert.Nil(t, clone.RemoveStatement("A"))
+	assert.Nil(t, clone.AddStatements(Statements{{Sid: "C"}}))
+	assert.Equal(t, Statements{{Sid: "A"}, {Sid: "B"}}, doc.Statement)
+}
+
+func TestDocument_Equal(t *testing.T) {
+	desired, err := Parse(`{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow",
+"Principal":{"AWS":["123456789012"]},"Action":["s3:GetObject"],"Resource":["arn:aws:s3:::foo/b","arn:aws:s3:::foo/a"],
+"Condition":{"StringEquals":{"aws:PrincipalOrgID":["o-1"]}}}]}`)
+	assert.Nil(t, err)
+
+	// the form that S3 returns the same policy in
+	current, err := Parse(`{"Statement":[{"Sid":"Read","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},
+"Action":"s3:GetObject","Resource":["arn:aws:s3:::foo/a","arn:aws:s3:::foo/b"],
+"Condition":{"StringEquals":{"aws:PrincipalOrgID":"o-1"}}}],"Version":"2012-10-17"}`)
+	assert.Nil(t, err)
+	assert.True(t, desired.Equal(current))
+	assert.Equal(t, Value{"s3:GetObject"}, current.Statement[0].Action)
+
+	changed := current.Clone()
+	changed.Statement[0].Action = Value{"s3:GetObject", "s3:PutObject"}
+	assert.False(t, desired.Equal(changed))
+
+	changed = current.Clone()
+	changed.Statement[0].Principal = Principal{"AWS": Value{"210987654321"}}
+	assert.False(t, desired.Equal(changed))
+
+	// S3 may return the statements in a different order
+	desired.Statement = append(desired.Statement, Statement{Sid: "Deny", Effect: "Deny", Principal: Principal{"AWS": Value{"*"}},
+		Action: Value{"s3:*"}, Resource: Value{"arn:aws:s3:::foo"}})
+	reordered := desired.Clone()
+	reordered.Statement[0], reordered.Statement[1] = reordered.Statement[1], reordered.Statement[0]
+	assert.True(t, desired.Equal(reordered))
+	assert.Equal(t, "Read", desired.Statement[0].Sid)
+}
@@ -0,0 +1,303 @@
+package policy
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
+
+const (
+	DecisionAllowed          = "allowed"
+	DecisionExplicitlyDenied = "explicitly denied"
+	DecisionImplicitlyDenied = "implicitly denied"
+
+	ifExistsSuffix      = "IfExists"
+	forAnyValuePrefix   = "ForAnyValue:"
+	forAllValuesPrefix  = "ForAllValues:"
+	anonymousPrincipal  = wildcard
+	rootPrincipalSuffix = ":root"
+	principalArnKey     = "aws:principalarn"
+	principalAccountKey = "aws:principalaccount"
+)
+
+// Request is an access request that is evaluated against a Document by Simulate.
+type Request struct {
+	// Principal is the ARN of the requester or the name of the service principal, "*" is the anonymous requester
+	Principal string
+	// Action is the requested action such as s3:GetObject
+	Action string
+	// Resource is the ARN of the requested bucket or object
+	Resource string
+	// Context holds the condition keys of the request such as aws:SecureTransport, the keys are case-insensitive
+	Context map[string]string
+}
+
+// Evaluation explains whether a single statement applies to a Request.
+type Evaluation struct {
+	// Statement is the Sid of the statement, or its 1-based index prefixed with '#' if it has no Sid
+	Statement string
+	Effect    string
+	Matched   bool
+	// Reason is the first element of the statement that does not match the request, empty for the matched ones
+	Reason string
+}
+
+func (e Evaluation) String() string {
+	if e.Matched {
+		return fmt.Sprintf("statement %s (%s): matched", e.Statement, e.Effect)
+	}
+
+	return fmt.Sprintf("statement %s (%s): not matched, %s", e.Statement, e.Effect, e.Reason)
+}
+
+// Result is the outcome of Simulate with the evaluation of each statement.
+type Result struct {
+	Decision string
+	// Decider is the label of the statement that decided the outcome, empty for the implicit denies
+	Decider     string
+	Evaluations []Evaluation
+}
+
+func (r Result) String() string {
+	if r.Decider == "" {
+		return fmt.Sprintf("%s, no statement allows the request", r.Decision)
+	}
+
+	return fmt.Sprintf("%s by statement %s", r.Decision, r.Decider)
+}
+
+// Simulate evaluates the request against the statements of the Document locally, just like S3 does for the bucket
+// policy. A matching Deny statement always wins over the Allow statements, the request is implicitly denied if no
+// statement matches. The aws:PrincipalArn and aws:PrincipalAccount keys are derived from the principal unless they are
+// in the context. Only the bucket policy is evaluated, the identity policies of the principal are not known.
+func (d *Document) Simulate(req Request) (*Result, error) {
+	context := make(map[string]string, len(req.Context)+2)
+	if strings.HasPrefix(req.Principal, "arn:") {
+		context[principalArnKey] = req.Principal
+	}
+
+	if account := accountOf(req.Principal); account != "" {
+		context[principalAccountKey] = account
+	}
+
+	for key, value := range req.Context {
+		context[strings.ToLower(key)] = value
+	}
+
+	res := &Result{Decision: DecisionImplicitlyDenied}
+	var allowedBy, deniedBy string
+	for i, statement := range d.Statement {
+		label := statementLabel(i, statement)
+		reason, err := statement.evaluate(req, context)
+		if err != nil {
+			return nil, fmt.Errorf("statement %s: %w", label, err)
+		}
+
+		evaluation := Evaluation{Statement: label, Effect: statement.Effect, Matched: reason == "", Reason: reason}
+		res.Evaluations = append(res.Evaluations, evaluation)
+		if !evaluation.Matched {
+			continue
+		}
+
+		if strings.EqualFold(statement.Effect, "Deny") && deniedBy == "" {
+			deniedBy = label
+		} else if strings.EqualFold(statement.Effect, "Allow") && allowedBy == "" {
+			allowedBy = label
+		}
+	}
+
+	switch {
+	case deniedBy != "":
+		res.Decision, res.Decider = DecisionExplicitlyDenied, deniedBy
+	case allowedBy != "":
+		res.Decision, res.Decider = DecisionAllowed, allowedBy
+	}
+
+	return res, nil
+}
+
+// evaluate returns the reason why the statement does not apply to the request, or an empty string if it applies
+func (s Statement) evaluate(req Request, context map[string]string) (string, error) {
+	switch {
+	case s.Principal != nil && !s.Principal.matches(req.Principal):
+		return "principal does not match", nil
+	case s.NotPrincipal != nil && s.NotPrincipal.matches(req.Principal):
+		return "principal is excluded by NotPrincipal", nil
+	case s.Principal == nil && s.NotPrincipal == nil:
+		return "statement has no principal", nil
+	case !s.MatchesAction(req.Action):
+		return "action does not match", nil
+	case !s.matchesResource(req.Resource):
+		return "resource does not match", nil
+	}
+
+	operators := make([]string, 0, len(s.Condition))
+	for operator := range s.Condition {
+		operators = append(operators, operator)
+	}
+
+	sort.Strings(operators)
+	for _, operator := range operators {
+		keys := make([]string, 0, len(s.Condition[operator]))
+		for key := range s.Condition[operator] {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+		for _, key := range keys {
+			ok, err := evaluateCondition(operator, key, s.Condition[operator][key], context)
+			if err != nil {
+				return "", err
+			}
+
+			if !ok {
+				return fmt.Sprintf("condition %s on %s is not satisfied", operator, key), nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// matches reports whether the principal is one of the identifiers of the Principal. An account ID or its root ARN
+// matches every principal of that account, the anonymous principal only matches the wildcard.
+func (p Principal) matches(principal string) bool {
+	if p.IsWildcard() {
+		return true
+	}
+
+	if principal == anonymousPrincipal {
+		return false
+	}
+
+	account := accountOf(principal)
+	for _, values := range p {
+		for _, value := range values {
+			if value == principal {
+				return true
+			}
+
+			if account == "" {
+				continue
+			}
+
+			if value == account || (strings.HasSuffix(value, rootPrincipalSuffix) && accountOf(value) == account) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// matchesResource reports whether the resource is covered by the statement, by its Resource element or by not being
+// excluded by its NotResource element.
+func (s Statement) matchesResource(resource string) bool {
+	if s.NotResource != nil {
+		for _, pattern := range s.NotResource {
+			if WildcardMatch(pattern, resource) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	for _, pattern := range s.Resource {
+		if WildcardMatch(pattern, resource) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// evaluateCondition evaluates a single condition key against the request context. A missing key satisfies only the
+// negated operators, the ones with the IfExists suffix and the ForAllValues set operator.
+func evaluateCondition(operator, key string, values Value, context map[string]string) (bool, error) {
+	base := operator
+	forAllValues := strings.HasPrefix(base, forAllValuesPrefix)
+	base = strings.TrimPrefix(strings.TrimPrefix(base, forAllValuesPrefix), forAnyValuePrefix)
+	ifExists := strings.HasSuffix(base, ifExistsSuffix)
+	base = strings.TrimSuffix(base, ifExistsSuffix)
+
+	actual, present := context[strings.ToLower(key)]
+	if base == "Null" {
+		return values.Contains(fmt.Sprint(!present)), nil
+	}
+
+	if !isConditionOperator(base) {
+		return false, fmt.Errorf("unknown condition operator %s", operator)
+	}
+
+	if !present {
+		return ifExists || forAllValues || strings.Contains(base, "Not"), nil
+	}
+
+	var match func(value string) (bool, error)
+	switch base {
+	case "StringEquals", "StringNotEquals":
+		match = func(value string) (bool, error) { return value == actual, nil }
+	case "StringEqualsIgnoreCase", "StringNotEqualsIgnoreCase", "Bool":
+		match = func(value string) (bool, error) { return strings.EqualFold(value, actual), nil }
+	case "StringLike", "StringNotLike", "ArnEquals", "ArnLike", "ArnNotEquals", "ArnNotLike":
+		match = func(value string) (bool, error) { return WildcardMatch(value, actual), nil }
+	case "IpAddress", "NotIpAddress":
+		match = func(value string) (bool, error) { return ipMatches(value, actual) }
+	default:
+		return false, fmt.Errorf("condition operator %s is not supported by the simulator", operator)
+	}
+
+	matched := false
+	for _, value := range values {
+		ok, err := match(value)
+		if err != nil {
+			return false, err
+		}
+
+		if ok {
+			matched = true
+			break
+		}
+	}
+
+	if strings.Contains(base, "Not") {
+		return !matched, nil
+	}
+
+	return matched, nil
+}
+
+// ipMatches reports whether the ip is in the CIDR block, a single IP address in the condition is matched as is
+func ipMatches(cidr, ip string) (bool, error) {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false, fmt.Errorf("%s is not a valid IP address", ip)
+	}
+
+	if !strings.Contains(cidr, "/") {
+		return addr.Equal(net.ParseIP(cidr)), nil
+	}
+
+	_, block, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false, err
+	}
+
+	return block.Contains(addr), nil
+}
+
+// accountOf returns the account ID of the ARN or of the plain account ID, empty for the others such as services
+func accountOf(principal string) string {
+	if accountIDRegex.MatchString(principal) {
+		return principal
+	}
+
+	parts := strings.Split(principal, ":")
+	if len(parts) > 4 && parts[0] == "arn" && accountIDRegex.MatchString(parts[4]) {
+		return parts[4]
+	}
+
+	return ""
+}
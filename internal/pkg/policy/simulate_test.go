@@ -0,0 +1,168 @@
+//go:build unit
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const simulatedPolicy = `{"Version": "2012-10-17", "Statement": [
+	{"Sid": "DenyInsecureTransport", "Effect": "Deny", "Principal": "*", "Action": "s3:*",
+		"Resource": ["arn:aws:s3:::thisisbucketname", "arn:aws:s3:::thisisbucketname/*"],
+		"Condition": {"Bool": {"aws:SecureTransport": "false"}}},
+	{"Sid": "AllowAccountRead", "Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::111122223333:root"},
+		"Action": ["s3:Get*", "s3:ListBucket"],
+		"Resource": ["arn:aws:s3:::thisisbucketname", "arn:aws:s3:::thisisbucketname/*"]},
+	{"Sid": "AllowOfficeUpload", "Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::444455556666:user/uploader"},
+		"Action": "s3:PutObject", "Resource": "arn:aws:s3:::thisisbucketname/uploads/*",
+		"Condition": {"IpAddress": {"aws:SourceIp": ["10.0.0.0/8", "192.168.1.10"]},
+			"StringLikeIfExists": {"s3:x-amz-server-side-encryption": "aws:kms*"}}},
+	{"Sid": "DenyDeletesExceptAdmin", "Effect": "Deny", "Principal": "*", "Action": "s3:DeleteObject",
+		"Resource": "arn:aws:s3:::thisisbucketname/*",
+		"Condition": {"StringNotEquals": {"aws:PrincipalArn": "arn:aws:iam::111122223333:role/admin"}}},
+	{"Sid": "AllowAdminDelete", "Effect": "Allow", "Principal": {"AWS": "111122223333"},
+		"Action": "s3:DeleteObject", "Resource": "arn:aws:s3:::thisisbucketname/*",
+		"Condition": {"StringEquals": {"aws:PrincipalArn": "arn:aws:iam::111122223333:role/admin"}}}
+]}`
+
+func TestDocument_Simulate(t *testing.T) {
+	doc, err := Parse(simulatedPolicy)
+	assert.Nil(t, err)
+
+	cases := []struct {
+		caseName string
+		Request
+		decision string
+		decider  string
+	}{
+		{"Allowed by account principal", Request{Principal: "arn:aws:iam::111122223333:role/reader",
+			Action: "s3:GetObject", Resource: "arn:aws:s3:::thisisbucketname/path/file.txt",
+			Context: map[string]string{"aws:SecureTransport": "true"}}, DecisionAllowed, "AllowAccountRead"},
+		{"Explicitly denied without secure transport", Request{Principal: "arn:aws:iam::111122223333:role/reader",
+			Action: "s3:GetObject", Resource: "arn:aws:s3:::thisisbucketname/path/file.txt",
+			Context: map[string]string{"aws:securetransport": "false"}}, DecisionExplicitlyDenied, "DenyInsecureTransport"},
+		{"Implicitly denied for other account", Request{Principal: "arn:aws:iam::999999999999:user/foo",
+			Action: "s3:GetObject", Resource: "arn:aws:s3:::thisisbucketname/file.txt",
+			Context: map[string]string{"aws:SecureTransport": "true"}}, DecisionImplicitlyDenied, ""},
+		{"Implicitly denied for anonymous", Request{Principal: "*", Action: "s3:GetObject",
+			Resource: "arn:aws:s3:::thisisbucketname/file.txt"}, DecisionImplicitlyDenied, ""},
+		{"Allowed upload from CIDR without encryption header", Request{Principal: "arn:aws:iam::444455556666:user/uploader",
+			Action: "s3:PutObject", Resource: "arn:aws:s3:::thisisbucketname/uploads/a.csv",
+			Context: map[string]string{"aws:SourceIp": "10.1.2.3"}}, DecisionAllowed, "AllowOfficeUpload"},
+		{"Allowed upload from single IP with kms", Request{Principal: "arn:aws:iam::444455556666:user/uploader",
+			Action: "s3:PutObject", Resource: "arn:aws:s3:::thisisbucketname/uploads/a.csv",
+			Context: map[string]string{"aws:SourceIp": "192.168.1.10", "s3:x-amz-server-side-encryption": "aws:kms"}},
+			DecisionAllowed, "AllowOfficeUpload"},
+		{"Implicitly denied upload with wrong encryption", Request{Principal: "arn:aws:iam::444455556666:user/uploader",
+			Action: "s3:PutObject", Resource: "arn:aws:s3:::thisisbucketname/uploads/a.csv",
+			Context: map[string]string{"aws:SourceIp": "10.1.2.3", "s3:x-amz-server-side-encryption": "AES256"}},
+			DecisionImplicitlyDenied, ""},
+		{"Implicitly denied upload from other IP", Request{Principal: "arn:aws:iam::444455556666:user/uploader",
+			Action: "s3:PutObject", Resource: "arn:aws:s3:::thisisbucketname/uploads/a.csv",
+			Context: map[string]string{"aws:SourceIp": "172.16.0.1"}}, DecisionImplicitlyDenied, ""},
+		{"Implicitly denied upload outside of prefix", Request{Principal: "arn:aws:iam::444455556666:user/uploader",
+			Action: "s3:PutObject", Resource: "arn:aws:s3:::thisisbucketname/other/a.csv",
+			Context: map[string]string{"aws:SourceIp": "10.1.2.3"}}, DecisionImplicitlyDenied, ""},
+		{"Allowed delete for admin", Request{Principal: "arn:aws:iam::111122223333:role/admin", Action: "s3:DeleteObject",
+			Resource: "arn:aws:s3:::thisisbucketname/a.csv", Context: map[string]string{
+				"aws:PrincipalArn": "arn:aws:iam::111122223333:role/admin"}}, DecisionAllowed, "AllowAdminDelete"},
+		{"Explicitly denied delete for others", Request{Principal: "arn:aws:iam::111122223333:role/reader",
+			Action: "s3:DeleteObject", Resource: "arn:aws:s3:::thisisbucketname/a.csv", Context: map[string]string{
+				"aws:PrincipalArn": "arn:aws:iam::111122223333:role/reader"}}, DecisionExplicitlyDenied, "DenyDeletesExceptAdmin"},
+		{"Allowed delete for admin with derived principal arn", Request{Principal: "arn:aws:iam::111122223333:role/admin",
+			Action: "s3:DeleteObject", Resource: "arn:aws:s3:::thisisbucketname/a.csv"}, DecisionAllowed,
+			"AllowAdminDelete"},
+		{"Explicitly denied delete for anonymous with missing key", Request{Principal: "*",
+			Action: "s3:DeleteObject", Resource: "arn:aws:s3:::thisisbucketname/a.csv"}, DecisionExplicitlyDenied,
+			"DenyDeletesExceptAdmin"},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		res, err := doc.Simulate(tc.Request)
+		assert.Nil(t, err)
+		assert.Equal(t, tc.decision, res.Decision)
+		assert.Equal(t, tc.decider, res.Decider)
+		assert.Len(t, res.Evaluations, len(doc.Statement))
+	}
+}
+
+func TestDocument_Simulate_Conditions(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		condition  string
+		context    map[string]string
+		matched    bool
+		shouldPass bool
+	}{
+		{"Null with missing key", `{"Null": {"aws:SourceVpce": "true"}}`, nil, true, true},
+		{"Null with present key", `{"Null": {"aws:SourceVpce": "true"}}`, map[string]string{"aws:SourceVpce": "vpce-1"}, false, true},
+		{"ForAllValues with missing key", `{"ForAllValues:StringEquals": {"aws:TagKeys": "team"}}`, nil, true, true},
+		{"ForAnyValue with missing key", `{"ForAnyValue:StringEquals": {"aws:TagKeys": "team"}}`, nil, false, true},
+		{"StringEqualsIgnoreCase", `{"StringEqualsIgnoreCase": {"aws:UserAgent": "CLI"}}`, map[string]string{"aws:UserAgent": "cli"}, true, true},
+		{"NotIpAddress", `{"NotIpAddress": {"aws:SourceIp": "10.0.0.0/8"}}`, map[string]string{"aws:SourceIp": "10.0.0.1"}, false, true},
+		{"ArnLike", `{"ArnLike": {"aws:SourceArn": "arn:aws:cloudfront::111122223333:distribution/*"}}`,
+			map[string]string{"aws:SourceArn": "arn:aws:cloudfront::111122223333:distribution/E1"}, true, true},
+		{"Failure caused by unsupported operator", `{"NumericLessThan": {"s3:max-keys": "10"}}`, map[string]string{"s3:max-keys": "5"}, false, false},
+		{"Failure caused by unknown operator", `{"Foo": {"s3:max-keys": "10"}}`, map[string]string{"s3:max-keys": "5"}, false, false},
+		{"Failure caused by invalid IP", `{"IpAddress": {"aws:SourceIp": "10.0.0.0/8"}}`, map[string]string{"aws:SourceIp": "foo"}, false, false},
+		{"Failure caused by invalid CIDR", `{"IpAddress": {"aws:SourceIp": "10.0.0.0/99"}}`, map[string]string{"aws:SourceIp": "10.0.0.1"}, false, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		doc, err := Parse(`{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject",
+			"Resource": "*", "Condition": ` + tc.condition + `}}`)
+		assert.Nil(t, err)
+
+		res, err := doc.Simulate(Request{Principal: "*", Action: "s3:GetObject", Resource: "arn:aws:s3:::foo/bar",
+			Context: tc.context})
+		if !tc.shouldPass {
+			assert.NotNil(t, err)
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, tc.matched, res.Evaluations[0].Matched)
+	}
+}
+
+func TestDocument_Simulate_Elements(t *testing.T) {
+	doc, err := Parse(`{"Version": "2012-10-17", "Statement": [
+		{"Effect": "Deny", "NotPrincipal": {"AWS": "arn:aws:iam::111122223333:role/admin"}, "Action": "s3:*",
+			"NotResource": "arn:aws:s3:::foo/public/*"},
+		{"Effect": "Allow", "Principal": {"Service": "logging.s3.amazonaws.com"}, "NotAction": "s3:Delete*",
+			"Resource": "arn:aws:s3:::foo/*"}
+	]}`)
+	assert.Nil(t, err)
+
+	res, err := doc.Simulate(Request{Principal: "logging.s3.amazonaws.com", Action: "s3:PutObject",
+		Resource: "arn:aws:s3:::foo/public/log"})
+	assert.Nil(t, err)
+	assert.Equal(t, DecisionAllowed, res.Decision)
+	assert.Equal(t, "#2", res.Decider)
+	assert.Equal(t, "statement #1 (Deny): not matched, resource does not match", res.Evaluations[0].String())
+	assert.Equal(t, "statement #2 (Allow): matched", res.Evaluations[1].String())
+	assert.Equal(t, "allowed by statement #2", res.String())
+
+	res, err = doc.Simulate(Request{Principal: "logging.s3.amazonaws.com", Action: "s3:DeleteObject",
+		Resource: "arn:aws:s3:::foo/public/log"})
+	assert.Nil(t, err)
+	assert.Equal(t, DecisionImplicitlyDenied, res.Decision)
+	assert.Equal(t, "implicitly denied, no statement allows the request", res.String())
+
+	res, err = doc.Simulate(Request{Principal: "arn:aws:iam::111122223333:role/admin", Action: "s3:GetObject",
+		Resource: "arn:aws:s3:::foo/private/log"})
+	assert.Nil(t, err)
+	assert.Equal(t, "principal is excluded by NotPrincipal", res.Evaluations[0].Reason)
+
+	res, err = doc.Simulate(Request{Principal: "arn:aws:iam::111122223333:role/reader", Action: "s3:GetObject",
+		Resource: "arn:aws:s3:::foo/private/log"})
+	assert.Nil(t, err)
+	assert.Equal(t, DecisionExplicitlyDenied, res.Decision)
+	assert.Equal(t, "principal does not match", res.Evaluations[1].Reason)
+}
@@ -0,0 +1,130 @@
+package policy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	TemplateDenyInsecureTransport = "deny-insecure-transport"
+	TemplateEnforceSSEKMS         = "enforce-sse-kms"
+	TemplateCloudFrontOAC         = "cloudfront-oac"
+	TemplateReadOnlyAccount       = "read-only-account"
+	TemplateRestrictVpcEndpoint   = "restrict-vpc-endpoint"
+)
+
+var (
+	accountIDRegex     = regexp.MustCompile(`^[0-9]{12}$`)
+	vpcEndpointIDRegex = regexp.MustCompile(`^vpce-[0-9a-f]+$`)
+)
+
+// TemplateParams are the parameters of the policy templates, BucketName is required by all of them and the others
+// are required only by the templates that use them.
+type TemplateParams struct {
+	BucketName string
+	// KmsKeyArn is the optional ARN of the only KMS key that is allowed by TemplateEnforceSSEKMS
+	KmsKeyArn string
+	// DistributionArn is the ARN of the CloudFront distribution of TemplateCloudFrontOAC
+	DistributionArn string
+	// AccountID is the AWS account that TemplateReadOnlyAccount grants read access
+	AccountID string
+	// VpcEndpointID is the VPC endpoint that TemplateRestrictVpcEndpoint restricts the access to
+	VpcEndpointID string
+}
+
+// TemplateNames returns the names of the available policy templates.
+func TemplateNames() []string {
+	return []string{TemplateDenyInsecureTransport, TemplateEnforceSSEKMS, TemplateCloudFrontOAC, TemplateReadOnlyAccount,
+		TemplateRestrictVpcEndpoint}
+}
+
+// Template generates the policy Document of the template with the given name for the bucket in params. Every
+// statement of the generated Document has a Sid, so that it can be merged into an existing policy statement by
+// statement.
+func Template(name string, params TemplateParams) (*Document, error) {
+	if params.BucketName == "" {
+		return nil, fmt.Errorf("bucket name is required to generate a policy template")
+	}
+
+	bucketArn := "arn:aws:s3:::" + params.BucketName
+	objectsArn := bucketArn + "/*"
+
+	var statements Statements
+	switch name {
+	case TemplateDenyInsecureTransport:
+		statements = Statements{{
+			Sid:       "DenyInsecureTransport",
+			Effect:    "Deny",
+			Principal: Principal{wildcard: Value{wildcard}},
+			Action:    Value{"s3:*"},
+			Resource:  Value{bucketArn, objectsArn},
+			Condition: Condition{"Bool": {"aws:SecureTransport": Value{"false"}}},
+		}}
+	case TemplateEnforceSSEKMS:
+		statements = Statements{{
+			Sid:       "DenyNonKMSEncryptedUploads",
+			Effect:    "Deny",
+			Principal: Principal{wildcard: Value{wildcard}},
+			Action:    Value{"s3:PutObject"},
+			Resource:  Value{objectsArn},
+			Condition: Condition{"StringNotEquals": {"s3:x-amz-server-side-encryption": Value{"aws:kms"}}},
+		}}
+
+		if params.KmsKeyArn != "" {
+			statements = append(statements, Statement{
+				Sid:       "DenyUploadsWithOtherKMSKeys",
+				Effect:    "Deny",
+				Principal: Principal{wildcard: Value{wildcard}},
+				Action:    Value{"s3:PutObject"},
+				Resource:  Value{objectsArn},
+				Condition: Condition{"StringNotEqualsIfExists": {
+					"s3:x-amz-server-side-encryption-aws-kms-key-id": Value{params.KmsKeyArn},
+				}},
+			})
+		}
+	case TemplateCloudFrontOAC:
+		if !strings.HasPrefix(params.DistributionArn, "arn:aws:cloudfront::") {
+			return nil, fmt.Errorf("a valid CloudFront distribution ARN is required for %s template", name)
+		}
+
+		statements = Statements{{
+			Sid:       "AllowCloudFrontServicePrincipalReadOnly",
+			Effect:    "Allow",
+			Principal: Principal{"Service": Value{"cloudfront.amazonaws.com"}},
+			Action:    Value{"s3:GetObject"},
+			Resource:  Value{objectsArn},
+			Condition: Condition{"StringEquals": {"AWS:SourceArn": Value{params.DistributionArn}}},
+		}}
+	case TemplateReadOnlyAccount:
+		if !accountIDRegex.MatchString(params.AccountID) {
+			return nil, fmt.Errorf("a 12 digit AWS account ID is required for %s template", name)
+		}
+
+		statements = Statements{{
+			Sid:       "AllowReadOnly" + params.AccountID,
+			Effect:    "Allow",
+			Principal: Principal{"AWS": Value{fmt.Sprintf("arn:aws:iam::%s:root", params.AccountID)}},
+			Action:    Value{"s3:GetObject", "s3:GetObjectVersion", "s3:ListBucket", "s3:GetBucketLocation"},
+			Resource:  Value{bucketArn, objectsArn},
+		}}
+	case TemplateRestrictVpcEndpoint:
+		if !vpcEndpointIDRegex.MatchString(params.VpcEndpointID) {
+			return nil, fmt.Errorf("a valid VPC endpoint ID is required for %s template", name)
+		}
+
+		statements = Statements{{
+			Sid:       "RestrictToVpcEndpoint",
+			Effect:    "Deny",
+			Principal: Principal{wildcard: Value{wildcard}},
+			Action:    Value{"s3:*"},
+			Resource:  Value{bucketArn, objectsArn},
+			Condition: Condition{"StringNotEquals": {"aws:SourceVpce": Value{params.VpcEndpointID}}},
+		}}
+	default:
+		return nil, fmt.Errorf("unknown policy template %s, available templates are %s", name,
+			strings.Join(TemplateNames(), ", "))
+	}
+
+	return &Document{Version: DefaultVersion, Statement: statements}, nil
+}
@@ -0,0 +1,56 @@
+//go:build unit
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplate(t *testing.T) {
+	params := TemplateParams{
+		BucketName:      "thisisbucketname",
+		KmsKeyArn:       "arn:aws:kms:us-east-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+		DistributionArn: "arn:aws:cloudfront::111122223333:distribution/EDFDVBD6EXAMPLE",
+		AccountID:       "111122223333",
+		VpcEndpointID:   "vpce-1a2b3c4d",
+	}
+
+	cases := []struct {
+		caseName   string
+		name       string
+		params     TemplateParams
+		shouldPass bool
+		statements int
+	}{
+		{"Success deny insecure transport", TemplateDenyInsecureTransport, params, true, 1},
+		{"Success enforce sse kms", TemplateEnforceSSEKMS, params, true, 2},
+		{"Success enforce sse kms without key", TemplateEnforceSSEKMS, TemplateParams{BucketName: "thisisbucketname"}, true, 1},
+		{"Success cloudfront oac", TemplateCloudFrontOAC, params, true, 1},
+		{"Success read only account", TemplateReadOnlyAccount, params, true, 1},
+		{"Success restrict vpc endpoint", TemplateRestrictVpcEndpoint, params, true, 1},
+		{"Failure caused by missing bucket name", TemplateDenyInsecureTransport, TemplateParams{}, false, 0},
+		{"Failure caused by missing distribution", TemplateCloudFrontOAC, TemplateParams{BucketName: "thisisbucketname"}, false, 0},
+		{"Failure caused by invalid account", TemplateReadOnlyAccount, TemplateParams{BucketName: "thisisbucketname", AccountID: "1111"}, false, 0},
+		{"Failure caused by invalid vpc endpoint", TemplateRestrictVpcEndpoint, TemplateParams{BucketName: "thisisbucketname", VpcEndpointID: "vpc-1"}, false, 0},
+		{"Failure caused by unknown template", "allow-everything", params, false, 0},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		doc, err := Template(tc.name, tc.params)
+		if !tc.shouldPass {
+			assert.NotNil(t, err)
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Len(t, doc.Statement, tc.statements)
+
+		content, err := doc.String()
+		assert.Nil(t, err)
+		assert.Empty(t, Lint(content, tc.params.BucketName))
+	}
+}
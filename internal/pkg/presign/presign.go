@@ -0,0 +1,87 @@
+package presign
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+// URL is a presigned URL of an object. Headers are the signed headers that the requests must be sent with, the Host
+// header is left out since HTTP clients set it from the URL.
+type URL struct {
+	Key     string
+	Method  string
+	URL     string
+	Headers http.Header
+}
+
+// FromRequest returns the presigned request of the object with the given key as a URL.
+func FromRequest(key string, req *v4.PresignedHTTPRequest) URL {
+	headers := req.SignedHeader.Clone()
+	if headers == nil {
+		headers = http.Header{}
+	}
+
+	headers.Del("Host")
+
+	return URL{Key: key, Method: req.Method, URL: req.URL, Headers: headers}
+}
+
+// HeaderLines returns the Headers of the URL as 'Name: value' lines in ascending order.
+func (u URL) HeaderLines() []string {
+	lines := make([]string, 0, len(u.Headers))
+	for name, values := range u.Headers {
+		lines = append(lines, fmt.Sprintf("%s: %s", name, strings.Join(values, ",")))
+	}
+
+	sort.Strings(lines)
+
+	return lines
+}
+
+// MarshalCSV returns the URLs as a CSV with 'key' and 'url' columns, without a trailing newline. If any of the URLs
+// has signed headers, a 'headers' column is added with the HeaderLines of each URL separated by newlines, since the
+// requests are rejected by S3 when they are sent without them.
+func MarshalCSV(urls []URL) (string, error) {
+	withHeaders := false
+	for _, url := range urls {
+		if len(url.Headers) > 0 {
+			withHeaders = true
+			break
+		}
+	}
+
+	columns := []string{"key", "url"}
+	if withHeaders {
+		columns = append(columns, "headers")
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(columns); err != nil {
+		return "", err
+	}
+
+	for _, url := range urls {
+		record := []string{url.Key, url.URL}
+		if withHeaders {
+			record = append(record, strings.Join(url.HeaderLines(), "\n"))
+		}
+
+		if err := writer.Write(record); err != nil {
+			return "", err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
@@ -0,0 +1,55 @@
+//go:build unit
+
+package presign
+
+import (
+	"net/http"
+	"testing"
+
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromRequest(t *testing.T) {
+	url := FromRequest("foo", &v4.PresignedHTTPRequest{
+		URL:    "https://thisisbucketname.s3.amazonaws.com/foo?X-Amz-Signature=signature",
+		Method: http.MethodPut,
+		SignedHeader: http.Header{
+			"Host":         []string{"thisisbucketname.s3.amazonaws.com"},
+			"Content-Type": []string{"text/csv"},
+		},
+	})
+
+	assert.Equal(t, "foo", url.Key)
+	assert.Equal(t, http.MethodPut, url.Method)
+	assert.Equal(t, []string{"Content-Type: text/csv"}, url.HeaderLines())
+	assert.Empty(t, FromRequest("foo", &v4.PresignedHTTPRequest{}).HeaderLines())
+}
+
+func TestMarshalCSV(t *testing.T) {
+	out, err := MarshalCSV([]URL{
+		{Key: "reports/a.csv", URL: "https://example.com/reports/a.csv?X-Amz-Signature=a"},
+		{Key: "reports/b,c.csv", URL: "https://example.com/reports/b%2Cc.csv?X-Amz-Signature=b"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, `key,url
+reports/a.csv,https://example.com/reports/a.csv?X-Amz-Signature=a
+"reports/b,c.csv",https://example.com/reports/b%2Cc.csv?X-Amz-Signature=b`, out)
+
+	out, err = MarshalCSV([]URL{
+		{Key: "reports/a.csv", URL: "https://example.com/reports/a.csv?X-Amz-Signature=a", Headers: http.Header{
+			"Content-Type":          []string{"text/csv"},
+			"X-Amz-Checksum-Sha256": []string{"checksum"},
+		}},
+		{Key: "reports/b.csv", URL: "https://example.com/reports/b.csv?X-Amz-Signature=b", Headers: http.Header{}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, `key,url,headers
+reports/a.csv,https://example.com/reports/a.csv?X-Amz-Signature=a,"Content-Type: text/csv
+X-Amz-Checksum-Sha256: checksum"
+reports/b.csv,https://example.com/reports/b.csv?X-Amz-Signature=b,`, out)
+
+	out, err = MarshalCSV(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "key,url", out)
+}
@@ -30,6 +30,18 @@ func GetConfirmRunner() *promptui.Prompt {
 	})
 }
 
+// GetExactMatchRunner returns a prompt that only accepts the expected input, it is used to guard the destructive
+// operations by making the user type the name of the resource.
+func GetExactMatchRunner(label, expected string) *promptui.Prompt {
+	return GetPromptRunner(label, false, func(s string) error {
+		if s == expected {
+			return nil
+		}
+
+		return errors.New("input does not match")
+	})
+}
+
 func AskForApproval(runner PromptRunner) error {
 	if res, err := runner.Run(); err != nil {
 		if strings.ToLower(res) == "n" {
@@ -42,6 +54,16 @@ func AskForApproval(runner PromptRunner) error {
 	return nil
 }
 
+// AskForExactMatch runs the prompt and returns an error unless the user typed exactly the expected input.
+func AskForExactMatch(runner PromptRunner, expected string) error {
+	res, err := runner.Run()
+	if err != nil || res != expected {
+		return constants.ErrInputMismatch
+	}
+
+	return nil
+}
+
 type PromptMock struct {
 	Msg string
 	Err error
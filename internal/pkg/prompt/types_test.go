@@ -109,3 +109,59 @@ func TestAskForApproval(t *testing.T) {
 		})
 	}
 }
+
+func TestGetExactMatchRunner(t *testing.T) {
+	testCases := []struct {
+		caseName  string
+		input     string
+		expectErr bool
+	}{
+		{"Exact match", "thisisbucketname", false},
+		{"Different case", "ThisIsBucketName", true},
+		{"Prefix", "thisisbucket", true},
+		{"Empty", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.caseName, func(t *testing.T) {
+			t.Logf("starting case %s", tc.caseName)
+
+			wrapper := &PromptWrapper{
+				Prompt:    GetExactMatchRunner("Type the bucket name to confirm", "thisisbucketname"),
+				UserInput: tc.input,
+			}
+
+			_, err := wrapper.Run()
+			if tc.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestAskForExactMatch(t *testing.T) {
+	testCases := []struct {
+		caseName  string
+		mock      *PromptMock
+		expectErr bool
+	}{
+		{"Match", &PromptMock{Msg: "thisisbucketname"}, false},
+		{"Mismatch", &PromptMock{Msg: "foo"}, true},
+		{"Prompt error", &PromptMock{Msg: "thisisbucketname", Err: constants.ErrInjected}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.caseName, func(t *testing.T) {
+			t.Logf("starting case %s", tc.caseName)
+
+			err := AskForExactMatch(tc.mock, "thisisbucketname")
+			if tc.expectErr {
+				assert.Equal(t, constants.ErrInputMismatch, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package publicaccess
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/policy"
+)
+
+const (
+	AllUsersURI           = "http://acs.amazonaws.com/groups/global/AllUsers"
+	AuthenticatedUsersURI = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+)
+
+// Verdict is the result of the public access evaluation of a bucket.
+type Verdict struct {
+	// Public is true if the bucket is accessible by anyone through its policy or ACL
+	Public bool
+	// Reasons explains which policy statements, ACL grants and block settings led to the verdict
+	Reasons []string
+}
+
+// IsPublicGrant reports whether the ACL grant is given to the AllUsers or AuthenticatedUsers groups.
+func IsPublicGrant(grant types.Grant) bool {
+	if grant.Grantee == nil || grant.Grantee.Type != types.TypeGroup {
+		return false
+	}
+
+	uri := aws.ToString(grant.Grantee.URI)
+	return uri == AllUsersURI || uri == AuthenticatedUsersURI
+}
+
+// Evaluate decides whether a bucket is public by combining its policy statements, ACL grants and public access block
+// settings. A nil policy document means that the bucket has no policy, and a nil block configuration means that
+// none of the block settings is enabled.
+//
+// Policy statements that allow access to anyone make the bucket public unless 'RestrictPublicBuckets' is enabled,
+// and ACL grants to the AllUsers or AuthenticatedUsers groups make the bucket public unless 'IgnorePublicAcls' is
+// enabled.
+func Evaluate(doc *policy.Document, grants []types.Grant, block *types.PublicAccessBlockConfiguration) Verdict {
+	if block == nil {
+		block = &types.PublicAccessBlockConfiguration{}
+	}
+
+	verdict := Verdict{}
+	if doc != nil {
+		for i, statement := range doc.Statement {
+			if !statement.IsPublic() {
+				continue
+			}
+
+			sid := statement.Sid
+			if sid == "" {
+				sid = fmt.Sprintf("#%d", i+1)
+			}
+
+			if aws.ToBool(block.RestrictPublicBuckets) {
+				verdict.Reasons = append(verdict.Reasons,
+					fmt.Sprintf("policy statement '%s' allows public access but it is restricted by 'RestrictPublicBuckets'", sid))
+				continue
+			}
+
+			verdict.Public = true
+			verdict.Reasons = append(verdict.Reasons, fmt.Sprintf("policy statement '%s' allows public access", sid))
+		}
+	}
+
+	for _, grant := range grants {
+		if !IsPublicGrant(grant) {
+			continue
+		}
+
+		if aws.ToBool(block.IgnorePublicAcls) {
+			verdict.Reasons = append(verdict.Reasons, fmt.Sprintf("ACL grants %s to %s but it is ignored by 'IgnorePublicAcls'",
+				grant.Permission, aws.ToString(grant.Grantee.URI)))
+			continue
+		}
+
+		verdict.Public = true
+		verdict.Reasons = append(verdict.Reasons, fmt.Sprintf("ACL grants %s to %s", grant.Permission, aws.ToString(grant.Grantee.URI)))
+	}
+
+	return verdict
+}
@@ -0,0 +1,76 @@
+//go:build unit
+
+package publicaccess
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	publicDoc = &policy.Document{Statement: policy.Statements{
+		{Sid: "PublicRead", Effect: "Allow", Principal: policy.Principal{"*": policy.Value{"*"}}, Action: policy.Value{"s3:GetObject"}},
+	}}
+	privateDoc = &policy.Document{Statement: policy.Statements{
+		{Effect: "Deny", Principal: policy.Principal{"*": policy.Value{"*"}}, Action: policy.Value{"s3:*"}},
+	}}
+	publicGrant = types.Grant{
+		Grantee:    &types.Grantee{Type: types.TypeGroup, URI: aws.String(AllUsersURI)},
+		Permission: types.PermissionRead,
+	}
+	privateGrant = types.Grant{
+		Grantee:    &types.Grantee{Type: types.TypeCanonicalUser, ID: aws.String("thisisownerid")},
+		Permission: types.PermissionFullControl,
+	}
+)
+
+func TestEvaluate(t *testing.T) {
+	cases := []struct {
+		caseName        string
+		doc             *policy.Document
+		grants          []types.Grant
+		block           *types.PublicAccessBlockConfiguration
+		expected        bool
+		expectedReasons int
+	}{
+		{"Public caused by policy", publicDoc, []types.Grant{privateGrant}, nil, true, 1},
+		{"Public caused by acl", privateDoc, []types.Grant{publicGrant}, nil, true, 1},
+		{"Public caused by policy and acl", publicDoc, []types.Grant{publicGrant, privateGrant}, nil, true, 2},
+		{"Not public without policy", nil, []types.Grant{privateGrant}, nil, false, 0},
+		{"Not public when restricted by block settings", publicDoc, []types.Grant{publicGrant},
+			&types.PublicAccessBlockConfiguration{RestrictPublicBuckets: aws.Bool(true), IgnorePublicAcls: aws.Bool(true)}, false, 2},
+		{"Public when only acls are ignored", publicDoc, []types.Grant{publicGrant},
+			&types.PublicAccessBlockConfiguration{IgnorePublicAcls: aws.Bool(true)}, true, 2},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		verdict := Evaluate(tc.doc, tc.grants, tc.block)
+		assert.Equal(t, tc.expected, verdict.Public)
+		assert.Len(t, verdict.Reasons, tc.expectedReasons)
+	}
+}
+
+func TestEvaluateUnnamedStatement(t *testing.T) {
+	doc := &policy.Document{Statement: policy.Statements{
+		privateDoc.Statement[0],
+		{Effect: "Allow", Principal: policy.Principal{"*": policy.Value{"*"}}, Action: policy.Value{"s3:GetObject"}},
+	}}
+
+	// the unnamed statements are labeled with their 1-based positions, just like the lint does
+	verdict := Evaluate(doc, nil, nil)
+	assert.True(t, verdict.Public)
+	assert.Len(t, verdict.Reasons, 1)
+	assert.Contains(t, verdict.Reasons[0], "'#2'")
+}
+
+func TestIsPublicGrant(t *testing.T) {
+	assert.True(t, IsPublicGrant(publicGrant))
+	assert.False(t, IsPublicGrant(privateGrant))
+	assert.False(t, IsPublicGrant(types.Grant{}))
+}
@@ -0,0 +1,356 @@
+package replication
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxRules is the maximum number of replication rules that a bucket can have
+	MaxRules = 1000
+
+	StatusEnabled  = "Enabled"
+	StatusDisabled = "Disabled"
+
+	// StatusNone is reported for the objects that do not have any replication status, which means they are not in
+	// the scope of any replication rule or they were uploaded before the replication was configured
+	StatusNone = "NONE"
+)
+
+var (
+	roleArnRegex   = regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:role/.+$`)
+	bucketArnRegex = regexp.MustCompile(`^arn:aws[a-z-]*:s3:::[^/]+$`)
+
+	// Statuses are the replication statuses reported by Summary, in the order they are printed
+	Statuses = []string{
+		string(types.ReplicationStatusCompleted),
+		string(types.ReplicationStatusPending),
+		string(types.ReplicationStatusFailed),
+		string(types.ReplicationStatusReplica),
+		StatusNone,
+	}
+)
+
+// Configuration is the replication configuration of a bucket, its JSON representation is identical with the
+// "--replication-configuration" input of "aws s3api put-bucket-replication" command.
+type Configuration struct {
+	Role  string `json:"Role" yaml:"Role"`
+	Rules []Rule `json:"Rules" yaml:"Rules"`
+}
+
+// Rule is a single replication rule of a Configuration.
+type Rule struct {
+	ID                      string                   `json:"ID,omitempty" yaml:"ID,omitempty"`
+	Priority                *int32                   `json:"Priority,omitempty" yaml:"Priority,omitempty"`
+	Status                  string                   `json:"Status" yaml:"Status"`
+	Filter                  *Filter                  `json:"Filter,omitempty" yaml:"Filter,omitempty"`
+	Destination             Destination              `json:"Destination" yaml:"Destination"`
+	DeleteMarkerReplication *DeleteMarkerReplication `json:"DeleteMarkerReplication,omitempty" yaml:"DeleteMarkerReplication,omitempty"`
+}
+
+// Filter identifies the objects that a Rule applies to.
+type Filter struct {
+	Prefix string `json:"Prefix" yaml:"Prefix"`
+}
+
+// Destination is the bucket that the objects matching a Rule are replicated to.
+type Destination struct {
+	Bucket       string `json:"Bucket" yaml:"Bucket"`
+	Account      string `json:"Account,omitempty" yaml:"Account,omitempty"`
+	StorageClass string `json:"StorageClass,omitempty" yaml:"StorageClass,omitempty"`
+}
+
+// DeleteMarkerReplication specifies whether the delete markers are replicated.
+type DeleteMarkerReplication struct {
+	Status string `json:"Status" yaml:"Status"`
+}
+
+// Parse parses the JSON or YAML content into a Configuration.
+func Parse(content []byte) (*Configuration, error) {
+	cfg := &Configuration{}
+	if err := utils.UnmarshalJSONOrYAML(content, cfg); err != nil {
+		return nil, errors.Wrap(err, "an error occurred while parsing replication configuration")
+	}
+
+	return cfg, nil
+}
+
+// Validate checks the Configuration against the rules of S3 and returns the first violation it finds.
+func (c *Configuration) Validate() error {
+	if !roleArnRegex.MatchString(c.Role) {
+		return fmt.Errorf("role %q is not a valid IAM role ARN", c.Role)
+	}
+
+	if len(c.Rules) == 0 {
+		return errors.New("replication configuration must contain at least one rule")
+	}
+
+	if len(c.Rules) > MaxRules {
+		return fmt.Errorf("replication configuration can contain at most %d rules, got %d", MaxRules, len(c.Rules))
+	}
+
+	ids := make(map[string]struct{})
+	priorities := make(map[int32]struct{})
+	for i, rule := range c.Rules {
+		if err := rule.Validate(); err != nil {
+			return errors.Wrapf(err, "rule %s is invalid", rule.name(i))
+		}
+
+		if rule.ID != "" {
+			if _, ok := ids[rule.ID]; ok {
+				return fmt.Errorf("duplicate rule ID %s", rule.ID)
+			}
+
+			ids[rule.ID] = struct{}{}
+		}
+
+		if rule.Priority != nil {
+			if _, ok := priorities[*rule.Priority]; ok {
+				return fmt.Errorf("duplicate rule priority %d", *rule.Priority)
+			}
+
+			priorities[*rule.Priority] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// Validate checks a single Rule against the rules of S3.
+func (r Rule) Validate() error {
+	if err := validateStatus(r.Status); err != nil {
+		return err
+	}
+
+	if r.Filter != nil && r.Priority == nil {
+		return errors.New("priority must be specified for the rules with a filter")
+	}
+
+	if !bucketArnRegex.MatchString(r.Destination.Bucket) {
+		return fmt.Errorf("destination bucket %q is not a valid bucket ARN", r.Destination.Bucket)
+	}
+
+	if r.Destination.StorageClass != "" && !isStorageClass(r.Destination.StorageClass) {
+		return fmt.Errorf("unsupported destination storage class %q", r.Destination.StorageClass)
+	}
+
+	if r.DeleteMarkerReplication != nil {
+		return validateStatus(r.DeleteMarkerReplication.Status)
+	}
+
+	return nil
+}
+
+func validateStatus(status string) error {
+	if status != StatusEnabled && status != StatusDisabled {
+		return fmt.Errorf("status must be %s or %s, got %q", StatusEnabled, StatusDisabled, status)
+	}
+
+	return nil
+}
+
+func isStorageClass(storageClass string) bool {
+	for _, v := range types.StorageClass("").Values() {
+		if string(v) == storageClass {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r Rule) name(index int) string {
+	if r.ID != "" {
+		return r.ID
+	}
+
+	return "#" + strconv.Itoa(index)
+}
+
+// String returns the indented JSON representation of the Configuration.
+func (c *Configuration) String() (string, error) {
+	bytes, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes), nil
+}
+
+// ToSDK converts the Configuration into the replication configuration of AWS SDK. The rules with a filter are
+// required to specify whether the delete markers are replicated, so it is disabled unless configured explicitly.
+func (c *Configuration) ToSDK() *types.ReplicationConfiguration {
+	cfg := &types.ReplicationConfiguration{Role: aws.String(c.Role)}
+	for _, rule := range c.Rules {
+		sdkRule := types.ReplicationRule{
+			Priority: rule.Priority,
+			Status:   types.ReplicationRuleStatus(rule.Status),
+			Destination: &types.Destination{
+				Bucket:       aws.String(rule.Destination.Bucket),
+				StorageClass: types.StorageClass(rule.Destination.StorageClass),
+			},
+		}
+
+		if rule.ID != "" {
+			sdkRule.ID = aws.String(rule.ID)
+		}
+
+		if rule.Destination.Account != "" {
+			sdkRule.Destination.Account = aws.String(rule.Destination.Account)
+		}
+
+		if rule.Filter != nil {
+			sdkRule.Filter = &types.ReplicationRuleFilterMemberPrefix{Value: rule.Filter.Prefix}
+			sdkRule.DeleteMarkerReplication = &types.DeleteMarkerReplication{Status: types.DeleteMarkerReplicationStatusDisabled}
+		}
+
+		if rule.DeleteMarkerReplication != nil {
+			sdkRule.DeleteMarkerReplication = &types.DeleteMarkerReplication{
+				Status: types.DeleteMarkerReplicationStatus(rule.DeleteMarkerReplication.Status),
+			}
+		}
+
+		cfg.Rules = append(cfg.Rules, sdkRule)
+	}
+
+	return cfg
+}
+
+// FromSDK converts the replication configuration of AWS SDK into a Configuration. Filters other than a plain
+// prefix are not supported by Configuration, they are reported with the prefix they contain.
+func FromSDK(res *types.ReplicationConfiguration) *Configuration {
+	cfg := &Configuration{Rules: []Rule{}}
+	if res == nil {
+		return cfg
+	}
+
+	cfg.Role = aws.ToString(res.Role)
+	for _, rule := range res.Rules {
+		r := Rule{
+			ID:       aws.ToString(rule.ID),
+			Priority: rule.Priority,
+			Status:   string(rule.Status),
+		}
+
+		if rule.Destination != nil {
+			r.Destination = Destination{
+				Bucket:       aws.ToString(rule.Destination.Bucket),
+				Account:      aws.ToString(rule.Destination.Account),
+				StorageClass: string(rule.Destination.StorageClass),
+			}
+		}
+
+		switch filter := rule.Filter.(type) {
+		case *types.ReplicationRuleFilterMemberPrefix:
+			r.Filter = &Filter{Prefix: filter.Value}
+		case *types.ReplicationRuleFilterMemberAnd:
+			r.Filter = &Filter{Prefix: aws.ToString(filter.Value.Prefix)}
+		case *types.ReplicationRuleFilterMemberTag:
+			r.Filter = &Filter{}
+		}
+
+		if rule.DeleteMarkerReplication != nil {
+			r.DeleteMarkerReplication = &DeleteMarkerReplication{Status: string(rule.DeleteMarkerReplication.Status)}
+		}
+
+		cfg.Rules = append(cfg.Rules, r)
+	}
+
+	return cfg
+}
+
+// Summary counts the replication statuses of the objects per prefix.
+type Summary struct {
+	depth  int
+	counts map[string]map[string]int
+}
+
+// Row is the replication status counts of a single prefix of a Summary.
+type Row struct {
+	Prefix string
+	Counts map[string]int
+	Total  int
+}
+
+// NewSummary returns a Summary that groups the objects by the first depth components of their keys.
+func NewSummary(depth int) *Summary {
+	return &Summary{
+		depth:  depth,
+		counts: make(map[string]map[string]int),
+	}
+}
+
+// Add counts an object with the given key and replication status.
+func (s *Summary) Add(key string, status types.ReplicationStatus) {
+	prefix := PrefixOf(key, s.depth)
+	if _, ok := s.counts[prefix]; !ok {
+		s.counts[prefix] = make(map[string]int)
+	}
+
+	name := string(status)
+	switch status {
+	case "":
+		name = StatusNone
+	case types.ReplicationStatusComplete:
+		// HeadObject used to report the completed replications as COMPLETE, both are counted as COMPLETED
+		name = string(types.ReplicationStatusCompleted)
+	}
+
+	s.counts[prefix][name]++
+}
+
+// Rows returns the counts of each prefix sorted by prefix.
+func (s *Summary) Rows() []Row {
+	rows := make([]Row, 0, len(s.counts))
+	for prefix, counts := range s.counts {
+		row := Row{Prefix: prefix, Counts: counts}
+		for _, count := range counts {
+			row.Total += count
+		}
+
+		rows = append(rows, row)
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Prefix < rows[j].Prefix
+	})
+
+	return rows
+}
+
+// Count returns the total number of objects with the given status.
+func (s *Summary) Count(status string) (total int) {
+	for _, counts := range s.counts {
+		total += counts[status]
+	}
+
+	return total
+}
+
+// PrefixOf returns the first depth components of the key including the trailing slash, objects at the root of
+// the bucket are reported with the "/" prefix.
+func PrefixOf(key string, depth int) string {
+	if depth <= 0 {
+		return "/"
+	}
+
+	parts := strings.Split(key, "/")
+	if len(parts) <= 1 {
+		return "/"
+	}
+
+	if len(parts)-1 < depth {
+		depth = len(parts) - 1
+	}
+
+	return strings.Join(parts[:depth], "/") + "/"
+}
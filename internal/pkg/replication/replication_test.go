@@ -0,0 +1,138 @@
+//go:build unit
+
+package replication
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	role   = "arn:aws:iam::123456789012:role/s3-replication"
+	bucket = "arn:aws:s3:::thevpnbeast-releases-dr"
+)
+
+func validRule(id string, priority int32) Rule {
+	return Rule{
+		ID:          id,
+		Priority:    aws.Int32(priority),
+		Status:      StatusEnabled,
+		Filter:      &Filter{Prefix: id + "/"},
+		Destination: Destination{Bucket: bucket},
+	}
+}
+
+func TestParse(t *testing.T) {
+	cfg, err := Parse([]byte(`{"Role": "` + role + `", "Rules": [{"Status": "Enabled", "Destination": {"Bucket": "` + bucket + `"}}]}`))
+	assert.Nil(t, err)
+	assert.Len(t, cfg.Rules, 1)
+
+	cfg, err = Parse([]byte("Role: " + role + "\nRules:\n  - Status: Enabled\n    Priority: 2\n    Filter:\n      Prefix: foo/\n    Destination:\n      Bucket: " + bucket + "\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), *cfg.Rules[0].Priority)
+	assert.Equal(t, "foo/", cfg.Rules[0].Filter.Prefix)
+
+	_, err = Parse([]byte(`{"Role": `))
+	assert.NotNil(t, err)
+}
+
+func TestConfiguration_Validate(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		cfg        *Configuration
+		shouldPass bool
+	}{
+		{"Success", &Configuration{Role: role, Rules: []Rule{validRule("foo", 1), validRule("bar", 2)}}, true},
+		{"Success without filter", &Configuration{Role: role, Rules: []Rule{{Status: StatusDisabled, Destination: Destination{Bucket: bucket, StorageClass: "GLACIER"}}}}, true},
+		{"Failure invalid role", &Configuration{Role: "s3-replication", Rules: []Rule{validRule("foo", 1)}}, false},
+		{"Failure no rules", &Configuration{Role: role}, false},
+		{"Failure too many rules", &Configuration{Role: role, Rules: make([]Rule, MaxRules+1)}, false},
+		{"Failure duplicate id", &Configuration{Role: role, Rules: []Rule{validRule("foo", 1), validRule("foo", 2)}}, false},
+		{"Failure duplicate priority", &Configuration{Role: role, Rules: []Rule{validRule("foo", 1), validRule("bar", 1)}}, false},
+		{"Failure invalid status", &Configuration{Role: role, Rules: []Rule{{Status: "enabled", Destination: Destination{Bucket: bucket}}}}, false},
+		{"Failure filter without priority", &Configuration{Role: role, Rules: []Rule{{Status: StatusEnabled, Filter: &Filter{}, Destination: Destination{Bucket: bucket}}}}, false},
+		{"Failure invalid destination", &Configuration{Role: role, Rules: []Rule{{Status: StatusEnabled, Destination: Destination{Bucket: "thevpnbeast-releases-dr"}}}}, false},
+		{"Failure invalid storage class", &Configuration{Role: role, Rules: []Rule{{Status: StatusEnabled, Destination: Destination{Bucket: bucket, StorageClass: "COLD"}}}}, false},
+		{"Failure invalid delete marker replication", &Configuration{Role: role, Rules: []Rule{{Status: StatusEnabled, Destination: Destination{Bucket: bucket},
+			DeleteMarkerReplication: &DeleteMarkerReplication{Status: "on"}}}}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		err := tc.cfg.Validate()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestSDKConversion(t *testing.T) {
+	withAccount := validRule("bar", 2)
+	withAccount.Destination.Account = "210987654321"
+	withAccount.DeleteMarkerReplication = &DeleteMarkerReplication{Status: StatusEnabled}
+
+	cfg := &Configuration{Role: role, Rules: []Rule{validRule("foo", 1), withAccount}}
+	sdk := cfg.ToSDK()
+
+	assert.Equal(t, types.DeleteMarkerReplicationStatusDisabled, sdk.Rules[0].DeleteMarkerReplication.Status)
+	assert.Equal(t, types.DeleteMarkerReplicationStatusEnabled, sdk.Rules[1].DeleteMarkerReplication.Status)
+	assert.Nil(t, sdk.Rules[0].Destination.Account)
+
+	expected := &Configuration{Role: role, Rules: []Rule{validRule("foo", 1), withAccount}}
+	expected.Rules[0].DeleteMarkerReplication = &DeleteMarkerReplication{Status: StatusDisabled}
+	assert.Equal(t, expected, FromSDK(sdk))
+
+	and := FromSDK(&types.ReplicationConfiguration{Rules: []types.ReplicationRule{
+		{Filter: &types.ReplicationRuleFilterMemberAnd{Value: types.ReplicationRuleAndOperator{Prefix: aws.String("foo/")}}},
+	}})
+	assert.Equal(t, "foo/", and.Rules[0].Filter.Prefix)
+	assert.Empty(t, FromSDK(nil).Rules)
+
+	content, err := cfg.String()
+	assert.Nil(t, err)
+	assert.Contains(t, content, `"Role": "`+role+`"`)
+}
+
+func TestPrefixOf(t *testing.T) {
+	cases := []struct {
+		key      string
+		depth    int
+		expected string
+	}{
+		{"file.txt", 1, "/"},
+		{"foo/file.txt", 1, "foo/"},
+		{"foo/bar/file.txt", 1, "foo/"},
+		{"foo/bar/file.txt", 2, "foo/bar/"},
+		{"foo/bar/file.txt", 5, "foo/bar/"},
+		{"foo/bar/file.txt", 0, "/"},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, PrefixOf(tc.key, tc.depth), tc.key)
+	}
+}
+
+func TestSummary(t *testing.T) {
+	summary := NewSummary(1)
+	summary.Add("foo/1.txt", types.ReplicationStatusComplete)
+	summary.Add("foo/2.txt", types.ReplicationStatusFailed)
+	summary.Add("foo/3.txt", types.ReplicationStatusCompleted)
+	summary.Add("bar/1.txt", types.ReplicationStatusPending)
+	summary.Add("1.txt", "")
+
+	rows := summary.Rows()
+	assert.Len(t, rows, 3)
+	assert.Equal(t, "/", rows[0].Prefix)
+	assert.Equal(t, 1, rows[0].Counts[StatusNone])
+	assert.Equal(t, "foo/", rows[2].Prefix)
+	assert.Equal(t, 3, rows[2].Total)
+	assert.Equal(t, 2, rows[2].Counts[string(types.ReplicationStatusCompleted)])
+	assert.Equal(t, 1, summary.Count(string(types.ReplicationStatusFailed)))
+	assert.Equal(t, 0, summary.Count(string(types.ReplicationStatusReplica)))
+}
@@ -0,0 +1,190 @@
+package restore
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/objectinfo"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/storageclass"
+)
+
+const (
+	StateNotArchived = "not archived"
+	StateNotRestored = "not restored"
+	StateInProgress  = "in progress"
+	StateRestored    = "restored"
+
+	ReasonNotArchived = "not archived, it can be read without a restore"
+	ReasonInProgress  = "a restore is already in progress"
+	ReasonNoExpedited = "the Expedited tier is not available for the objects in %s"
+	ReasonNotRestored = "archived in %s, it must be restored before it can be read"
+
+	ErrInvalidRestoreHeader = "invalid restore header %q"
+)
+
+// restoreHeader matches the x-amz-restore header that HeadObject returns for the objects that have a restore
+// request, such as 'ongoing-request="false", expiry-date="Fri, 21 Dec 2012 00:00:00 GMT"'
+var restoreHeader = regexp.MustCompile(`ongoing-request="(true|false)"(?:,\s*expiry-date="([^"]+)")?`)
+
+// Status is the restore status of an object. ArchiveStatus is the archive access tier of the objects in the
+// INTELLIGENT_TIERING storage class, Expiry is the time that the restored copy is removed.
+type Status struct {
+	Key           string
+	StorageClass  string
+	ArchiveStatus string
+	State         string
+	Expiry        time.Time
+}
+
+// Result is the outcome of the restore request of a single object, Err is nil on success.
+type Result struct {
+	Key string
+	Err error
+}
+
+// Tiers returns the names of the retrieval tiers.
+func Tiers() []string {
+	var tiers []string
+	for _, tier := range types.Tier("").Values() {
+		tiers = append(tiers, string(tier))
+	}
+
+	return tiers
+}
+
+// ParseTier returns the retrieval tier with the given case-insensitive name.
+func ParseTier(name string) (types.Tier, bool) {
+	for _, tier := range types.Tier("").Values() {
+		if strings.EqualFold(string(tier), name) {
+			return tier, true
+		}
+	}
+
+	return "", false
+}
+
+// ParseStatus returns the restore status of the object with the given key from its HeadObject output.
+func ParseStatus(key string, head *s3.HeadObjectOutput) (Status, error) {
+	status := Status{
+		Key:           key,
+		StorageClass:  objectinfo.StorageClass(head),
+		ArchiveStatus: string(head.ArchiveStatus),
+		State:         StateNotArchived,
+	}
+
+	if header := aws.ToString(head.Restore); header != "" {
+		match := restoreHeader.FindStringSubmatch(header)
+		if match == nil {
+			return status, fmt.Errorf(ErrInvalidRestoreHeader, header)
+		}
+
+		if match[1] == "true" {
+			status.State = StateInProgress
+			return status, nil
+		}
+
+		status.State = StateRestored
+		if match[2] != "" {
+			expiry, err := time.Parse(time.RFC1123, match[2])
+			if err != nil {
+				return status, fmt.Errorf(ErrInvalidRestoreHeader, header)
+			}
+
+			status.Expiry = expiry
+		}
+
+		return status, nil
+	}
+
+	if class, ok := storageclass.Lookup(status.StorageClass); (ok && class.Archived) || status.ArchiveStatus != "" {
+		status.State = StateNotRestored
+	}
+
+	return status, nil
+}
+
+// Plan splits the statuses into the objects that can be restored with the given tier and the ones that can not, the
+// restored objects are requested again to extend the expiry of their restored copies.
+func Plan(statuses []Status, tier types.Tier) (requests []Status, skips []storageclass.Skip) {
+	for _, status := range statuses {
+		switch {
+		case status.State == StateNotArchived:
+			skips = append(skips, storageclass.Skip{Key: status.Key, Reason: ReasonNotArchived})
+		case status.State == StateInProgress:
+			skips = append(skips, storageclass.Skip{Key: status.Key, Reason: ReasonInProgress})
+		case tier == types.TierExpedited && status.deepArchive():
+			skips = append(skips, storageclass.Skip{Key: status.Key, Reason: fmt.Sprintf(ReasonNoExpedited, status.tier())})
+		default:
+			requests = append(requests, status)
+		}
+	}
+
+	return requests, skips
+}
+
+// InProgress returns the number of the statuses whose restores are in progress.
+func InProgress(statuses []Status) (count int) {
+	for _, status := range statuses {
+		if status.State == StateInProgress {
+			count++
+		}
+	}
+
+	return count
+}
+
+// ArchivedReason returns the reason that an object can not be read if err is the InvalidObjectState error that
+// GetObject returns for the archived objects that are not restored.
+func ArchivedReason(err error) (string, bool) {
+	var state *types.InvalidObjectState
+	if !errors.As(err, &state) {
+		return "", false
+	}
+
+	tier := strings.TrimSpace(fmt.Sprintf("%s %s", state.StorageClass, state.AccessTier))
+	if tier == "" {
+		tier = "an archive tier"
+	}
+
+	return fmt.Sprintf(ReasonNotRestored, tier), true
+}
+
+// String returns the human-readable representation of the Status.
+func (s Status) String() string {
+	if s.State == StateRestored && !s.Expiry.IsZero() {
+		return fmt.Sprintf("%s: %s, restored until %s", s.Key, s.tier(), s.Expiry.UTC().Format(time.RFC3339))
+	}
+
+	return fmt.Sprintf("%s: %s, %s", s.Key, s.tier(), s.State)
+}
+
+// String returns the human-readable representation of the Result.
+func (r Result) String() string {
+	if r.Err != nil {
+		return fmt.Sprintf("%s: failed: %s", r.Key, r.Err.Error())
+	}
+
+	return fmt.Sprintf("%s: restore requested", r.Key)
+}
+
+// tier returns the storage class of the object together with its archive access tier, if any.
+func (s Status) tier() string {
+	if s.ArchiveStatus != "" {
+		return fmt.Sprintf("%s %s", s.StorageClass, s.ArchiveStatus)
+	}
+
+	return s.StorageClass
+}
+
+// deepArchive reports whether the object is in one of the deep archive tiers, they can not be restored with the
+// Expedited tier.
+func (s Status) deepArchive() bool {
+	return s.StorageClass == storageclass.DeepArchive ||
+		s.ArchiveStatus == string(types.ArchiveStatusDeepArchiveAccess)
+}
@@ -0,0 +1,112 @@
+//go:build unit
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/constants"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/storageclass"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTier(t *testing.T) {
+	tier, ok := ParseTier("expedited")
+	assert.True(t, ok)
+	assert.Equal(t, types.TierExpedited, tier)
+
+	_, ok = ParseTier("Fast")
+	assert.False(t, ok)
+	assert.ElementsMatch(t, []string{"Standard", "Bulk", "Expedited"}, Tiers())
+}
+
+func TestParseStatus(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		head       *s3.HeadObjectOutput
+		shouldPass bool
+		state      string
+		expected   string
+	}{
+		{"Not archived", &s3.HeadObjectOutput{}, true, StateNotArchived, "foo: STANDARD, not archived"},
+		{"Not archived in Glacier Instant Retrieval", &s3.HeadObjectOutput{StorageClass: types.StorageClassGlacierIr}, true,
+			StateNotArchived, "foo: GLACIER_IR, not archived"},
+		{"Not restored", &s3.HeadObjectOutput{StorageClass: types.StorageClassGlacier}, true, StateNotRestored,
+			"foo: GLACIER, not restored"},
+		{"Not restored in archive access tier",
+			&s3.HeadObjectOutput{StorageClass: types.StorageClassIntelligentTiering, ArchiveStatus: types.ArchiveStatusArchiveAccess},
+			true, StateNotRestored, "foo: INTELLIGENT_TIERING ARCHIVE_ACCESS, not restored"},
+		{"In progress", &s3.HeadObjectOutput{StorageClass: types.StorageClassDeepArchive, Restore: aws.String(`ongoing-request="true"`)},
+			true, StateInProgress, "foo: DEEP_ARCHIVE, in progress"},
+		{"Restored", &s3.HeadObjectOutput{StorageClass: types.StorageClassGlacier,
+			Restore: aws.String(`ongoing-request="false", expiry-date="Fri, 21 Dec 2012 00:00:00 GMT"`)},
+			true, StateRestored, "foo: GLACIER, restored until 2012-12-21T00:00:00Z"},
+		{"Invalid restore header", &s3.HeadObjectOutput{Restore: aws.String("restored")}, false, StateNotArchived, ""},
+		{"Invalid expiry date", &s3.HeadObjectOutput{Restore: aws.String(`ongoing-request="false", expiry-date="tomorrow"`)},
+			false, StateRestored, ""},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		status, err := ParseStatus("foo", tc.head)
+		assert.Equal(t, tc.state, status.State)
+		if !tc.shouldPass {
+			assert.NotNil(t, err)
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, tc.expected, status.String())
+	}
+}
+
+func TestPlan(t *testing.T) {
+	statuses := []Status{
+		{Key: "standard", StorageClass: storageclass.Standard, State: StateNotArchived},
+		{Key: "glacier", StorageClass: storageclass.Glacier, State: StateNotRestored},
+		{Key: "deep", StorageClass: storageclass.DeepArchive, State: StateNotRestored},
+		{Key: "deep-access", StorageClass: storageclass.IntelligentTiering, ArchiveStatus: "DEEP_ARCHIVE_ACCESS", State: StateNotRestored},
+		{Key: "ongoing", StorageClass: storageclass.Glacier, State: StateInProgress},
+		{Key: "restored", StorageClass: storageclass.Glacier, State: StateRestored, Expiry: time.Now()},
+	}
+
+	requests, skips := Plan(statuses, types.TierBulk)
+	assert.Len(t, requests, 4)
+	assert.Equal(t, []storageclass.Skip{
+		{Key: "standard", Reason: ReasonNotArchived},
+		{Key: "ongoing", Reason: ReasonInProgress},
+	}, skips)
+
+	requests, skips = Plan(statuses, types.TierExpedited)
+	assert.Len(t, requests, 2)
+	assert.Len(t, skips, 4)
+	assert.Equal(t, "the Expedited tier is not available for the objects in DEEP_ARCHIVE", skips[1].Reason)
+	assert.Equal(t, "the Expedited tier is not available for the objects in INTELLIGENT_TIERING DEEP_ARCHIVE_ACCESS",
+		skips[2].Reason)
+	assert.Equal(t, 1, InProgress(statuses))
+}
+
+func TestArchivedReason(t *testing.T) {
+	reason, ok := ArchivedReason(errors.Wrap(&types.InvalidObjectState{StorageClass: types.StorageClassIntelligentTiering,
+		AccessTier: types.IntelligentTieringAccessTierArchiveAccess}, "wrapped"))
+	assert.True(t, ok)
+	assert.Equal(t, "archived in INTELLIGENT_TIERING ARCHIVE_ACCESS, it must be restored before it can be read", reason)
+
+	reason, ok = ArchivedReason(&types.InvalidObjectState{})
+	assert.True(t, ok)
+	assert.Equal(t, "archived in an archive tier, it must be restored before it can be read", reason)
+
+	_, ok = ArchivedReason(constants.ErrInjected)
+	assert.False(t, ok)
+}
+
+func TestResult_String(t *testing.T) {
+	assert.Equal(t, "foo: restore requested", Result{Key: "foo"}.String())
+	assert.Equal(t, "foo: failed: injected error", Result{Key: "foo", Err: constants.ErrInjected}.String())
+}
@@ -0,0 +1,210 @@
+package storageclass
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+const (
+	Standard           = "STANDARD"
+	IntelligentTiering = "INTELLIGENT_TIERING"
+	StandardIA         = "STANDARD_IA"
+	OneZoneIA          = "ONEZONE_IA"
+	GlacierIR          = "GLACIER_IR"
+	Glacier            = "GLACIER"
+	DeepArchive        = "DEEP_ARCHIVE"
+
+	// MaxCopySize is the size of the largest object in bytes that can be copied with a single CopyObject call
+	MaxCopySize = 5 * 1024 * 1024 * 1024
+
+	ReasonAlreadyInClass = "already in the target storage class"
+	ReasonArchived       = "archived in %s, it must be restored before it can be copied"
+	ReasonTooLarge       = "larger than 5 GB, it can not be copied with a single request"
+
+	WarnMinSize     = "smaller than the %d KB minimum billable size of %s, it is billed as %d KB"
+	WarnMinDuration = "stored in %s for %d days, moving it before %d days incurs an early deletion fee"
+
+	kb = 1024
+	gb = 1024 * 1024 * 1024
+)
+
+// Class is a storage class that the objects can be transitioned to. PricePerGB is the monthly storage price of a
+// GB in us-east-1, MinSize is the minimum billable object size in bytes, Overhead is the metadata stored with each
+// object in bytes, MinDuration is the minimum storage duration in days that is billed even if the object is moved
+// earlier and Archived reports whether the objects must be restored before they can be read.
+type Class struct {
+	Name        string
+	PricePerGB  float64
+	MinSize     int64
+	Overhead    int64
+	MinDuration int
+	Archived    bool
+}
+
+// classes are the storage classes with their us-east-1 list prices, the prices are used only for estimations
+var classes = map[string]Class{
+	Standard:           {Name: Standard, PricePerGB: 0.023},
+	IntelligentTiering: {Name: IntelligentTiering, PricePerGB: 0.023},
+	StandardIA:         {Name: StandardIA, PricePerGB: 0.0125, MinSize: 128 * kb, MinDuration: 30},
+	OneZoneIA:          {Name: OneZoneIA, PricePerGB: 0.01, MinSize: 128 * kb, MinDuration: 30},
+	GlacierIR:          {Name: GlacierIR, PricePerGB: 0.004, MinSize: 128 * kb, MinDuration: 90},
+	Glacier:            {Name: Glacier, PricePerGB: 0.0036, Overhead: 40 * kb, MinDuration: 90, Archived: true},
+	DeepArchive:        {Name: DeepArchive, PricePerGB: 0.00099, Overhead: 40 * kb, MinDuration: 180, Archived: true},
+}
+
+// Transition is an object that is planned to be moved to another storage class, Warnings are the cost implications
+// of moving it.
+type Transition struct {
+	Key          string
+	Size         int64
+	From         string
+	LastModified time.Time
+	Warnings     []string
+}
+
+// Skip is an object that can not be moved to the target storage class.
+type Skip struct {
+	Key    string
+	Reason string
+}
+
+// Result is the outcome of moving a single object to the target storage class, Err is nil on success.
+type Result struct {
+	Key  string
+	From string
+	To   string
+	Err  error
+}
+
+// Estimate is the estimated monthly storage cost of the planned transitions before and after they are applied.
+type Estimate struct {
+	Objects int
+	Bytes   int64
+	Current float64
+	Target  float64
+}
+
+// Lookup returns the Class with the given name.
+func Lookup(name string) (Class, bool) {
+	class, ok := classes[name]
+	return class, ok
+}
+
+// Names returns the names of the storage classes that the objects can be transitioned to in ascending order.
+func Names() []string {
+	names := make([]string, 0, len(classes))
+	for name := range classes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Of returns the storage class of a listed object, the objects in the STANDARD storage class can be listed without a
+// storage class.
+func Of(object types.Object) string {
+	if object.StorageClass == "" {
+		return Standard
+	}
+
+	return string(object.StorageClass)
+}
+
+// Plan splits the objects into the ones that can be moved to the target Class and the ones that can not. The age
+// of the objects are calculated from now to warn about the early deletion fees of their current storage classes.
+func Plan(objects []types.Object, target Class, now time.Time) (transitions []Transition, skips []Skip) {
+	for _, object := range objects {
+		key, from, size := aws.ToString(object.Key), Of(object), aws.ToInt64(object.Size)
+		current, known := Lookup(from)
+		switch {
+		case from == target.Name:
+			skips = append(skips, Skip{Key: key, Reason: ReasonAlreadyInClass})
+			continue
+		case known && current.Archived:
+			skips = append(skips, Skip{Key: key, Reason: fmt.Sprintf(ReasonArchived, from)})
+			continue
+		case size > MaxCopySize:
+			skips = append(skips, Skip{Key: key, Reason: ReasonTooLarge})
+			continue
+		}
+
+		transition := Transition{Key: key, Size: size, From: from, LastModified: aws.ToTime(object.LastModified)}
+		if size < target.MinSize {
+			transition.Warnings = append(transition.Warnings, fmt.Sprintf(WarnMinSize, target.MinSize/kb, target.Name,
+				target.MinSize/kb))
+		}
+
+		if age := int(now.Sub(transition.LastModified).Hours() / 24); known && age < current.MinDuration {
+			transition.Warnings = append(transition.Warnings, fmt.Sprintf(WarnMinDuration, from, age,
+				current.MinDuration))
+		}
+
+		transitions = append(transitions, transition)
+	}
+
+	return transitions, skips
+}
+
+// MonthlyCost returns the estimated monthly storage cost of an object of the given size in the Class, the minimum
+// billable size and the metadata overhead of the Class are taken into account.
+func (c Class) MonthlyCost(size int64) float64 {
+	billable := size
+	if billable < c.MinSize {
+		billable = c.MinSize
+	}
+
+	return float64(billable+c.Overhead) / gb * c.PricePerGB
+}
+
+// EstimateCost estimates the monthly storage cost of the transitions in their current storage classes and in the
+// target Class. The objects in unknown storage classes are priced as STANDARD.
+func EstimateCost(transitions []Transition, target Class) Estimate {
+	estimate := Estimate{Objects: len(transitions)}
+	for _, transition := range transitions {
+		current, ok := Lookup(transition.From)
+		if !ok {
+			current = classes[Standard]
+		}
+
+		estimate.Bytes += transition.Size
+		estimate.Current += current.MonthlyCost(transition.Size)
+		estimate.Target += target.MonthlyCost(transition.Size)
+	}
+
+	return estimate
+}
+
+// Delta returns the estimated change of the monthly storage cost, a negative value is a saving.
+func (e Estimate) Delta() float64 {
+	return e.Target - e.Current
+}
+
+// String returns the human-readable representation of the Estimate.
+func (e Estimate) String() string {
+	return fmt.Sprintf("objects=%d, size=%.3f GB, current=$%.4f/month, target=$%.4f/month, delta=%s$%.4f/month",
+		e.Objects, float64(e.Bytes)/gb, e.Current, e.Target, sign(e.Delta()), math.Abs(e.Delta()))
+}
+
+// String returns the human-readable representation of the Result.
+func (r Result) String() string {
+	if r.Err != nil {
+		return fmt.Sprintf("%s: %s -> %s, failed: %s", r.Key, r.From, r.To, r.Err.Error())
+	}
+
+	return fmt.Sprintf("%s: %s -> %s, transitioned", r.Key, r.From, r.To)
+}
+
+func sign(value float64) string {
+	if value < 0 {
+		return "-"
+	}
+
+	return "+"
+}
@@ -0,0 +1,79 @@
+//go:build unit
+
+package storageclass
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	class, ok := Lookup(GlacierIR)
+	assert.True(t, ok)
+	assert.Equal(t, 90, class.MinDuration)
+
+	_, ok = Lookup("COLD")
+	assert.False(t, ok)
+	assert.Equal(t, []string{DeepArchive, Glacier, GlacierIR, IntelligentTiering, OneZoneIA, Standard, StandardIA}, Names())
+}
+
+func TestOf(t *testing.T) {
+	assert.Equal(t, Standard, Of(types.Object{}))
+	assert.Equal(t, StandardIA, Of(types.Object{StorageClass: types.ObjectStorageClassStandardIa}))
+}
+
+func TestPlan(t *testing.T) {
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	target, _ := Lookup(GlacierIR)
+	objects := []types.Object{
+		{Key: aws.String("large"), Size: aws.Int64(1024 * 1024), LastModified: aws.Time(now.AddDate(0, 0, -100))},
+		{Key: aws.String("small"), Size: aws.Int64(10 * 1024), LastModified: aws.Time(now.AddDate(0, 0, -100))},
+		{Key: aws.String("recent-ia"), Size: aws.Int64(1024 * 1024), LastModified: aws.Time(now.AddDate(0, 0, -10)),
+			StorageClass: types.ObjectStorageClassStandardIa},
+		{Key: aws.String("already"), Size: aws.Int64(1024 * 1024), StorageClass: types.ObjectStorageClassGlacierIr},
+		{Key: aws.String("archived"), Size: aws.Int64(1024 * 1024), StorageClass: types.ObjectStorageClassDeepArchive},
+		{Key: aws.String("huge"), Size: aws.Int64(6 * 1024 * 1024 * 1024)},
+	}
+
+	transitions, skips := Plan(objects, target, now)
+	assert.Len(t, transitions, 3)
+	assert.Empty(t, transitions[0].Warnings)
+	assert.Equal(t, []string{"smaller than the 128 KB minimum billable size of GLACIER_IR, it is billed as 128 KB"},
+		transitions[1].Warnings)
+	assert.Equal(t, []string{"stored in STANDARD_IA for 10 days, moving it before 30 days incurs an early deletion fee"},
+		transitions[2].Warnings)
+	assert.Equal(t, []Skip{
+		{Key: "already", Reason: ReasonAlreadyInClass},
+		{Key: "archived", Reason: "archived in DEEP_ARCHIVE, it must be restored before it can be copied"},
+		{Key: "huge", Reason: ReasonTooLarge},
+	}, skips)
+}
+
+func TestEstimateCost(t *testing.T) {
+	target, _ := Lookup(StandardIA)
+	estimate := EstimateCost([]Transition{
+		{Key: "a", Size: 1024 * 1024 * 1024, From: Standard},
+		{Key: "b", Size: 0, From: "REDUCED_REDUNDANCY"},
+	}, target)
+
+	assert.Equal(t, 2, estimate.Objects)
+	assert.InDelta(t, 0.023, estimate.Current, 0.0001)
+	assert.InDelta(t, 0.0125+float64(128*1024)/(1024*1024*1024)*0.0125, estimate.Target, 0.0001)
+	assert.Less(t, estimate.Delta(), 0.0)
+	assert.Equal(t, "objects=2, size=1.000 GB, current=$0.0230/month, target=$0.0125/month, delta=-$0.0105/month",
+		estimate.String())
+
+	glacier, _ := Lookup(Glacier)
+	assert.Greater(t, glacier.MonthlyCost(0), 0.0)
+}
+
+func TestResult_String(t *testing.T) {
+	assert.Equal(t, "foo: STANDARD -> GLACIER_IR, transitioned", Result{Key: "foo", From: Standard, To: GlacierIR}.String())
+	assert.Equal(t, "foo: STANDARD -> GLACIER_IR, failed: injected",
+		Result{Key: "foo", From: Standard, To: GlacierIR, Err: errors.New("injected")}.String())
+}
@@ -0,0 +1,177 @@
+package tagging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"text/tabwriter"
+
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	ReasonMissing  = "missing"
+	ReasonMismatch = "value does not match"
+
+	FormatTable = "table"
+	FormatJSON  = "json"
+)
+
+// Policy is the JSON or YAML representation of the tags that the buckets and objects are required to have.
+type Policy struct {
+	Required []Rule `json:"Required" yaml:"Required"`
+}
+
+// Rule is a single required tag of a Policy. An empty Pattern allows any value, Default is the value that is added
+// to the buckets that do not have the tag when the violations are fixed.
+type Rule struct {
+	Key     string `json:"Key" yaml:"Key"`
+	Pattern string `json:"Pattern,omitempty" yaml:"Pattern,omitempty"`
+	Default string `json:"Default,omitempty" yaml:"Default,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+// Violation is a required tag that a bucket or an object of it does not comply with.
+type Violation struct {
+	Bucket string `json:"Bucket"`
+	Object string `json:"Object,omitempty"`
+	Key    string `json:"Key"`
+	Value  string `json:"Value"`
+	Reason string `json:"Reason"`
+}
+
+// BucketResult is the outcome of auditing a bucket, and optionally its objects, against a Policy. Tags are the current
+// tags of the bucket and Region is the region that the bucket is in.
+type BucketResult struct {
+	Bucket     string
+	Region     string
+	Tags       map[string]string
+	Violations []Violation
+}
+
+// ParsePolicy parses the JSON or YAML content into a Policy and validates it.
+func ParsePolicy(content []byte) (*Policy, error) {
+	policy := &Policy{}
+	if err := utils.UnmarshalJSONOrYAML(content, policy); err != nil {
+		return nil, errors.Wrap(err, "an error occurred while parsing tag policy")
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// ReadPolicyFile reads the JSON or YAML tag policy file at the given path and parses it.
+func ReadPolicyFile(path string) (*Policy, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParsePolicy(content)
+}
+
+// Validate compiles the patterns of the rules and checks that the keys are unique and the defaults comply with both
+// their patterns and the tagging limits of S3.
+func (p *Policy) Validate() error {
+	if len(p.Required) == 0 {
+		return errors.New("tag policy must contain at least one required tag")
+	}
+
+	defaults := make(map[string]string)
+	for i := range p.Required {
+		rule := &p.Required[i]
+		if rule.Key == "" {
+			return fmt.Errorf("required tag #%d must have a key", i+1)
+		}
+
+		if _, ok := defaults[rule.Key]; ok {
+			return fmt.Errorf("duplicate required tag %q", rule.Key)
+		}
+
+		pattern, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return errors.Wrapf(err, "pattern of required tag %q is not a valid regular expression", rule.Key)
+		}
+
+		rule.pattern = pattern
+		if rule.Default != "" && !pattern.MatchString(rule.Default) {
+			return fmt.Errorf("default value %q of required tag %q does not match its pattern %q", rule.Default,
+				rule.Key, rule.Pattern)
+		}
+
+		defaults[rule.Key] = rule.Default
+	}
+
+	return Validate(defaults)
+}
+
+// Audit checks the tags against the rules of the Policy and returns the violations in the order of the rules. The
+// Bucket and Object fields of the violations are left to the caller.
+func (p *Policy) Audit(tags map[string]string) (violations []Violation) {
+	for _, rule := range p.Required {
+		value, ok := tags[rule.Key]
+		switch {
+		case !ok:
+			violations = append(violations, Violation{Key: rule.Key, Reason: ReasonMissing})
+		case !rule.pattern.MatchString(value):
+			violations = append(violations, Violation{Key: rule.Key, Value: value,
+				Reason: fmt.Sprintf("%s %q", ReasonMismatch, rule.Pattern)})
+		}
+	}
+
+	return violations
+}
+
+// Fix returns a copy of the tags with the default values of the missing required tags added. The tags with values
+// that do not match their patterns are left as they are since their owners should decide on the correct values, so
+// are the missing tags without a default value.
+func (p *Policy) Fix(tags map[string]string) map[string]string {
+	fixed := make(map[string]string, len(tags)+len(p.Required))
+	for key, value := range tags {
+		fixed[key] = value
+	}
+
+	for _, rule := range p.Required {
+		if _, ok := fixed[rule.Key]; !ok && rule.Default != "" {
+			fixed[rule.Key] = rule.Default
+		}
+	}
+
+	return fixed
+}
+
+// MarshalViolations returns the representation of the violations in the given format, which is FormatTable or
+// FormatJSON. The table has one row per violation, an empty list of violations is marshalled as an empty table or an
+// empty JSON array.
+func MarshalViolations(violations []Violation, format string) ([]byte, error) {
+	switch format {
+	case FormatTable:
+		buf := &bytes.Buffer{}
+		w := tabwriter.NewWriter(buf, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "BUCKET\tOBJECT\tKEY\tVALUE\tREASON")
+		for _, v := range violations {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", v.Bucket, v.Object, v.Key, v.Value, v.Reason)
+		}
+
+		if err := w.Flush(); err != nil {
+			return nil, err
+		}
+
+		return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+	case FormatJSON:
+		if violations == nil {
+			violations = []Violation{}
+		}
+
+		return json.MarshalIndent(violations, "", "  ")
+	default:
+		return nil, fmt.Errorf("report format must be %s or %s, got %q", FormatTable, FormatJSON, format)
+	}
+}
@@ -0,0 +1,110 @@
+//go:build unit
+
+package tagging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePolicy(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		content    string
+		shouldPass bool
+	}{
+		{"Success yaml", "Required:\n  - Key: env\n    Pattern: ^(dev|prod)$\n    Default: dev\n", true},
+		{"Success json without pattern", `{"Required": [{"Key": "owner"}]}`, true},
+		{"Failure caused by no required tags", `{"Required": []}`, false},
+		{"Failure caused by empty key", `{"Required": [{"Pattern": ".*"}]}`, false},
+		{"Failure caused by duplicate keys", `{"Required": [{"Key": "env"}, {"Key": "env"}]}`, false},
+		{"Failure caused by invalid pattern", `{"Required": [{"Key": "env", "Pattern": "("}]}`, false},
+		{"Failure caused by default not matching pattern", `{"Required": [{"Key": "env", "Pattern": "^prod$", "Default": "dev"}]}`, false},
+		{"Failure caused by invalid default", `{"Required": [{"Key": "env", "Default": "a,b"}]}`, false},
+		{"Failure caused by reserved key", `{"Required": [{"Key": "aws:env"}]}`, false},
+		{"Failure caused by invalid content", `{"Required": `, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		policy, err := ParsePolicy([]byte(tc.content))
+		if tc.shouldPass {
+			assert.Nil(t, err)
+			assert.NotNil(t, policy)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestReadPolicyFile(t *testing.T) {
+	policy, err := ReadPolicyFile("../../../testdata/required-tags.yaml")
+	assert.Nil(t, err)
+	assert.Len(t, policy.Required, 3)
+
+	_, err = ReadPolicyFile("../../../testdata/required-tags.yamlllll")
+	assert.NotNil(t, err)
+}
+
+func TestPolicy_Audit(t *testing.T) {
+	policy, err := ReadPolicyFile("../../../testdata/required-tags.yaml")
+	assert.Nil(t, err)
+
+	cases := []struct {
+		caseName string
+		tags     map[string]string
+		expected []Violation
+	}{
+		{"Success compliant", map[string]string{"cost-center": "1234", "owner": "jane@example.com", "env": "prod", "foo": "bar"}, nil},
+		{"Success missing and mismatching", map[string]string{"cost-center": "abc", "env": "prod"}, []Violation{
+			{Key: "cost-center", Value: "abc", Reason: `value does not match "^[0-9]{4}$"`},
+			{Key: "owner", Reason: ReasonMissing},
+		}},
+		{"Success without tags", nil, []Violation{
+			{Key: "cost-center", Reason: ReasonMissing},
+			{Key: "owner", Reason: ReasonMissing},
+			{Key: "env", Reason: ReasonMissing},
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+		assert.Equal(t, tc.expected, policy.Audit(tc.tags))
+	}
+}
+
+func TestPolicy_Fix(t *testing.T) {
+	policy, err := ReadPolicyFile("../../../testdata/required-tags.yaml")
+	assert.Nil(t, err)
+
+	tags := map[string]string{"env": "invalid", "foo": "bar"}
+	fixed := policy.Fix(tags)
+	assert.Equal(t, map[string]string{"cost-center": "0000", "env": "invalid", "foo": "bar"}, fixed)
+	assert.Equal(t, map[string]string{"env": "invalid", "foo": "bar"}, tags)
+}
+
+func TestMarshalViolations(t *testing.T) {
+	violations := []Violation{
+		{Bucket: "foo", Key: "owner", Reason: ReasonMissing},
+		{Bucket: "foo", Object: "logs/a.txt", Key: "env", Value: "test", Reason: ReasonMismatch},
+	}
+
+	table, err := MarshalViolations(violations, FormatTable)
+	assert.Nil(t, err)
+	assert.Equal(t, "BUCKET  OBJECT      KEY    VALUE  REASON\n"+
+		"foo                 owner         missing\n"+
+		"foo     logs/a.txt  env    test   value does not match", string(table))
+
+	content, err := MarshalViolations(violations, FormatJSON)
+	assert.Nil(t, err)
+	assert.Contains(t, string(content), `"Object": "logs/a.txt"`)
+
+	content, err = MarshalViolations(nil, FormatJSON)
+	assert.Nil(t, err)
+	assert.Equal(t, "[]", string(content))
+
+	_, err = MarshalViolations(violations, "xml")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,197 @@
+package tagging
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxTags is the maximum number of tags that a bucket can have
+	MaxTags = 50
+	// MaxKeyLength is the maximum length of a tag key in Unicode characters
+	MaxKeyLength = 128
+	// MaxValueLength is the maximum length of a tag value in Unicode characters
+	MaxValueLength = 256
+
+	reservedPrefix = "aws:"
+	escape         = '\\'
+	pairSeparator  = ','
+	keySeparator   = '='
+)
+
+// allowedRegex matches the characters that S3 allows in tag keys and values, that are letters, numbers, spaces and
+// the + - = . _ : / @ characters
+var allowedRegex = regexp.MustCompile(`^[\p{L}\p{Z}\p{N}+\-=._:/@]*$`)
+
+// File is the JSON or YAML representation of the tags, it is identical with the "--tagging" input of
+// "aws s3api put-bucket-tagging" command.
+type File struct {
+	TagSet []Tag `json:"TagSet" yaml:"TagSet"`
+}
+
+// Tag is a single tag of a File.
+type Tag struct {
+	Key   string `json:"Key" yaml:"Key"`
+	Value string `json:"Value" yaml:"Value"`
+}
+
+// ParsePairs parses comma separated key=value pairs. A pair is split at its first '=', so the values can contain
+// '='. A comma that is not followed by another key=value pair is kept in the value, any ',', '=' or '\' can also be
+// escaped with a '\' to be taken literally.
+func ParsePairs(s string) (map[string]string, error) {
+	tags := make(map[string]string)
+	var key, current strings.Builder
+	lastKey, hasKey, escaped := "", false, false
+	flush := func() error {
+		switch {
+		case hasKey:
+			lastKey = key.String()
+			tags[lastKey] = current.String()
+		case lastKey != "":
+			tags[lastKey] += string(pairSeparator) + current.String()
+		default:
+			return fmt.Errorf("tag %q is not a key value pair separated with '='", current.String())
+		}
+
+		key.Reset()
+		current.Reset()
+		hasKey = false
+
+		return nil
+	}
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == escape:
+			escaped = true
+		case r == keySeparator && !hasKey:
+			key.WriteString(current.String())
+			current.Reset()
+			hasKey = true
+		case r == pairSeparator:
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	if escaped {
+		return nil, errors.New("tags can not end with an escape character")
+	}
+
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// Parse parses the JSON or YAML content into tags. The content can either be a plain key value object or the
+// "TagSet" list of "aws s3api get-bucket-tagging" command.
+func Parse(content []byte) (map[string]string, error) {
+	trimmed := bytes.TrimSpace(content)
+	if len(trimmed) == 0 {
+		return nil, errors.New("tags file is empty")
+	}
+
+	file := &File{}
+	if err := utils.UnmarshalJSONOrYAML(trimmed, file); err == nil && file.TagSet != nil {
+		tags := make(map[string]string, len(file.TagSet))
+		for _, tag := range file.TagSet {
+			if _, ok := tags[tag.Key]; ok {
+				return nil, fmt.Errorf("duplicate tag key %q", tag.Key)
+			}
+
+			tags[tag.Key] = tag.Value
+		}
+
+		return tags, nil
+	}
+
+	tags := make(map[string]string)
+	if err := utils.UnmarshalJSONOrYAML(trimmed, &tags); err != nil {
+		return nil, errors.Wrap(err, "an error occurred while parsing tags")
+	}
+
+	return tags, nil
+}
+
+// ReadFile reads the JSON or YAML tags file at the given path and parses it.
+func ReadFile(path string) (map[string]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(content)
+}
+
+// Validate checks the tags against the tagging limits of S3 and returns the first violation it finds in the order
+// of the keys.
+func Validate(tags map[string]string) error {
+	if len(tags) > MaxTags {
+		return fmt.Errorf("a bucket can have at most %d tags, got %d", MaxTags, len(tags))
+	}
+
+	for _, key := range SortedKeys(tags) {
+		value := tags[key]
+		if key == "" || utf8.RuneCountInString(key) > MaxKeyLength {
+			return fmt.Errorf("tag key %q must be 1-%d characters long", key, MaxKeyLength)
+		}
+
+		if utf8.RuneCountInString(value) > MaxValueLength {
+			return fmt.Errorf("value of tag %q can be at most %d characters long", key, MaxValueLength)
+		}
+
+		if strings.HasPrefix(strings.ToLower(key), reservedPrefix) {
+			return fmt.Errorf("tag key %q can not start with the reserved prefix %q", key, reservedPrefix)
+		}
+
+		if !allowedRegex.MatchString(key) {
+			return fmt.Errorf("tag key %q can only contain letters, numbers, spaces and + - = . _ : / @", key)
+		}
+
+		if !allowedRegex.MatchString(value) {
+			return fmt.Errorf("value of tag %q can only contain letters, numbers, spaces and + - = . _ : / @", key)
+		}
+	}
+
+	return nil
+}
+
+// SortedKeys returns the keys of the tags in ascending order.
+func SortedKeys(tags map[string]string) []string {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// FromTagSet converts the tag set of S3 into a key value map.
+func FromTagSet(tagSet []types.Tag) map[string]string {
+	tags := make(map[string]string, len(tagSet))
+	for _, tag := range tagSet {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	return tags
+}
@@ -0,0 +1,131 @@
+//go:build unit
+
+package tagging
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePairs(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		input      string
+		expected   map[string]string
+		shouldPass bool
+	}{
+		{"Success", "foo1=bar1,foo2=bar2", map[string]string{"foo1": "bar1", "foo2": "bar2"}, true},
+		{"Success with equal sign in value", "query=a=b,foo=bar", map[string]string{"query": "a=b", "foo": "bar"}, true},
+		{"Success with comma in value", "list=a,b,c,foo=bar", map[string]string{"list": "a,b,c", "foo": "bar"}, true},
+		{"Success with escaped characters", `a\=b=c\,d=e,f=g\\`, map[string]string{"a=b": "c,d=e", "f": `g\`}, true},
+		{"Success with empty value", "foo=", map[string]string{"foo": ""}, true},
+		{"Success with spaces", "cost center=team a", map[string]string{"cost center": "team a"}, true},
+		{"Failure caused by missing separator", "foo", nil, false},
+		{"Failure caused by empty input", "", nil, false},
+		{"Failure caused by trailing escape", `foo=bar\`, nil, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		tags, err := ParsePairs(tc.input)
+		if !tc.shouldPass {
+			assert.NotNil(t, err)
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, tc.expected, tags)
+	}
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		content    string
+		expected   map[string]string
+		shouldPass bool
+	}{
+		{"Success json object", `{"foo": "bar", "team": "data"}`, map[string]string{"foo": "bar", "team": "data"}, true},
+		{"Success json tag set", `{"TagSet": [{"Key": "foo", "Value": "a=b,c"}]}`, map[string]string{"foo": "a=b,c"}, true},
+		{"Success yaml object", "foo: bar\nteam: data\n", map[string]string{"foo": "bar", "team": "data"}, true},
+		{"Success yaml tag set", "TagSet:\n  - Key: foo\n    Value: bar\n", map[string]string{"foo": "bar"}, true},
+		{"Success empty tag set", `{"TagSet": []}`, map[string]string{}, true},
+		{"Failure caused by empty content", " ", nil, false},
+		{"Failure caused by duplicate keys", `{"TagSet": [{"Key": "foo", "Value": "a"}, {"Key": "foo", "Value": "b"}]}`, nil, false},
+		{"Failure caused by invalid json", `{"foo": `, nil, false},
+		{"Failure caused by nested values", `{"foo": {"bar": "baz"}}`, nil, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		tags, err := Parse([]byte(tc.content))
+		if !tc.shouldPass {
+			assert.NotNil(t, err)
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, tc.expected, tags)
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	tags, err := ReadFile("../../../testdata/tags.json")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "data", "cost-center": "1234", "query": "a=b"}, tags)
+
+	_, err = ReadFile("../../../testdata/tags.jsonnnn")
+	assert.NotNil(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	tooMany := make(map[string]string)
+	for i := 0; i <= MaxTags; i++ {
+		tooMany[fmt.Sprintf("key%d", i)] = "value"
+	}
+
+	cases := []struct {
+		caseName   string
+		tags       map[string]string
+		shouldPass bool
+	}{
+		{"Success", map[string]string{"team": "data", "path": "a/b:c@d+e-f=g.h_i", "ünïcode key": "值"}, true},
+		{"Success with empty value", map[string]string{"team": ""}, true},
+		{"Success with max length unicode key", map[string]string{strings.Repeat("ü", MaxKeyLength): "foo"}, true},
+		{"Failure caused by too many tags", tooMany, false},
+		{"Failure caused by empty key", map[string]string{"": "foo"}, false},
+		{"Failure caused by long key", map[string]string{strings.Repeat("a", MaxKeyLength+1): "foo"}, false},
+		{"Failure caused by long value", map[string]string{"foo": strings.Repeat("a", MaxValueLength+1)}, false},
+		{"Failure caused by reserved prefix", map[string]string{"AWS:foo": "bar"}, false},
+		{"Failure caused by invalid key character", map[string]string{"foo*": "bar"}, false},
+		{"Failure caused by invalid value character", map[string]string{"foo": "a,b"}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		err := Validate(tc.tags)
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestSortedKeys(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "c"}, SortedKeys(map[string]string{"c": "1", "a": "2", "b": "3"}))
+	assert.Empty(t, SortedKeys(nil))
+}
+
+func TestFromTagSet(t *testing.T) {
+	tags := FromTagSet([]types.Tag{{Key: aws.String("foo"), Value: aws.String("bar")}, {Key: aws.String("team")}})
+	assert.Equal(t, map[string]string{"foo": "bar", "team": ""}, tags)
+}
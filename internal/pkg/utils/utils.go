@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	internalawstypes "github.com/bilalcaliskan/s3-manager/internal/pkg/aws/types"
@@ -11,6 +12,7 @@ import (
 	"github.com/bilalcaliskan/s3-manager/cmd/root/options"
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 func Contains(s []string, e string) bool {
@@ -80,3 +82,43 @@ func CheckArgs(args []string, allowed int) error {
 
 	return nil
 }
+
+// UnmarshalJSONOrYAML unmarshals the content into v, content that starts with '{' or '[' is treated as JSON and
+// anything else as YAML.
+func UnmarshalJSONOrYAML(content []byte, v interface{}) error {
+	trimmed := bytes.TrimSpace(content)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		return json.Unmarshal(trimmed, v)
+	}
+
+	return yaml.Unmarshal(trimmed, v)
+}
+
+// ExitCodeError is an error that makes the process exit with Code instead of the default exit code 1.
+type ExitCodeError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitCodeError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitCodeError) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode returns the exit code of the process for the error returned by the root command, 0 for nil, the code of
+// the ExitCodeError in the chain if any and 1 otherwise.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var exitCodeErr *ExitCodeError
+	if errors.As(err, &exitCodeErr) {
+		return exitCodeErr.Code
+	}
+
+	return 1
+}
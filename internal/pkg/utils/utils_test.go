@@ -5,6 +5,7 @@ package utils
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/logging"
 	"github.com/bilalcaliskan/s3-manager/internal/pkg/prompt"
 	"testing"
@@ -227,3 +228,45 @@ func TestCheckArgs(t *testing.T) {
 		assert.Equal(t, tc.expectedErr, err)
 	}
 }
+
+func TestUnmarshalJSONOrYAML(t *testing.T) {
+	type target struct {
+		Name string `json:"Name" yaml:"Name"`
+	}
+
+	cases := []struct {
+		caseName   string
+		content    string
+		shouldPass bool
+		expected   string
+	}{
+		{"Success json", `{"Name": "foo"}`, true, "foo"},
+		{"Success yaml", "Name: bar\n", true, "bar"},
+		{"Failure invalid json", `{"Name": `, false, ""},
+		{"Failure invalid yaml", "Name: [", false, ""},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		var res target
+		err := UnmarshalJSONOrYAML([]byte(tc.content), &res)
+		if !tc.shouldPass {
+			assert.NotNil(t, err)
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, tc.expected, res.Name)
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	assert.Equal(t, 0, ExitCode(nil))
+	assert.Equal(t, 1, ExitCode(errors.New("dummy error")))
+
+	err := &ExitCodeError{Code: 2, Err: errors.New("dummy error")}
+	assert.Equal(t, 2, ExitCode(err))
+	assert.Equal(t, 2, ExitCode(fmt.Errorf("wrapped: %w", err)))
+	assert.Equal(t, "dummy error", err.Error())
+}
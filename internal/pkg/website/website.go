@@ -0,0 +1,304 @@
+package website
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/policy"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
+	"github.com/pkg/errors"
+)
+
+// MaxRoutingRules is the maximum number of routing rules that a website configuration can have
+const MaxRoutingRules = 50
+
+// Configuration is the website configuration of a bucket, its JSON representation is identical with the
+// "--website-configuration" input of "aws s3api put-bucket-website" command.
+type Configuration struct {
+	IndexDocument         *IndexDocument         `json:"IndexDocument,omitempty"`
+	ErrorDocument         *ErrorDocument         `json:"ErrorDocument,omitempty"`
+	RedirectAllRequestsTo *RedirectAllRequestsTo `json:"RedirectAllRequestsTo,omitempty"`
+	RoutingRules          []RoutingRule          `json:"RoutingRules,omitempty" yaml:"RoutingRules,omitempty"`
+}
+
+// IndexDocument is the document that is returned for the requests made to the root or to any subfolder.
+type IndexDocument struct {
+	Suffix string `json:"Suffix"`
+}
+
+// ErrorDocument is the document that is returned when a 4XX class error occurs.
+type ErrorDocument struct {
+	Key string `json:"Key"`
+}
+
+// RedirectAllRequestsTo redirects every request made to the website endpoint to another host.
+type RedirectAllRequestsTo struct {
+	HostName string `json:"HostName"`
+	Protocol string `json:"Protocol,omitempty"`
+}
+
+// RoutingRule redirects the requests that match its Condition.
+type RoutingRule struct {
+	Condition *Condition `json:"Condition,omitempty" yaml:"Condition,omitempty"`
+	Redirect  Redirect   `json:"Redirect" yaml:"Redirect"`
+}
+
+// Condition is the condition that a request must match for a RoutingRule to be applied.
+type Condition struct {
+	HttpErrorCodeReturnedEquals string `json:"HttpErrorCodeReturnedEquals,omitempty" yaml:"HttpErrorCodeReturnedEquals,omitempty"`
+	KeyPrefixEquals             string `json:"KeyPrefixEquals,omitempty" yaml:"KeyPrefixEquals,omitempty"`
+}
+
+// Redirect is where a request that matches a RoutingRule is redirected to.
+type Redirect struct {
+	HostName             string `json:"HostName,omitempty" yaml:"HostName,omitempty"`
+	HttpRedirectCode     string `json:"HttpRedirectCode,omitempty" yaml:"HttpRedirectCode,omitempty"`
+	Protocol             string `json:"Protocol,omitempty" yaml:"Protocol,omitempty"`
+	ReplaceKeyPrefixWith string `json:"ReplaceKeyPrefixWith,omitempty" yaml:"ReplaceKeyPrefixWith,omitempty"`
+	ReplaceKeyWith       string `json:"ReplaceKeyWith,omitempty" yaml:"ReplaceKeyWith,omitempty"`
+}
+
+// ParseRoutingRules parses the JSON or YAML content into routing rules. The content can either be an object with
+// the "RoutingRules" key or a plain list of rules.
+func ParseRoutingRules(content []byte) ([]RoutingRule, error) {
+	var rules []RoutingRule
+	if err := utils.UnmarshalJSONOrYAML(content, &rules); err != nil {
+		cfg := &Configuration{}
+		if cfgErr := utils.UnmarshalJSONOrYAML(content, cfg); cfgErr != nil {
+			return nil, errors.Wrap(err, "an error occurred while parsing routing rules")
+		}
+
+		rules = cfg.RoutingRules
+	}
+
+	return rules, nil
+}
+
+// IsEmpty reports whether the Configuration has no setting at all, which means website hosting is disabled.
+func (c *Configuration) IsEmpty() bool {
+	return c.IndexDocument == nil && c.ErrorDocument == nil && c.RedirectAllRequestsTo == nil && len(c.RoutingRules) == 0
+}
+
+// Validate checks the Configuration against the rules of S3 and returns the first violation it finds.
+func (c *Configuration) Validate() error {
+	if c.RedirectAllRequestsTo != nil {
+		if c.IndexDocument != nil || c.ErrorDocument != nil || len(c.RoutingRules) > 0 {
+			return errors.New("redirecting all requests can not be combined with index document, error document or routing rules")
+		}
+
+		if c.RedirectAllRequestsTo.HostName == "" {
+			return errors.New("host name to redirect all requests must be specified")
+		}
+
+		return validateProtocol(c.RedirectAllRequestsTo.Protocol)
+	}
+
+	if c.IndexDocument == nil || c.IndexDocument.Suffix == "" {
+		return errors.New("index document must be specified")
+	}
+
+	if strings.Contains(c.IndexDocument.Suffix, "/") {
+		return fmt.Errorf("index document %q can not contain a slash", c.IndexDocument.Suffix)
+	}
+
+	if c.ErrorDocument != nil && c.ErrorDocument.Key == "" {
+		return errors.New("error document can not be empty")
+	}
+
+	if len(c.RoutingRules) > MaxRoutingRules {
+		return fmt.Errorf("website configuration can contain at most %d routing rules, got %d", MaxRoutingRules, len(c.RoutingRules))
+	}
+
+	for i, rule := range c.RoutingRules {
+		if err := rule.Validate(); err != nil {
+			return errors.Wrapf(err, "routing rule #%d is invalid", i)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks a single RoutingRule against the rules of S3.
+func (r RoutingRule) Validate() error {
+	redirect := r.Redirect
+	if redirect == (Redirect{}) {
+		return errors.New("redirect must have at least one setting")
+	}
+
+	if redirect.ReplaceKeyPrefixWith != "" && redirect.ReplaceKeyWith != "" {
+		return errors.New("'ReplaceKeyPrefixWith' and 'ReplaceKeyWith' can not be specified together")
+	}
+
+	if redirect.HttpRedirectCode != "" {
+		if code, err := strconv.Atoi(redirect.HttpRedirectCode); err != nil || code < 300 || code > 399 {
+			return fmt.Errorf("http redirect code %q must be a 3XX code", redirect.HttpRedirectCode)
+		}
+	}
+
+	if r.Condition != nil && r.Condition.HttpErrorCodeReturnedEquals != "" {
+		if code, err := strconv.Atoi(r.Condition.HttpErrorCodeReturnedEquals); err != nil || code < 400 || code > 599 {
+			return fmt.Errorf("http error code %q must be a 4XX or 5XX code", r.Condition.HttpErrorCodeReturnedEquals)
+		}
+	}
+
+	return validateProtocol(redirect.Protocol)
+}
+
+func validateProtocol(protocol string) error {
+	switch protocol {
+	case "", string(types.ProtocolHttp), string(types.ProtocolHttps):
+		return nil
+	default:
+		return fmt.Errorf("unsupported protocol %q, supported protocols are http and https", protocol)
+	}
+}
+
+// String returns the indented JSON representation of the Configuration.
+func (c *Configuration) String() (string, error) {
+	bytes, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes), nil
+}
+
+// ToSDK converts the Configuration into the website configuration of AWS SDK.
+func (c *Configuration) ToSDK() *types.WebsiteConfiguration {
+	cfg := &types.WebsiteConfiguration{}
+	if c.IndexDocument != nil {
+		cfg.IndexDocument = &types.IndexDocument{Suffix: aws.String(c.IndexDocument.Suffix)}
+	}
+
+	if c.ErrorDocument != nil {
+		cfg.ErrorDocument = &types.ErrorDocument{Key: aws.String(c.ErrorDocument.Key)}
+	}
+
+	if c.RedirectAllRequestsTo != nil {
+		cfg.RedirectAllRequestsTo = &types.RedirectAllRequestsTo{
+			HostName: aws.String(c.RedirectAllRequestsTo.HostName),
+			Protocol: types.Protocol(c.RedirectAllRequestsTo.Protocol),
+		}
+	}
+
+	for _, rule := range c.RoutingRules {
+		sdkRule := types.RoutingRule{
+			Redirect: &types.Redirect{
+				HostName:             optionalString(rule.Redirect.HostName),
+				HttpRedirectCode:     optionalString(rule.Redirect.HttpRedirectCode),
+				Protocol:             types.Protocol(rule.Redirect.Protocol),
+				ReplaceKeyPrefixWith: optionalString(rule.Redirect.ReplaceKeyPrefixWith),
+				ReplaceKeyWith:       optionalString(rule.Redirect.ReplaceKeyWith),
+			},
+		}
+
+		if rule.Condition != nil {
+			sdkRule.Condition = &types.Condition{
+				HttpErrorCodeReturnedEquals: optionalString(rule.Condition.HttpErrorCodeReturnedEquals),
+				KeyPrefixEquals:             optionalString(rule.Condition.KeyPrefixEquals),
+			}
+		}
+
+		cfg.RoutingRules = append(cfg.RoutingRules, sdkRule)
+	}
+
+	return cfg
+}
+
+// FromSDK converts the GetBucketWebsiteOutput of AWS SDK into a Configuration.
+func FromSDK(res *s3.GetBucketWebsiteOutput) *Configuration {
+	cfg := &Configuration{}
+	if res == nil {
+		return cfg
+	}
+
+	if res.IndexDocument != nil {
+		cfg.IndexDocument = &IndexDocument{Suffix: aws.ToString(res.IndexDocument.Suffix)}
+	}
+
+	if res.ErrorDocument != nil {
+		cfg.ErrorDocument = &ErrorDocument{Key: aws.ToString(res.ErrorDocument.Key)}
+	}
+
+	if res.RedirectAllRequestsTo != nil {
+		cfg.RedirectAllRequestsTo = &RedirectAllRequestsTo{
+			HostName: aws.ToString(res.RedirectAllRequestsTo.HostName),
+			Protocol: string(res.RedirectAllRequestsTo.Protocol),
+		}
+	}
+
+	for _, rule := range res.RoutingRules {
+		r := RoutingRule{}
+		if rule.Redirect != nil {
+			r.Redirect = Redirect{
+				HostName:             aws.ToString(rule.Redirect.HostName),
+				HttpRedirectCode:     aws.ToString(rule.Redirect.HttpRedirectCode),
+				Protocol:             string(rule.Redirect.Protocol),
+				ReplaceKeyPrefixWith: aws.ToString(rule.Redirect.ReplaceKeyPrefixWith),
+				ReplaceKeyWith:       aws.ToString(rule.Redirect.ReplaceKeyWith),
+			}
+		}
+
+		if rule.Condition != nil {
+			r.Condition = &Condition{
+				HttpErrorCodeReturnedEquals: aws.ToString(rule.Condition.HttpErrorCodeReturnedEquals),
+				KeyPrefixEquals:             aws.ToString(rule.Condition.KeyPrefixEquals),
+			}
+		}
+
+		cfg.RoutingRules = append(cfg.RoutingRules, r)
+	}
+
+	return cfg
+}
+
+// CheckServable returns the reasons that would prevent the website endpoint from serving the objects of a bucket,
+// by looking at its policy and public access block configuration. Website endpoints only serve the objects that
+// are publicly readable, so a bucket policy that allows anonymous 's3:GetObject' calls is required.
+func CheckServable(doc *policy.Document, block *types.PublicAccessBlockConfiguration) (warnings []string) {
+	if block == nil {
+		block = &types.PublicAccessBlockConfiguration{}
+	}
+
+	publicRead := false
+	if doc != nil {
+		for _, statement := range doc.Statement {
+			if statement.IsPublic() && statement.MatchesAction("s3:GetObject") {
+				publicRead = true
+				break
+			}
+		}
+	}
+
+	if !publicRead {
+		warnings = append(warnings, "bucket policy does not allow public 's3:GetObject' access, website endpoint will "+
+			"respond with 403 Forbidden")
+
+		if aws.ToBool(block.BlockPublicPolicy) {
+			warnings = append(warnings, "'BlockPublicPolicy' is enabled on public access block, a public bucket policy "+
+				"can not be added until it is disabled")
+		}
+
+		return warnings
+	}
+
+	if aws.ToBool(block.RestrictPublicBuckets) {
+		warnings = append(warnings, "bucket policy allows public 's3:GetObject' access but it is restricted by "+
+			"'RestrictPublicBuckets' on public access block, website endpoint will respond with 403 Forbidden")
+	}
+
+	return warnings
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return aws.String(s)
+}
@@ -0,0 +1,147 @@
+//go:build unit
+
+package website
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRoutingRules(t *testing.T) {
+	cases := []struct {
+		caseName   string
+		content    string
+		shouldPass bool
+		rules      int
+	}{
+		{"Success json list", `[{"Redirect": {"HostName": "example.com"}}]`, true, 1},
+		{"Success json object", `{"RoutingRules": [{"Redirect": {"HostName": "example.com"}}, {"Redirect": {"ReplaceKeyWith": "foo"}}]}`, true, 2},
+		{"Success yaml list", "- Condition:\n    KeyPrefixEquals: docs/\n  Redirect:\n    ReplaceKeyPrefixWith: documents/\n", true, 1},
+		{"Failure invalid json", `[{"Redirect": `, false, 0},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		rules, err := ParseRoutingRules([]byte(tc.content))
+		if !tc.shouldPass {
+			assert.NotNil(t, err)
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Len(t, rules, tc.rules)
+	}
+}
+
+func TestConfiguration_Validate(t *testing.T) {
+	index := &IndexDocument{Suffix: "index.html"}
+	cases := []struct {
+		caseName   string
+		cfg        *Configuration
+		shouldPass bool
+	}{
+		{"Success index document", &Configuration{IndexDocument: index, ErrorDocument: &ErrorDocument{Key: "error.html"}}, true},
+		{"Success redirect all", &Configuration{RedirectAllRequestsTo: &RedirectAllRequestsTo{HostName: "example.com", Protocol: "https"}}, true},
+		{"Success routing rules", &Configuration{IndexDocument: index, RoutingRules: []RoutingRule{
+			{Condition: &Condition{HttpErrorCodeReturnedEquals: "404"}, Redirect: Redirect{HostName: "example.com", HttpRedirectCode: "301"}},
+		}}, true},
+		{"Failure redirect all combined", &Configuration{IndexDocument: index, RedirectAllRequestsTo: &RedirectAllRequestsTo{HostName: "example.com"}}, false},
+		{"Failure redirect all without host", &Configuration{RedirectAllRequestsTo: &RedirectAllRequestsTo{}}, false},
+		{"Failure redirect all invalid protocol", &Configuration{RedirectAllRequestsTo: &RedirectAllRequestsTo{HostName: "example.com", Protocol: "ftp"}}, false},
+		{"Failure no index document", &Configuration{}, false},
+		{"Failure index document with slash", &Configuration{IndexDocument: &IndexDocument{Suffix: "a/index.html"}}, false},
+		{"Failure empty error document", &Configuration{IndexDocument: index, ErrorDocument: &ErrorDocument{}}, false},
+		{"Failure empty redirect", &Configuration{IndexDocument: index, RoutingRules: []RoutingRule{{}}}, false},
+		{"Failure both replace keys", &Configuration{IndexDocument: index, RoutingRules: []RoutingRule{
+			{Redirect: Redirect{ReplaceKeyWith: "foo", ReplaceKeyPrefixWith: "bar"}},
+		}}, false},
+		{"Failure invalid redirect code", &Configuration{IndexDocument: index, RoutingRules: []RoutingRule{
+			{Redirect: Redirect{HttpRedirectCode: "200"}},
+		}}, false},
+		{"Failure invalid error code", &Configuration{IndexDocument: index, RoutingRules: []RoutingRule{
+			{Condition: &Condition{HttpErrorCodeReturnedEquals: "302"}, Redirect: Redirect{HostName: "example.com"}},
+		}}, false},
+		{"Failure too many routing rules", &Configuration{IndexDocument: index, RoutingRules: make([]RoutingRule, MaxRoutingRules+1)}, false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+
+		err := tc.cfg.Validate()
+		if tc.shouldPass {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestSDKConversion(t *testing.T) {
+	cfg := &Configuration{
+		IndexDocument: &IndexDocument{Suffix: "index.html"},
+		ErrorDocument: &ErrorDocument{Key: "error.html"},
+		RoutingRules: []RoutingRule{
+			{Condition: &Condition{KeyPrefixEquals: "docs/"}, Redirect: Redirect{ReplaceKeyPrefixWith: "documents/"}},
+			{Redirect: Redirect{HostName: "example.com", Protocol: "https"}},
+		},
+	}
+
+	sdk := cfg.ToSDK()
+	assert.Equal(t, "index.html", *sdk.IndexDocument.Suffix)
+	assert.Nil(t, sdk.RoutingRules[0].Redirect.HostName)
+	assert.Nil(t, sdk.RoutingRules[1].Condition)
+
+	res := &s3.GetBucketWebsiteOutput{
+		IndexDocument: sdk.IndexDocument,
+		ErrorDocument: sdk.ErrorDocument,
+		RoutingRules:  sdk.RoutingRules,
+	}
+	assert.Equal(t, cfg, FromSDK(res))
+
+	redirect := &Configuration{RedirectAllRequestsTo: &RedirectAllRequestsTo{HostName: "example.com", Protocol: "http"}}
+	assert.Equal(t, redirect, FromSDK(&s3.GetBucketWebsiteOutput{RedirectAllRequestsTo: redirect.ToSDK().RedirectAllRequestsTo}))
+
+	assert.True(t, FromSDK(nil).IsEmpty())
+	assert.False(t, cfg.IsEmpty())
+}
+
+func TestConfiguration_String(t *testing.T) {
+	content, err := (&Configuration{IndexDocument: &IndexDocument{Suffix: "index.html"}}).String()
+	assert.Nil(t, err)
+	assert.Contains(t, content, `"Suffix": "index.html"`)
+	assert.NotContains(t, content, "RoutingRules")
+}
+
+func TestCheckServable(t *testing.T) {
+	publicRead := &policy.Document{Statement: policy.Statements{
+		{Effect: "Allow", Principal: policy.Principal{"*": {"*"}}, Action: policy.Value{"s3:GetObject"}},
+	}}
+	privateRead := &policy.Document{Statement: policy.Statements{
+		{Effect: "Allow", Principal: policy.Principal{"AWS": {"arn:aws:iam::123456789012:root"}}, Action: policy.Value{"s3:GetObject"}},
+	}}
+
+	cases := []struct {
+		caseName string
+		doc      *policy.Document
+		block    *types.PublicAccessBlockConfiguration
+		warnings int
+	}{
+		{"Servable", publicRead, nil, 0},
+		{"Servable with block public policy", publicRead, &types.PublicAccessBlockConfiguration{BlockPublicPolicy: aws.Bool(true)}, 0},
+		{"Restricted public buckets", publicRead, &types.PublicAccessBlockConfiguration{RestrictPublicBuckets: aws.Bool(true)}, 1},
+		{"No policy", nil, nil, 1},
+		{"Private policy", privateRead, &types.PublicAccessBlockConfiguration{}, 1},
+		{"No policy with block public policy", nil, &types.PublicAccessBlockConfiguration{BlockPublicPolicy: aws.Bool(true)}, 2},
+	}
+
+	for _, tc := range cases {
+		t.Logf("starting case %s", tc.caseName)
+		assert.Len(t, CheckServable(tc.doc, tc.block), tc.warnings)
+	}
+}
@@ -7,10 +7,11 @@ import (
 	"os"
 
 	"github.com/bilalcaliskan/s3-manager/cmd/root"
+	"github.com/bilalcaliskan/s3-manager/internal/pkg/utils"
 )
 
 func main() {
 	if err := root.Execute(); err != nil {
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 }